@@ -0,0 +1,35 @@
+package swego
+
+// AzAltMode is the type of coordinate conversion constants of swe_azalt and
+// swe_azalt_rev.
+type AzAltMode int32
+
+// RefracMode is the type of refraction direction constants.
+type RefracMode int32
+
+// AzAltFlags represents the library state and atmospheric conditions of
+// swe_azalt and swe_azalt_rev.
+type AzAltFlags struct {
+	AtPress   float64  // Atmospheric pressure in mbar (hPa), 0 estimates it
+	AtTemp    float64  // Atmospheric temperature in °C
+	LapseRate *float64 // Argument to swe_set_lapse_rate, nil resets it.
+	DeltaT    *float64 // Argument to swe_set_delta_t_userdef, nil resets it.
+}
+
+// SetLapseRate sets f as attenuation of the atmospheric temperature with the
+// altitude in °K/m in flags object fl.
+// Set fl.LapseRate to nil to reset the value within the Swiss Ephemeris.
+func (fl *AzAltFlags) SetLapseRate(f float64) { fl.LapseRate = &f }
+
+// SetDeltaT sets f as delta T in flags object fl.
+// Set fl.DeltaT to nil to reset the value within the Swiss Ephemeris.
+func (fl *AzAltFlags) SetDeltaT(f float64) { fl.DeltaT = &f }
+
+// Refraction represents the result of swe_refrac_extended. All values are in
+// degrees.
+type Refraction struct {
+	TrueAlt    float64 // true altitude, if possible, otherwise input value
+	AppAlt     float64 // apparent altitude, if possible, otherwise input value
+	Refraction float64
+	Dip        float64 // dip of the horizon
+}
@@ -0,0 +1,17 @@
+package swego
+
+// CalcRequest represents a single calculation of CalcBatch.
+type CalcRequest struct {
+	JD     float64 // Julian Date, in Universal Time if UT is set, otherwise in Ephemeris Time
+	UT     bool    // calculate like CalcUT instead of Calc
+	Planet Planet
+	Flags  *CalcFlags
+}
+
+// CalcResult represents the result of a CalcRequest, the fields correspond
+// to the return values of Calc and CalcUT.
+type CalcResult struct {
+	XX    []float64
+	Flags int
+	Err   error
+}
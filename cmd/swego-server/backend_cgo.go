@@ -0,0 +1,19 @@
+//go:build (linux && cgo) || (darwin && cgo)
+// +build linux,cgo darwin,cgo
+
+package main
+
+import (
+	"github.com/howesteve/swego"
+	"github.com/howesteve/swego/swecgo"
+)
+
+func init() {
+	openCgo = func(ephePath string) swego.Interface {
+		if ephePath == "" {
+			return swecgo.Open()
+		}
+
+		return swecgo.OpenWithPath(ephePath)
+	}
+}
@@ -0,0 +1,1033 @@
+package main
+
+import "github.com/howesteve/swego"
+
+// endpoints lists the methods of swego.Interface served via HTTP. Path is
+// the name of the C function without the swe_ prefix. The fields of the
+// request types are the arguments of the method, the fields of the response
+// types are the results of the method without the error.
+var endpoints = []*endpoint{
+	{"version", "Version", VersionRequest{}, VersionResponse{}, nil},
+	{"get_planet_name", "PlanetName", PlanetNameRequest{}, PlanetNameResponse{}, nil},
+	{"calc", "Calc", CalcRequest{}, CalcResponse{}, nil},
+	{"calc_ut", "CalcUT", CalcUTRequest{}, CalcUTResponse{}, nil},
+	{"calc_pctr", "CalcPctr", CalcPctrRequest{}, CalcPctrResponse{}, nil},
+	{"fixstar", "FixStar", FixStarRequest{}, FixStarResponse{}, nil},
+	{"fixstar_ut", "FixStarUT", FixStarUTRequest{}, FixStarUTResponse{}, nil},
+	{"fixstar_mag", "FixStarMag", FixStarMagRequest{}, FixStarMagResponse{}, nil},
+	{"fixstar2", "FixStar2", FixStar2Request{}, FixStar2Response{}, nil},
+	{"fixstar2_ut", "FixStar2UT", FixStar2UTRequest{}, FixStar2UTResponse{}, nil},
+	{"fixstar2_mag", "FixStar2Mag", FixStar2MagRequest{}, FixStar2MagResponse{}, nil},
+	{"nod_aps", "NodAps", NodApsRequest{}, NodApsResponse{}, nil},
+	{"nod_aps_ut", "NodApsUT", NodApsUTRequest{}, NodApsUTResponse{}, nil},
+	{"get_orbital_elements", "GetOrbitalElements", GetOrbitalElementsRequest{}, GetOrbitalElementsResponse{}, nil},
+	{"orbit_max_min_true_distance", "OrbitMaxMinTrueDistance", OrbitMaxMinTrueDistanceRequest{}, OrbitMaxMinTrueDistanceResponse{}, nil},
+	{"solcross", "SolCross", SolCrossRequest{}, SolCrossResponse{}, nil},
+	{"solcross_ut", "SolCrossUT", SolCrossUTRequest{}, SolCrossUTResponse{}, nil},
+	{"mooncross", "MoonCross", MoonCrossRequest{}, MoonCrossResponse{}, nil},
+	{"mooncross_ut", "MoonCrossUT", MoonCrossUTRequest{}, MoonCrossUTResponse{}, nil},
+	{"mooncross_node", "MoonCrossNode", MoonCrossNodeRequest{}, MoonCrossNodeResponse{}, nil},
+	{"mooncross_node_ut", "MoonCrossNodeUT", MoonCrossNodeUTRequest{}, MoonCrossNodeUTResponse{}, nil},
+	{"helio_cross", "HelioCross", HelioCrossRequest{}, HelioCrossResponse{}, nil},
+	{"helio_cross_ut", "HelioCrossUT", HelioCrossUTRequest{}, HelioCrossUTResponse{}, nil},
+	{"get_ayanamsa_ex", "GetAyanamsaEx", GetAyanamsaExRequest{}, GetAyanamsaExResponse{}, nil},
+	{"get_ayanamsa_ex_ut", "GetAyanamsaExUT", GetAyanamsaExUTRequest{}, GetAyanamsaExUTResponse{}, nil},
+	{"get_ayanamsa_name", "GetAyanamsaName", GetAyanamsaNameRequest{}, GetAyanamsaNameResponse{}, nil},
+	{"julday", "JulDay", JulDayRequest{}, JulDayResponse{}, nil},
+	{"revjul", "RevJul", RevJulRequest{}, RevJulResponse{}, nil},
+	{"utc_to_jd", "UTCToJD", UTCToJDRequest{}, UTCToJDResponse{}, nil},
+	{"jdet_to_utc", "JdETToUTC", JdETToUTCRequest{}, JdETToUTCResponse{}, nil},
+	{"jdut1_to_utc", "JdUT1ToUTC", JdUT1ToUTCRequest{}, JdUT1ToUTCResponse{}, nil},
+	{"utc_time_zone", "UTCTimeZone", UTCTimeZoneRequest{}, UTCTimeZoneResponse{}, nil},
+	{"houses_ex", "HousesEx", HousesExRequest{}, HousesExResponse{}, nil},
+	{"houses_armc", "HousesARMC", HousesARMCRequest{}, HousesARMCResponse{}, nil},
+	{"houses_ex2", "HousesEx2", HousesEx2Request{}, HousesEx2Response{}, nil},
+	{"houses_armc_ex2", "HousesARMCEx2", HousesARMCEx2Request{}, HousesARMCEx2Response{}, nil},
+	{"house_pos", "HousePos", HousePosRequest{}, HousePosResponse{}, nil},
+	{"gauquelin_sector", "GauquelinSector", GauquelinSectorRequest{}, GauquelinSectorResponse{}, nil},
+	{"house_name", "HouseName", HouseNameRequest{}, HouseNameResponse{}, nil},
+	{"sol_eclipse_when_glob", "SolEclipseWhenGlob", SolEclipseWhenGlobRequest{}, SolEclipseWhenGlobResponse{}, nil},
+	{"sol_eclipse_when_loc", "SolEclipseWhenLoc", SolEclipseWhenLocRequest{}, SolEclipseWhenLocResponse{}, nil},
+	{"sol_eclipse_where", "SolEclipseWhere", SolEclipseWhereRequest{}, SolEclipseWhereResponse{}, nil},
+	{"sol_eclipse_how", "SolEclipseHow", SolEclipseHowRequest{}, SolEclipseHowResponse{}, nil},
+	{"lun_occult_when_glob", "LunOccultWhenGlob", LunOccultWhenGlobRequest{}, LunOccultWhenGlobResponse{}, nil},
+	{"lun_occult_when_loc", "LunOccultWhenLoc", LunOccultWhenLocRequest{}, LunOccultWhenLocResponse{}, nil},
+	{"lun_occult_where", "LunOccultWhere", LunOccultWhereRequest{}, LunOccultWhereResponse{}, nil},
+	{"lun_eclipse_when", "LunEclipseWhen", LunEclipseWhenRequest{}, LunEclipseWhenResponse{}, nil},
+	{"lun_eclipse_when_loc", "LunEclipseWhenLoc", LunEclipseWhenLocRequest{}, LunEclipseWhenLocResponse{}, nil},
+	{"lun_eclipse_how", "LunEclipseHow", LunEclipseHowRequest{}, LunEclipseHowResponse{}, nil},
+	{"pheno", "Pheno", PhenoRequest{}, PhenoResponse{}, nil},
+	{"pheno_ut", "PhenoUT", PhenoUTRequest{}, PhenoUTResponse{}, nil},
+	{"rise_trans", "RiseTrans", RiseTransRequest{}, RiseTransResponse{}, nil},
+	{"azalt", "AzAlt", AzAltRequest{}, AzAltResponse{}, nil},
+	{"azalt_rev", "AzAltRev", AzAltRevRequest{}, AzAltRevResponse{}, nil},
+	{"refrac", "Refrac", RefracRequest{}, RefracResponse{}, nil},
+	{"refrac_extended", "RefracExtended", RefracExtendedRequest{}, RefracExtendedResponse{}, nil},
+	{"heliacal_ut", "HeliacalUT", HeliacalUTRequest{}, HeliacalUTResponse{}, nil},
+	{"heliacal_pheno_ut", "HeliacalPhenoUT", HeliacalPhenoUTRequest{}, HeliacalPhenoUTResponse{}, nil},
+	{"vis_limit_mag", "VisLimitMag", VisLimitMagRequest{}, VisLimitMagResponse{}, nil},
+	{"heliacal_angle", "HeliacalAngle", HeliacalAngleRequest{}, HeliacalAngleResponse{}, nil},
+	{"topo_arcus_visionis", "TopoArcusVisionis", TopoArcusVisionisRequest{}, TopoArcusVisionisResponse{}, nil},
+	{"deltat_ex", "DeltaTEx", DeltaTExRequest{}, DeltaTExResponse{}, nil},
+	{"time_equ", "TimeEqu", TimeEquRequest{}, TimeEquResponse{}, nil},
+	{"lmt_to_lat", "LMTToLAT", LMTToLATRequest{}, LMTToLATResponse{}, nil},
+	{"lat_to_lmt", "LATToLMT", LATToLMTRequest{}, LATToLMTResponse{}, nil},
+	{"sidtime0", "SidTime0", SidTime0Request{}, SidTime0Response{}, nil},
+	{"sidtime", "SidTime", SidTimeRequest{}, SidTimeResponse{}, nil},
+	{"cotrans", "CoTrans", CoTransRequest{}, CoTransResponse{}, nil},
+	{"cotrans_sp", "CoTransSp", CoTransSpRequest{}, CoTransSpResponse{}, nil},
+	{"split_deg", "SplitDeg", SplitDegRequest{}, SplitDegResponse{}, nil},
+	{"calc_batch", "CalcBatch", CalcBatchRequest{}, CalcBatchResponse{}, calcBatch},
+}
+
+// VersionRequest are the arguments of Version.
+type VersionRequest struct{}
+
+// VersionResponse are the results of Version.
+type VersionResponse struct {
+	Version string `json:"version"`
+}
+
+// PlanetNameRequest are the arguments of PlanetName.
+type PlanetNameRequest struct {
+	Planet swego.Planet `json:"planet"`
+}
+
+// PlanetNameResponse are the results of PlanetName.
+type PlanetNameResponse struct {
+	Name string `json:"name"`
+}
+
+// CalcRequest are the arguments of Calc.
+type CalcRequest struct {
+	ET     float64      `json:"et"`
+	Planet swego.Planet `json:"planet"`
+	Flags  *CalcFlags   `json:"flags"`
+}
+
+// CalcResponse are the results of Calc.
+type CalcResponse struct {
+	XX    []float64 `json:"xx"`
+	Flags int       `json:"cfl"`
+}
+
+// CalcUTRequest are the arguments of CalcUT.
+type CalcUTRequest struct {
+	UT     float64      `json:"ut"`
+	Planet swego.Planet `json:"planet"`
+	Flags  *CalcFlags   `json:"flags"`
+}
+
+// CalcUTResponse are the results of CalcUT.
+type CalcUTResponse struct {
+	XX    []float64 `json:"xx"`
+	Flags int       `json:"cfl"`
+}
+
+// CalcPctrRequest are the arguments of CalcPctr.
+type CalcPctrRequest struct {
+	ET     float64      `json:"et"`
+	Planet swego.Planet `json:"planet"`
+	Center swego.Planet `json:"center"`
+	Flags  *CalcFlags   `json:"flags"`
+}
+
+// CalcPctrResponse are the results of CalcPctr.
+type CalcPctrResponse struct {
+	XX    []float64 `json:"xx"`
+	Flags int       `json:"cfl"`
+}
+
+// FixStarRequest are the arguments of FixStar.
+type FixStarRequest struct {
+	Star  string     `json:"star"`
+	ET    float64    `json:"et"`
+	Flags *CalcFlags `json:"flags"`
+}
+
+// FixStarResponse are the results of FixStar.
+type FixStarResponse struct {
+	Name  string    `json:"name"`
+	XX    []float64 `json:"xx"`
+	Flags int       `json:"cfl"`
+}
+
+// FixStarUTRequest are the arguments of FixStarUT.
+type FixStarUTRequest struct {
+	Star  string     `json:"star"`
+	UT    float64    `json:"ut"`
+	Flags *CalcFlags `json:"flags"`
+}
+
+// FixStarUTResponse are the results of FixStarUT.
+type FixStarUTResponse struct {
+	Name  string    `json:"name"`
+	XX    []float64 `json:"xx"`
+	Flags int       `json:"cfl"`
+}
+
+// FixStarMagRequest are the arguments of FixStarMag.
+type FixStarMagRequest struct {
+	Star string `json:"star"`
+}
+
+// FixStarMagResponse are the results of FixStarMag.
+type FixStarMagResponse struct {
+	Name string  `json:"name"`
+	Mag  float64 `json:"mag"`
+}
+
+// FixStar2Request are the arguments of FixStar2.
+type FixStar2Request struct {
+	Star  string     `json:"star"`
+	ET    float64    `json:"et"`
+	Flags *CalcFlags `json:"flags"`
+}
+
+// FixStar2Response are the results of FixStar2.
+type FixStar2Response struct {
+	Name  string    `json:"name"`
+	XX    []float64 `json:"xx"`
+	Flags int       `json:"cfl"`
+}
+
+// FixStar2UTRequest are the arguments of FixStar2UT.
+type FixStar2UTRequest struct {
+	Star  string     `json:"star"`
+	UT    float64    `json:"ut"`
+	Flags *CalcFlags `json:"flags"`
+}
+
+// FixStar2UTResponse are the results of FixStar2UT.
+type FixStar2UTResponse struct {
+	Name  string    `json:"name"`
+	XX    []float64 `json:"xx"`
+	Flags int       `json:"cfl"`
+}
+
+// FixStar2MagRequest are the arguments of FixStar2Mag.
+type FixStar2MagRequest struct {
+	Star string `json:"star"`
+}
+
+// FixStar2MagResponse are the results of FixStar2Mag.
+type FixStar2MagResponse struct {
+	Name string  `json:"name"`
+	Mag  float64 `json:"mag"`
+}
+
+// NodApsRequest are the arguments of NodAps.
+type NodApsRequest struct {
+	ET     float64            `json:"et"`
+	Planet swego.Planet       `json:"planet"`
+	Flags  *CalcFlags         `json:"flags"`
+	Method swego.NodApsMethod `json:"method"`
+}
+
+// NodApsResponse are the results of NodAps.
+type NodApsResponse struct {
+	Nasc []float64 `json:"nasc"`
+	Ndsc []float64 `json:"ndsc"`
+	Peri []float64 `json:"peri"`
+	Aphe []float64 `json:"aphe"`
+}
+
+// NodApsUTRequest are the arguments of NodApsUT.
+type NodApsUTRequest struct {
+	UT     float64            `json:"ut"`
+	Planet swego.Planet       `json:"planet"`
+	Flags  *CalcFlags         `json:"flags"`
+	Method swego.NodApsMethod `json:"method"`
+}
+
+// NodApsUTResponse are the results of NodApsUT.
+type NodApsUTResponse struct {
+	Nasc []float64 `json:"nasc"`
+	Ndsc []float64 `json:"ndsc"`
+	Peri []float64 `json:"peri"`
+	Aphe []float64 `json:"aphe"`
+}
+
+// GetOrbitalElementsRequest are the arguments of GetOrbitalElements.
+type GetOrbitalElementsRequest struct {
+	ET     float64      `json:"et"`
+	Planet swego.Planet `json:"planet"`
+	Flags  *CalcFlags   `json:"flags"`
+}
+
+// GetOrbitalElementsResponse are the results of GetOrbitalElements.
+type GetOrbitalElementsResponse struct {
+	OrbitalElements swego.OrbitalElements `json:"orbitalElements"`
+}
+
+// OrbitMaxMinTrueDistanceRequest are the arguments of OrbitMaxMinTrueDistance.
+type OrbitMaxMinTrueDistanceRequest struct {
+	ET     float64      `json:"et"`
+	Planet swego.Planet `json:"planet"`
+	Flags  *CalcFlags   `json:"flags"`
+}
+
+// OrbitMaxMinTrueDistanceResponse are the results of OrbitMaxMinTrueDistance.
+type OrbitMaxMinTrueDistanceResponse struct {
+	DMax  float64 `json:"dmax"`
+	DMin  float64 `json:"dmin"`
+	DTrue float64 `json:"dtrue"`
+}
+
+// SolCrossRequest are the arguments of SolCross.
+type SolCrossRequest struct {
+	X2Cross float64    `json:"x2cross"`
+	ET      float64    `json:"et"`
+	Flags   *CalcFlags `json:"flags"`
+}
+
+// SolCrossResponse are the results of SolCross.
+type SolCrossResponse struct {
+	JD float64 `json:"jd"`
+}
+
+// SolCrossUTRequest are the arguments of SolCrossUT.
+type SolCrossUTRequest struct {
+	X2Cross float64    `json:"x2cross"`
+	UT      float64    `json:"ut"`
+	Flags   *CalcFlags `json:"flags"`
+}
+
+// SolCrossUTResponse are the results of SolCrossUT.
+type SolCrossUTResponse struct {
+	JD float64 `json:"jd"`
+}
+
+// MoonCrossRequest are the arguments of MoonCross.
+type MoonCrossRequest struct {
+	X2Cross float64    `json:"x2cross"`
+	ET      float64    `json:"et"`
+	Flags   *CalcFlags `json:"flags"`
+}
+
+// MoonCrossResponse are the results of MoonCross.
+type MoonCrossResponse struct {
+	JD float64 `json:"jd"`
+}
+
+// MoonCrossUTRequest are the arguments of MoonCrossUT.
+type MoonCrossUTRequest struct {
+	X2Cross float64    `json:"x2cross"`
+	UT      float64    `json:"ut"`
+	Flags   *CalcFlags `json:"flags"`
+}
+
+// MoonCrossUTResponse are the results of MoonCrossUT.
+type MoonCrossUTResponse struct {
+	JD float64 `json:"jd"`
+}
+
+// MoonCrossNodeRequest are the arguments of MoonCrossNode.
+type MoonCrossNodeRequest struct {
+	ET    float64    `json:"et"`
+	Flags *CalcFlags `json:"flags"`
+}
+
+// MoonCrossNodeResponse are the results of MoonCrossNode.
+type MoonCrossNodeResponse struct {
+	JD  float64 `json:"jd"`
+	Lng float64 `json:"lng"`
+	Lat float64 `json:"lat"`
+}
+
+// MoonCrossNodeUTRequest are the arguments of MoonCrossNodeUT.
+type MoonCrossNodeUTRequest struct {
+	UT    float64    `json:"ut"`
+	Flags *CalcFlags `json:"flags"`
+}
+
+// MoonCrossNodeUTResponse are the results of MoonCrossNodeUT.
+type MoonCrossNodeUTResponse struct {
+	JD  float64 `json:"jd"`
+	Lng float64 `json:"lng"`
+	Lat float64 `json:"lat"`
+}
+
+// HelioCrossRequest are the arguments of HelioCross.
+type HelioCrossRequest struct {
+	Planet   swego.Planet `json:"planet"`
+	X2Cross  float64      `json:"x2cross"`
+	ET       float64      `json:"et"`
+	Flags    *CalcFlags   `json:"flags"`
+	Backward bool         `json:"backward"`
+}
+
+// HelioCrossResponse are the results of HelioCross.
+type HelioCrossResponse struct {
+	JD float64 `json:"jd"`
+}
+
+// HelioCrossUTRequest are the arguments of HelioCrossUT.
+type HelioCrossUTRequest struct {
+	Planet   swego.Planet `json:"planet"`
+	X2Cross  float64      `json:"x2cross"`
+	UT       float64      `json:"ut"`
+	Flags    *CalcFlags   `json:"flags"`
+	Backward bool         `json:"backward"`
+}
+
+// HelioCrossUTResponse are the results of HelioCrossUT.
+type HelioCrossUTResponse struct {
+	JD float64 `json:"jd"`
+}
+
+// GetAyanamsaExRequest are the arguments of GetAyanamsaEx.
+type GetAyanamsaExRequest struct {
+	ET    float64          `json:"et"`
+	Flags *AyanamsaExFlags `json:"flags"`
+}
+
+// GetAyanamsaExResponse are the results of GetAyanamsaEx.
+type GetAyanamsaExResponse struct {
+	Ayanamsa float64 `json:"ayanamsa"`
+}
+
+// GetAyanamsaExUTRequest are the arguments of GetAyanamsaExUT.
+type GetAyanamsaExUTRequest struct {
+	UT    float64          `json:"ut"`
+	Flags *AyanamsaExFlags `json:"flags"`
+}
+
+// GetAyanamsaExUTResponse are the results of GetAyanamsaExUT.
+type GetAyanamsaExUTResponse struct {
+	Ayanamsa float64 `json:"ayanamsa"`
+}
+
+// GetAyanamsaNameRequest are the arguments of GetAyanamsaName.
+type GetAyanamsaNameRequest struct {
+	Ayanamsa swego.Ayanamsa `json:"ayanamsa"`
+}
+
+// GetAyanamsaNameResponse are the results of GetAyanamsaName.
+type GetAyanamsaNameResponse struct {
+	Name string `json:"name"`
+}
+
+// JulDayRequest are the arguments of JulDay.
+type JulDayRequest struct {
+	Year     int           `json:"year"`
+	Month    int           `json:"month"`
+	Day      int           `json:"day"`
+	Hour     float64       `json:"hour"`
+	Calendar swego.CalType `json:"calendar"`
+}
+
+// JulDayResponse are the results of JulDay.
+type JulDayResponse struct {
+	JD float64 `json:"jd"`
+}
+
+// RevJulRequest are the arguments of RevJul.
+type RevJulRequest struct {
+	JD       float64       `json:"jd"`
+	Calendar swego.CalType `json:"calendar"`
+}
+
+// RevJulResponse are the results of RevJul.
+type RevJulResponse struct {
+	Year  int     `json:"year"`
+	Month int     `json:"month"`
+	Day   int     `json:"day"`
+	Hour  float64 `json:"hour"`
+}
+
+// UTCToJDRequest are the arguments of UTCToJD.
+type UTCToJDRequest struct {
+	Year   int               `json:"year"`
+	Month  int               `json:"month"`
+	Day    int               `json:"day"`
+	Hour   int               `json:"hour"`
+	Minute int               `json:"minute"`
+	Second float64           `json:"second"`
+	Flags  *DateConvertFlags `json:"flags"`
+}
+
+// UTCToJDResponse are the results of UTCToJD.
+type UTCToJDResponse struct {
+	ET float64 `json:"et"`
+	UT float64 `json:"ut"`
+}
+
+// JdETToUTCRequest are the arguments of JdETToUTC.
+type JdETToUTCRequest struct {
+	ET    float64           `json:"et"`
+	Flags *DateConvertFlags `json:"flags"`
+}
+
+// JdETToUTCResponse are the results of JdETToUTC.
+type JdETToUTCResponse struct {
+	Year   int     `json:"year"`
+	Month  int     `json:"month"`
+	Day    int     `json:"day"`
+	Hour   int     `json:"hour"`
+	Minute int     `json:"minute"`
+	Second float64 `json:"second"`
+}
+
+// JdUT1ToUTCRequest are the arguments of JdUT1ToUTC.
+type JdUT1ToUTCRequest struct {
+	UT1   float64           `json:"ut1"`
+	Flags *DateConvertFlags `json:"flags"`
+}
+
+// JdUT1ToUTCResponse are the results of JdUT1ToUTC.
+type JdUT1ToUTCResponse struct {
+	Year   int     `json:"year"`
+	Month  int     `json:"month"`
+	Day    int     `json:"day"`
+	Hour   int     `json:"hour"`
+	Minute int     `json:"minute"`
+	Second float64 `json:"second"`
+}
+
+// UTCTimeZoneRequest are the arguments of UTCTimeZone.
+type UTCTimeZoneRequest struct {
+	Year   int     `json:"year"`
+	Month  int     `json:"month"`
+	Day    int     `json:"day"`
+	Hour   int     `json:"hour"`
+	Minute int     `json:"minute"`
+	Second float64 `json:"second"`
+	TZ     float64 `json:"tz"`
+}
+
+// UTCTimeZoneResponse are the results of UTCTimeZone.
+type UTCTimeZoneResponse struct {
+	Year   int     `json:"year"`
+	Month  int     `json:"month"`
+	Day    int     `json:"day"`
+	Hour   int     `json:"hour"`
+	Minute int     `json:"minute"`
+	Second float64 `json:"second"`
+}
+
+// HousesExRequest are the arguments of HousesEx.
+type HousesExRequest struct {
+	UT     float64        `json:"ut"`
+	Flags  *HousesExFlags `json:"flags"`
+	GeoLat float64        `json:"geolat"`
+	GeoLon float64        `json:"geolon"`
+	HSys   houseSystem    `json:"hsys"`
+}
+
+// HousesExResponse are the results of HousesEx.
+type HousesExResponse struct {
+	Cusps []float64 `json:"cusps"`
+	ASCMC []float64 `json:"ascmc"`
+}
+
+// HousesARMCRequest are the arguments of HousesARMC.
+type HousesARMCRequest struct {
+	ARMC   float64     `json:"armc"`
+	GeoLat float64     `json:"geolat"`
+	Eps    float64     `json:"eps"`
+	HSys   houseSystem `json:"hsys"`
+}
+
+// HousesARMCResponse are the results of HousesARMC.
+type HousesARMCResponse struct {
+	Cusps []float64 `json:"cusps"`
+	ASCMC []float64 `json:"ascmc"`
+}
+
+// HousesEx2Request are the arguments of HousesEx2.
+type HousesEx2Request struct {
+	UT     float64        `json:"ut"`
+	Flags  *HousesExFlags `json:"flags"`
+	GeoLat float64        `json:"geolat"`
+	GeoLon float64        `json:"geolon"`
+	HSys   houseSystem    `json:"hsys"`
+}
+
+// HousesEx2Response are the results of HousesEx2.
+type HousesEx2Response struct {
+	Houses swego.Houses `json:"houses"`
+}
+
+// HousesARMCEx2Request are the arguments of HousesARMCEx2.
+type HousesARMCEx2Request struct {
+	ARMC   float64     `json:"armc"`
+	GeoLat float64     `json:"geolat"`
+	Eps    float64     `json:"eps"`
+	HSys   houseSystem `json:"hsys"`
+}
+
+// HousesARMCEx2Response are the results of HousesARMCEx2.
+type HousesARMCEx2Response struct {
+	Houses swego.Houses `json:"houses"`
+}
+
+// HousePosRequest are the arguments of HousePos.
+type HousePosRequest struct {
+	ARMC   float64     `json:"armc"`
+	GeoLat float64     `json:"geolat"`
+	Eps    float64     `json:"eps"`
+	HSys   houseSystem `json:"hsys"`
+	PlLng  float64     `json:"pllng"`
+	PlLat  float64     `json:"pllat"`
+}
+
+// HousePosResponse are the results of HousePos.
+type HousePosResponse struct {
+	Pos float64 `json:"pos"`
+}
+
+// GauquelinSectorRequest are the arguments of GauquelinSector.
+type GauquelinSectorRequest struct {
+	UT      float64               `json:"ut"`
+	Body    Body                  `json:"body"`
+	Loc     GeoLoc                `json:"loc"`
+	Method  swego.GauquelinMethod `json:"method"`
+	AtPress float64               `json:"atpress"`
+	AtTemp  float64               `json:"attemp"`
+	Flags   *CalcFlags            `json:"flags"`
+}
+
+// GauquelinSectorResponse are the results of GauquelinSector.
+type GauquelinSectorResponse struct {
+	Sector float64 `json:"sector"`
+}
+
+// HouseNameRequest are the arguments of HouseName.
+type HouseNameRequest struct {
+	HSys houseSystem `json:"hsys"`
+}
+
+// HouseNameResponse are the results of HouseName.
+type HouseNameResponse struct {
+	Name string `json:"name"`
+}
+
+// SolEclipseWhenGlobRequest are the arguments of SolEclipseWhenGlob.
+type SolEclipseWhenGlobRequest struct {
+	UT       float64           `json:"ut"`
+	Flags    *EclipseFlags     `json:"flags"`
+	Type     swego.EclipseType `json:"type"`
+	Backward bool              `json:"backward"`
+}
+
+// SolEclipseWhenGlobResponse are the results of SolEclipseWhenGlob.
+type SolEclipseWhenGlobResponse struct {
+	SolarEclipse swego.SolarEclipse `json:"solarEclipse"`
+}
+
+// SolEclipseWhenLocRequest are the arguments of SolEclipseWhenLoc.
+type SolEclipseWhenLocRequest struct {
+	UT       float64       `json:"ut"`
+	Flags    *EclipseFlags `json:"flags"`
+	Loc      GeoLoc        `json:"loc"`
+	Backward bool          `json:"backward"`
+}
+
+// SolEclipseWhenLocResponse are the results of SolEclipseWhenLoc.
+type SolEclipseWhenLocResponse struct {
+	SolarEclipse swego.SolarEclipse `json:"solarEclipse"`
+}
+
+// SolEclipseWhereRequest are the arguments of SolEclipseWhere.
+type SolEclipseWhereRequest struct {
+	UT    float64       `json:"ut"`
+	Flags *EclipseFlags `json:"flags"`
+}
+
+// SolEclipseWhereResponse are the results of SolEclipseWhere.
+type SolEclipseWhereResponse struct {
+	SolarEclipse swego.SolarEclipse `json:"solarEclipse"`
+}
+
+// SolEclipseHowRequest are the arguments of SolEclipseHow.
+type SolEclipseHowRequest struct {
+	UT    float64       `json:"ut"`
+	Flags *EclipseFlags `json:"flags"`
+	Loc   GeoLoc        `json:"loc"`
+}
+
+// SolEclipseHowResponse are the results of SolEclipseHow.
+type SolEclipseHowResponse struct {
+	SolarEclipse swego.SolarEclipse `json:"solarEclipse"`
+}
+
+// LunOccultWhenGlobRequest are the arguments of LunOccultWhenGlob.
+type LunOccultWhenGlobRequest struct {
+	UT       float64           `json:"ut"`
+	Body     Body              `json:"body"`
+	Flags    *EclipseFlags     `json:"flags"`
+	Type     swego.EclipseType `json:"type"`
+	Backward bool              `json:"backward"`
+}
+
+// LunOccultWhenGlobResponse are the results of LunOccultWhenGlob.
+type LunOccultWhenGlobResponse struct {
+	Occultation swego.Occultation `json:"occultation"`
+}
+
+// LunOccultWhenLocRequest are the arguments of LunOccultWhenLoc.
+type LunOccultWhenLocRequest struct {
+	UT       float64       `json:"ut"`
+	Body     Body          `json:"body"`
+	Flags    *EclipseFlags `json:"flags"`
+	Loc      GeoLoc        `json:"loc"`
+	Backward bool          `json:"backward"`
+}
+
+// LunOccultWhenLocResponse are the results of LunOccultWhenLoc.
+type LunOccultWhenLocResponse struct {
+	Occultation swego.Occultation `json:"occultation"`
+}
+
+// LunOccultWhereRequest are the arguments of LunOccultWhere.
+type LunOccultWhereRequest struct {
+	UT    float64       `json:"ut"`
+	Body  Body          `json:"body"`
+	Flags *EclipseFlags `json:"flags"`
+}
+
+// LunOccultWhereResponse are the results of LunOccultWhere.
+type LunOccultWhereResponse struct {
+	Occultation swego.Occultation `json:"occultation"`
+}
+
+// LunEclipseWhenRequest are the arguments of LunEclipseWhen.
+type LunEclipseWhenRequest struct {
+	UT       float64           `json:"ut"`
+	Flags    *EclipseFlags     `json:"flags"`
+	Type     swego.EclipseType `json:"type"`
+	Backward bool              `json:"backward"`
+}
+
+// LunEclipseWhenResponse are the results of LunEclipseWhen.
+type LunEclipseWhenResponse struct {
+	LunarEclipse swego.LunarEclipse `json:"lunarEclipse"`
+}
+
+// LunEclipseWhenLocRequest are the arguments of LunEclipseWhenLoc.
+type LunEclipseWhenLocRequest struct {
+	UT       float64       `json:"ut"`
+	Flags    *EclipseFlags `json:"flags"`
+	Loc      GeoLoc        `json:"loc"`
+	Backward bool          `json:"backward"`
+}
+
+// LunEclipseWhenLocResponse are the results of LunEclipseWhenLoc.
+type LunEclipseWhenLocResponse struct {
+	LunarEclipse swego.LunarEclipse `json:"lunarEclipse"`
+}
+
+// LunEclipseHowRequest are the arguments of LunEclipseHow.
+type LunEclipseHowRequest struct {
+	UT    float64       `json:"ut"`
+	Flags *EclipseFlags `json:"flags"`
+	Loc   GeoLoc        `json:"loc"`
+}
+
+// LunEclipseHowResponse are the results of LunEclipseHow.
+type LunEclipseHowResponse struct {
+	LunarEclipse swego.LunarEclipse `json:"lunarEclipse"`
+}
+
+// PhenoRequest are the arguments of Pheno.
+type PhenoRequest struct {
+	ET     float64      `json:"et"`
+	Planet swego.Planet `json:"planet"`
+	Flags  *CalcFlags   `json:"flags"`
+}
+
+// PhenoResponse are the results of Pheno.
+type PhenoResponse struct {
+	Phenomena swego.Phenomena `json:"phenomena"`
+}
+
+// PhenoUTRequest are the arguments of PhenoUT.
+type PhenoUTRequest struct {
+	UT     float64      `json:"ut"`
+	Planet swego.Planet `json:"planet"`
+	Flags  *CalcFlags   `json:"flags"`
+}
+
+// PhenoUTResponse are the results of PhenoUT.
+type PhenoUTResponse struct {
+	Phenomena swego.Phenomena `json:"phenomena"`
+}
+
+// RiseTransRequest are the arguments of RiseTrans.
+type RiseTransRequest struct {
+	UT    float64              `json:"ut"`
+	Body  Body                 `json:"body"`
+	Loc   GeoLoc               `json:"loc"`
+	Event swego.RiseTransEvent `json:"event"`
+	Flags *RiseTransFlags      `json:"flags"`
+}
+
+// RiseTransResponse are the results of RiseTrans.
+type RiseTransResponse struct {
+	JD float64 `json:"jd"`
+}
+
+// AzAltRequest are the arguments of AzAlt.
+type AzAltRequest struct {
+	UT    float64         `json:"ut"`
+	Loc   GeoLoc          `json:"loc"`
+	Mode  swego.AzAltMode `json:"mode"`
+	Lng   float64         `json:"lng"`
+	Lat   float64         `json:"lat"`
+	Flags *AzAltFlags     `json:"flags"`
+}
+
+// AzAltResponse are the results of AzAlt.
+type AzAltResponse struct {
+	Az      float64 `json:"az"`
+	TrueAlt float64 `json:"trueAlt"`
+	AppAlt  float64 `json:"appAlt"`
+}
+
+// AzAltRevRequest are the arguments of AzAltRev.
+type AzAltRevRequest struct {
+	UT    float64         `json:"ut"`
+	Loc   GeoLoc          `json:"loc"`
+	Mode  swego.AzAltMode `json:"mode"`
+	Az    float64         `json:"az"`
+	Alt   float64         `json:"alt"`
+	Flags *AzAltFlags     `json:"flags"`
+}
+
+// AzAltRevResponse are the results of AzAltRev.
+type AzAltRevResponse struct {
+	Lng float64 `json:"lng"`
+	Lat float64 `json:"lat"`
+}
+
+// RefracRequest are the arguments of Refrac.
+type RefracRequest struct {
+	Alt     float64          `json:"alt"`
+	AtPress float64          `json:"atpress"`
+	AtTemp  float64          `json:"attemp"`
+	Mode    swego.RefracMode `json:"mode"`
+}
+
+// RefracResponse are the results of Refrac.
+type RefracResponse struct {
+	Alt float64 `json:"alt"`
+}
+
+// RefracExtendedRequest are the arguments of RefracExtended.
+type RefracExtendedRequest struct {
+	Alt       float64          `json:"alt"`
+	GeoAlt    float64          `json:"geoalt"`
+	AtPress   float64          `json:"atpress"`
+	AtTemp    float64          `json:"attemp"`
+	LapseRate float64          `json:"lapseRate"`
+	Mode      swego.RefracMode `json:"mode"`
+}
+
+// RefracExtendedResponse are the results of RefracExtended.
+type RefracExtendedResponse struct {
+	Alt        float64          `json:"alt"`
+	Refraction swego.Refraction `json:"refraction"`
+}
+
+// HeliacalUTRequest are the arguments of HeliacalUT.
+type HeliacalUTRequest struct {
+	UT    float64             `json:"ut"`
+	Loc   GeoLoc              `json:"loc"`
+	Body  Body                `json:"body"`
+	Event swego.HeliacalEvent `json:"event"`
+	Flags *HeliacalFlags      `json:"flags"`
+}
+
+// HeliacalUTResponse are the results of HeliacalUT.
+type HeliacalUTResponse struct {
+	HeliacalTimes swego.HeliacalTimes `json:"heliacalTimes"`
+}
+
+// HeliacalPhenoUTRequest are the arguments of HeliacalPhenoUT.
+type HeliacalPhenoUTRequest struct {
+	UT    float64             `json:"ut"`
+	Loc   GeoLoc              `json:"loc"`
+	Body  Body                `json:"body"`
+	Event swego.HeliacalEvent `json:"event"`
+	Flags *HeliacalFlags      `json:"flags"`
+}
+
+// HeliacalPhenoUTResponse are the results of HeliacalPhenoUT.
+type HeliacalPhenoUTResponse struct {
+	HeliacalPheno swego.HeliacalPheno `json:"heliacalPheno"`
+}
+
+// VisLimitMagRequest are the arguments of VisLimitMag.
+type VisLimitMagRequest struct {
+	UT    float64        `json:"ut"`
+	Loc   GeoLoc         `json:"loc"`
+	Body  Body           `json:"body"`
+	Flags *HeliacalFlags `json:"flags"`
+}
+
+// VisLimitMagResponse are the results of VisLimitMag.
+type VisLimitMagResponse struct {
+	VisLimit swego.VisLimit `json:"visLimit"`
+}
+
+// HeliacalAngleRequest are the arguments of HeliacalAngle.
+type HeliacalAngleRequest struct {
+	UT      float64        `json:"ut"`
+	Loc     GeoLoc         `json:"loc"`
+	Mag     float64        `json:"mag"`
+	ObjAz   float64        `json:"objAz"`
+	SunAz   float64        `json:"sunAz"`
+	MoonAz  float64        `json:"moonAz"`
+	MoonAlt float64        `json:"moonAlt"`
+	Flags   *HeliacalFlags `json:"flags"`
+}
+
+// HeliacalAngleResponse are the results of HeliacalAngle.
+type HeliacalAngleResponse struct {
+	Angle  float64 `json:"angle"`
+	ArcVis float64 `json:"arcVis"`
+	SunAlt float64 `json:"sunAlt"`
+}
+
+// TopoArcusVisionisRequest are the arguments of TopoArcusVisionis.
+type TopoArcusVisionisRequest struct {
+	UT      float64        `json:"ut"`
+	Loc     GeoLoc         `json:"loc"`
+	Mag     float64        `json:"mag"`
+	ObjAz   float64        `json:"objAz"`
+	ObjAlt  float64        `json:"objAlt"`
+	SunAz   float64        `json:"sunAz"`
+	MoonAz  float64        `json:"moonAz"`
+	MoonAlt float64        `json:"moonAlt"`
+	Flags   *HeliacalFlags `json:"flags"`
+}
+
+// TopoArcusVisionisResponse are the results of TopoArcusVisionis.
+type TopoArcusVisionisResponse struct {
+	ArcVis float64 `json:"arcVis"`
+}
+
+// DeltaTExRequest are the arguments of DeltaTEx.
+type DeltaTExRequest struct {
+	JD        float64         `json:"jd"`
+	Ephemeris swego.Ephemeris `json:"eph"`
+}
+
+// DeltaTExResponse are the results of DeltaTEx.
+type DeltaTExResponse struct {
+	DeltaT float64 `json:"deltaT"`
+}
+
+// TimeEquRequest are the arguments of TimeEqu.
+type TimeEquRequest struct {
+	JD    float64       `json:"jd"`
+	Flags *TimeEquFlags `json:"flags"`
+}
+
+// TimeEquResponse are the results of TimeEqu.
+type TimeEquResponse struct {
+	E float64 `json:"e"`
+}
+
+// LMTToLATRequest are the arguments of LMTToLAT.
+type LMTToLATRequest struct {
+	JDLMT  float64       `json:"jdLMT"`
+	GeoLon float64       `json:"geolon"`
+	Flags  *TimeEquFlags `json:"flags"`
+}
+
+// LMTToLATResponse are the results of LMTToLAT.
+type LMTToLATResponse struct {
+	JDLAT float64 `json:"jdLAT"`
+}
+
+// LATToLMTRequest are the arguments of LATToLMT.
+type LATToLMTRequest struct {
+	JDLAT  float64       `json:"jdLAT"`
+	GeoLon float64       `json:"geolon"`
+	Flags  *TimeEquFlags `json:"flags"`
+}
+
+// LATToLMTResponse are the results of LATToLMT.
+type LATToLMTResponse struct {
+	JDLMT float64 `json:"jdLMT"`
+}
+
+// SidTime0Request are the arguments of SidTime0.
+type SidTime0Request struct {
+	UT    float64       `json:"ut"`
+	Eps   float64       `json:"eps"`
+	Nut   float64       `json:"nut"`
+	Flags *SidTimeFlags `json:"flags"`
+}
+
+// SidTime0Response are the results of SidTime0.
+type SidTime0Response struct {
+	SidTime float64 `json:"sidTime"`
+}
+
+// SidTimeRequest are the arguments of SidTime.
+type SidTimeRequest struct {
+	UT    float64       `json:"ut"`
+	Flags *SidTimeFlags `json:"flags"`
+}
+
+// SidTimeResponse are the results of SidTime.
+type SidTimeResponse struct {
+	SidTime float64 `json:"sidTime"`
+}
+
+// CoTransRequest are the arguments of CoTrans.
+type CoTransRequest struct {
+	XPO []float64 `json:"xpo"`
+	Eps float64   `json:"eps"`
+}
+
+// CoTransResponse are the results of CoTrans.
+type CoTransResponse struct {
+	XPN []float64 `json:"xpn"`
+}
+
+// CoTransSpRequest are the arguments of CoTransSp.
+type CoTransSpRequest struct {
+	XPO []float64 `json:"xpo"`
+	Eps float64   `json:"eps"`
+}
+
+// CoTransSpResponse are the results of CoTransSp.
+type CoTransSpResponse struct {
+	XPN []float64 `json:"xpn"`
+}
+
+// SplitDegRequest are the arguments of SplitDeg.
+type SplitDegRequest struct {
+	DDeg      float64 `json:"ddeg"`
+	RoundFlag int     `json:"roundflag"`
+}
+
+// SplitDegResponse are the results of SplitDeg.
+type SplitDegResponse struct {
+	Deg   int32   `json:"deg"`
+	Min   int32   `json:"min"`
+	Sec   int32   `json:"sec"`
+	SecFr float64 `json:"secfr"`
+	Sign  int32   `json:"sign"`
+}
+
+// CalcBatchRequest are the arguments of CalcBatch.
+type CalcBatchRequest struct {
+	Requests []CalcBatchCall `json:"requests"`
+}
+
+// CalcBatchResponse are the results of CalcBatch.
+type CalcBatchResponse struct {
+	Results []CalcBatchResult `json:"results"`
+}
+
+// CalcBatchResult is a swego.CalcResult with the error encoded as string.
+type CalcBatchResult struct {
+	XX    []float64 `json:"xx"`
+	Flags int       `json:"cfl"`
+	Error string    `json:"error,omitempty"`
+}
@@ -0,0 +1,90 @@
+// Command swego-server serves swego.Interface via HTTP with JSON encoded
+// request and response bodies, e.g.
+//
+//	curl -d '{"ut": 2451545, "planet": 4, "flags": {"flags": 260}}' localhost:8080/calc_ut
+//
+// Each method is served at the name of the C function without the swe_ prefix,
+// the OpenAPI document describing all methods is served at /openapi.json.
+// By default the Swiss Ephemeris is called via cgo, flag -worker selects a pool
+// of swerker-stdio workers instead, their statistics are served at /metrics in
+// the Prometheus text format. Flag -grpc additionally serves the gRPC service
+// of package swegrpc.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"path/filepath"
+
+	"github.com/howesteve/swego"
+	"github.com/howesteve/swego/swegrpc"
+	"github.com/howesteve/swego/swerker"
+	"github.com/howesteve/swego/swerker/stdio"
+
+	"google.golang.org/grpc"
+)
+
+// openCgo opens the Swiss Ephemeris via cgo, it is nil if cgo is not
+// available.
+var openCgo func(ephePath string) swego.Interface
+
+func main() {
+	addr := flag.String("addr", ":8080", "listen address")
+	grpcAddr := flag.String("grpc", "", "gRPC listen address, disabled if empty")
+	worker := flag.String("worker", "", "path to swerker-stdio, use cgo if empty")
+	workers := flag.Int("workers", 0, "number of swerker-stdio workers, 0 uses the number of CPUs")
+	ephePath := flag.String("ephe", "", "ephemeris data path, a list of paths like $PATH")
+	flag.Parse()
+
+	var swe swego.Interface
+	var d *stdio.Dispatcher
+	switch {
+	case *worker != "":
+		var err error
+		d, err = stdio.New(*worker, stdio.NumWorkers(*workers), stdio.DataPath(filepath.SplitList(*ephePath)...),
+			stdio.OnExitError(func(err error) { log.Printf("worker exited: %v", err) }),
+			stdio.OnNewError(func(err error) { log.Printf("worker not restarted: %v", err) }))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer d.Close()
+		swe = swerker.NewClient(d)
+	case openCgo != nil:
+		swe = openCgo(*ephePath)
+	default:
+		log.Fatal("swego-server: built without cgo, flag -worker is required")
+	}
+
+	if *grpcAddr != "" {
+		ln, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		s := grpc.NewServer()
+		swegrpc.Register(s, swe)
+		go func() { log.Fatal(s.Serve(ln)) }()
+	}
+
+	var h http.Handler = newServer(swe)
+	if d != nil {
+		mux := http.NewServeMux()
+		mux.Handle("/", h)
+		mux.Handle("/metrics", metricsHandler(d))
+		h = mux
+	}
+
+	log.Fatal(http.ListenAndServe(*addr, h))
+}
+
+// metricsHandler serves the statistics of dispatcher d in the Prometheus text
+// exposition format.
+func metricsHandler(d *stdio.Dispatcher) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		d.Stats().WriteTo(w)
+	})
+}
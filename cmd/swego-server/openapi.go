@@ -0,0 +1,162 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/howesteve/swego"
+)
+
+type object = map[string]interface{}
+
+// openAPIDocument returns an OpenAPI 3.0 document describing endpoints. The
+// schemas are generated from the request and response types.
+func openAPIDocument(endpoints []*endpoint) object {
+	g := &schemaGen{schemas: object{
+		"Error": object{
+			"type":       "object",
+			"properties": object{"error": object{"type": "string"}},
+			"required":   []string{"error"},
+		},
+	}}
+
+	errorResp := func(desc string) object {
+		return object{
+			"description": desc,
+			"content":     object{"application/json": object{"schema": ref("Error")}},
+		}
+	}
+
+	paths := object{}
+	for _, e := range endpoints {
+		unprocessable := "The Swiss Ephemeris reported an error."
+		if e.method == "RiseTrans" {
+			unprocessable = "The Swiss Ephemeris reported an error, or no rise or set is found as the body is circumpolar or never rises at the location."
+		}
+
+		unprocessable += " A result that is not a finite number is reported as error."
+
+		paths["/"+e.path] = object{"post": object{
+			"operationId": e.method,
+			"summary":     "Calls " + e.method + " of swego.Interface.",
+			"requestBody": object{
+				"required": true,
+				"content":  object{"application/json": object{"schema": g.schema(reflect.TypeOf(e.req))}},
+			},
+			"responses": object{
+				"200": object{
+					"description": "The results of " + e.method + ".",
+					"content":     object{"application/json": object{"schema": g.schema(reflect.TypeOf(e.resp))}},
+				},
+				"400": errorResp("The request body is malformed or contains invalid flags."),
+				"422": errorResp(unprocessable),
+				"500": errorResp("The call failed."),
+			},
+		}}
+	}
+
+	paths["/openapi.json"] = object{"get": object{
+		"operationId": "OpenAPI",
+		"summary":     "Returns this document.",
+		"responses": object{
+			"200": object{"description": "The OpenAPI document."},
+		},
+	}}
+
+	return object{
+		"openapi": "3.0.3",
+		"info": object{
+			"title":   "swego",
+			"version": "1.0.0",
+		},
+		"paths":      paths,
+		"components": object{"schemas": g.schemas},
+	}
+}
+
+func ref(name string) object {
+	return object{"$ref": "#/components/schemas/" + name}
+}
+
+// schemaGen generates schemas of Go types. Named struct types are added to
+// schemas and referenced.
+type schemaGen struct {
+	schemas object
+}
+
+var hsysType = reflect.TypeOf(houseSystem(0))
+
+func (g *schemaGen) schema(t reflect.Type) object {
+	if t == hsysType {
+		return object{"type": "string", "minLength": 1, "maxLength": 1}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return object{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return object{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		return object{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return object{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return object{"type": "number", "format": "double"}
+	case reflect.String:
+		return object{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return object{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Ptr:
+		s := g.schema(t.Elem())
+		if _, ok := s["$ref"]; ok {
+			// Sibling keywords of $ref are ignored.
+			s = object{"allOf": []object{s}}
+		}
+
+		s["nullable"] = true
+		return s
+	case reflect.Struct:
+		name := schemaName(t)
+		if _, ok := g.schemas[name]; !ok {
+			g.schemas[name] = nil // break cycles
+			g.schemas[name] = g.structSchema(t)
+		}
+
+		return ref(name)
+	}
+
+	panic("swego-server: unsupported type " + t.String())
+}
+
+func (g *schemaGen) structSchema(t reflect.Type) object {
+	props := object{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue // unexported
+		}
+
+		name := f.Name
+		if tag := strings.Split(f.Tag.Get("json"), ",")[0]; tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		props[name] = g.schema(f.Type)
+	}
+
+	return object{"type": "object", "properties": props}
+}
+
+var swegoPkgPath = reflect.TypeOf(swego.Planet(0)).PkgPath()
+
+// schemaName returns the name of the schema of struct type t. Types of package
+// swego are prefixed to distinguish e.g. swego.CalcRequest from CalcRequest.
+func schemaName(t reflect.Type) string {
+	if t.PkgPath() == swegoPkgPath {
+		return "swego." + t.Name()
+	}
+
+	return t.Name()
+}
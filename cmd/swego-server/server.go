@@ -0,0 +1,234 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"reflect"
+
+	"github.com/howesteve/swego"
+)
+
+// endpoint describes a method of swego.Interface served via HTTP.
+type endpoint struct {
+	path   string      // URL path without the leading slash
+	method string      // name of the swego.Interface method
+	req    interface{} // zero value of the request type
+	resp   interface{} // zero value of the response type
+
+	// call calls the method with request req and returns the response. If
+	// call is nil the method is called via reflection.
+	call func(swe swego.Interface, req interface{}) (interface{}, error)
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// invoke calls the method of e on swe with the fields of req as arguments and
+// returns the results in a value of the response type.
+func (e *endpoint) invoke(swe swego.Interface, req interface{}) (interface{}, error) {
+	if e.call != nil {
+		return e.call(swe, req)
+	}
+
+	fn := reflect.ValueOf(swe).MethodByName(e.method)
+	rv := reflect.ValueOf(req)
+	args := make([]reflect.Value, rv.NumField())
+	for i := range args {
+		arg := rv.Field(i)
+		if c, ok := arg.Interface().(converter); ok {
+			arg = reflect.ValueOf(c.convert())
+		}
+
+		args[i] = arg.Convert(fn.Type().In(i))
+	}
+
+	out := fn.Call(args)
+	if n := len(out); n != 0 && out[n-1].Type() == errorType {
+		if err, _ := out[n-1].Interface().(error); err != nil {
+			return nil, err
+		}
+
+		out = out[:n-1]
+	}
+
+	resp := reflect.New(reflect.TypeOf(e.resp)).Elem()
+	for i, v := range out {
+		resp.Field(i).Set(v)
+	}
+
+	return resp.Interface(), nil
+}
+
+func calcBatch(swe swego.Interface, req interface{}) (interface{}, error) {
+	calls := req.(CalcBatchRequest).Requests
+	requests := make([]swego.CalcRequest, len(calls))
+	for i, c := range calls {
+		requests[i] = swego.CalcRequest{JD: c.JD, UT: c.UT, Planet: c.Planet, Flags: c.Flags.swego()}
+	}
+
+	results := swe.CalcBatch(requests)
+
+	resp := CalcBatchResponse{Results: make([]CalcBatchResult, len(results))}
+	for i, r := range results {
+		resp.Results[i] = CalcBatchResult{XX: r.XX, Flags: r.Flags}
+		if r.Err != nil {
+			resp.Results[i].Error = r.Err.Error()
+		}
+	}
+
+	return resp, nil
+}
+
+// maxBodySize limits the size of a request body.
+const maxBodySize = 1 << 20
+
+// server serves the methods of a swego.Interface via HTTP. The request and
+// response bodies are JSON encoded.
+type server struct {
+	swe       swego.Interface
+	endpoints map[string]*endpoint
+	openAPI   []byte
+}
+
+func newServer(swe swego.Interface) *server {
+	s := &server{
+		swe:       swe,
+		endpoints: make(map[string]*endpoint, len(endpoints)),
+	}
+
+	for _, e := range endpoints {
+		s.endpoints["/"+e.path] = e
+	}
+
+	doc, err := json.Marshal(openAPIDocument(endpoints))
+	if err != nil {
+		panic(err)
+	}
+
+	s.openAPI = doc
+	return s
+}
+
+// errorResponse is the body of a response with an error status code.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/openapi.json" {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(s.openAPI)
+		return
+	}
+
+	e, ok := s.endpoints[r.URL.Path]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	req, err := decodeRequest(w, r, e)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := e.invoke(s.swe, req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		var libErr swego.Error
+		if errors.As(err, &libErr) || errors.As(err, new(*swego.CircumpolarError)) {
+			status = http.StatusUnprocessableEntity
+		} else {
+			log.Printf("%s: %v", e.method, err)
+		}
+
+		writeError(w, status, err.Error())
+		return
+	}
+
+	// JSON has no representation of NaN and infinities.
+	if !finite(reflect.ValueOf(resp)) {
+		writeError(w, http.StatusUnprocessableEntity, "result is not a finite number")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// finite reports whether all floating-point numbers within v are finite.
+func finite(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		return !math.IsNaN(f) && !math.IsInf(f, 0)
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil() || finite(v.Elem())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !finite(v.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !finite(v.Field(i)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// decodeRequest decodes the body of r into a value of the request type of e
+// and validates it. An empty body is treated as an empty JSON object.
+func decodeRequest(w http.ResponseWriter, r *http.Request, e *endpoint) (interface{}, error) {
+	v := reflect.New(reflect.TypeOf(e.req))
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v.Interface()); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if dec.More() {
+		return nil, errors.New("trailing data after JSON object")
+	}
+
+	if err := validate(v.Elem()); err != nil {
+		return nil, err
+	}
+
+	return v.Elem().Interface(), nil
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, errorResponse{msg})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(errorResponse{err.Error()})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(data, '\n'))
+}
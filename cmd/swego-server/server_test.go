@@ -0,0 +1,308 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/howesteve/swego"
+)
+
+// testSwe implements the methods of swego.Interface used by the tests, the
+// other methods panic.
+type testSwe struct {
+	swego.Interface
+	err   error
+	xx    []float64     // result of CalcUT, nil returns 1 to 6
+	calls []interface{} // arguments of the calls
+}
+
+func (swe *testSwe) CalcUT(ut float64, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	swe.calls = append(swe.calls, []interface{}{ut, pl, fl})
+	if swe.err != nil {
+		return nil, -1, swe.err
+	}
+
+	if swe.xx != nil {
+		return swe.xx, int(fl.Flags), nil
+	}
+
+	return []float64{1, 2, 3, 4, 5, 6}, int(fl.Flags), nil
+}
+
+func (swe *testSwe) HouseName(hsys swego.HSys) (string, error) {
+	swe.calls = append(swe.calls, []interface{}{hsys})
+	return "Placidus", nil
+}
+
+func (swe *testSwe) RiseTrans(ut float64, body swego.Body, loc swego.GeoLoc, event swego.RiseTransEvent, fl *swego.RiseTransFlags) (float64, error) {
+	swe.calls = append(swe.calls, []interface{}{ut, body, loc, event, fl})
+	return 0, swe.err
+}
+
+func (swe *testSwe) CalcBatch(requests []swego.CalcRequest) []swego.CalcResult {
+	swe.calls = append(swe.calls, []interface{}{requests})
+	return []swego.CalcResult{
+		{XX: []float64{1, 2, 3, 4, 5, 6}, Flags: 4},
+		{XX: make([]float64, 6), Flags: -1, Err: swego.Error("illegal planet number")},
+	}
+}
+
+func TestEndpoints(t *testing.T) {
+	iface := reflect.TypeOf((*swego.Interface)(nil)).Elem()
+	converterType := reflect.TypeOf((*converter)(nil)).Elem()
+	covered := map[string]bool{"ExclusiveLock": true, "ExclusiveUnlock": true}
+	paths := make(map[string]bool)
+
+	for _, e := range endpoints {
+		if paths[e.path] {
+			t.Errorf("duplicate path %q", e.path)
+		}
+
+		paths[e.path] = true
+		covered[e.method] = true
+
+		m, ok := iface.MethodByName(e.method)
+		if !ok {
+			t.Errorf("%s: method not in swego.Interface", e.method)
+			continue
+		}
+
+		if e.call != nil {
+			continue
+		}
+
+		req := reflect.TypeOf(e.req)
+		if req.NumField() != m.Type.NumIn() {
+			t.Errorf("%s: request has %d fields, want: %d", e.method, req.NumField(), m.Type.NumIn())
+		} else {
+			for i := 0; i < req.NumField(); i++ {
+				f := req.Field(i)
+				typ := f.Type
+				if typ.Implements(converterType) {
+					typ = reflect.TypeOf(reflect.New(typ.Elem()).Interface().(converter).convert())
+				}
+
+				if !typ.ConvertibleTo(m.Type.In(i)) {
+					t.Errorf("%s: request field %s has type %s, want: %s", e.method, f.Name, typ, m.Type.In(i))
+				}
+			}
+		}
+
+		numOut := m.Type.NumOut()
+		if numOut != 0 && m.Type.Out(numOut-1) == errorType {
+			numOut--
+		}
+
+		resp := reflect.TypeOf(e.resp)
+		if resp.NumField() != numOut {
+			t.Errorf("%s: response has %d fields, want: %d", e.method, resp.NumField(), numOut)
+		} else {
+			for i := 0; i < resp.NumField(); i++ {
+				if f := resp.Field(i); f.Type != m.Type.Out(i) {
+					t.Errorf("%s: response field %s has type %s, want: %s", e.method, f.Name, f.Type, m.Type.Out(i))
+				}
+			}
+		}
+	}
+
+	for i := 0; i < iface.NumMethod(); i++ {
+		if name := iface.Method(i).Name; !covered[name] {
+			t.Errorf("%s: no endpoint", name)
+		}
+	}
+}
+
+func post(t *testing.T, h http.Handler, path, body string) (int, string) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	return rec.Code, strings.TrimSpace(rec.Body.String())
+}
+
+func TestServer(t *testing.T) {
+	swe := new(testSwe)
+	s := newServer(swe)
+
+	code, body := post(t, s, "/calc_ut", `{"ut": 2451545, "planet": 4, "flags": {"flags": 260, "topo_loc": {"long": 8.55, "lat": 47.37}, "sid_mode": {"mode": 1}, "delta_t": 0.001}}`)
+	if want := `{"xx":[1,2,3,4,5,6],"cfl":260}`; code != http.StatusOK || body != want {
+		t.Errorf("POST /calc_ut = %d %s, want: 200 %s", code, body, want)
+	}
+
+	fl := &swego.CalcFlags{
+		Flags:   260,
+		TopoLoc: &swego.GeoLoc{Long: 8.55, Lat: 47.37},
+		SidMode: &swego.SidMode{Mode: swego.SidmLahiri},
+	}
+	fl.SetDeltaT(0.001)
+
+	want := []interface{}{2451545.0, swego.Mars, fl}
+	if len(swe.calls) != 1 || !reflect.DeepEqual(swe.calls[0], want) {
+		t.Errorf("CalcUT calls = %v, want: [%v]", swe.calls, want)
+	}
+
+	code, body = post(t, s, "/house_name", `{"hsys": "p"}`)
+	if want := `{"name":"Placidus"}`; code != http.StatusOK || body != want {
+		t.Errorf("POST /house_name = %d %s, want: 200 %s", code, body, want)
+	}
+
+	if want := []interface{}{swego.Placidus}; !reflect.DeepEqual(swe.calls[1], want) {
+		t.Errorf("HouseName args = %v, want: %v", swe.calls[1], want)
+	}
+
+	code, body = post(t, s, "/rise_trans", `{"ut": 2451545, "body": {"star": "Sirius"}, "loc": {"long": 8.55, "lat": 47.37, "alt": 400}, "event": 1, "flags": {"flags": 4, "at_press": 1013.25, "hor_hgt": -1}}`)
+	if code != http.StatusOK {
+		t.Errorf("POST /rise_trans = %d %s, want: 200", code, body)
+	}
+
+	rtFl := &swego.RiseTransFlags{Flags: 4, AtPress: 1013.25}
+	rtFl.SetHorHgt(-1)
+
+	want = []interface{}{2451545.0, swego.Body{Star: "Sirius"}, swego.GeoLoc{Long: 8.55, Lat: 47.37, Alt: 400}, swego.CalcRise, rtFl}
+	if !reflect.DeepEqual(swe.calls[2], want) {
+		t.Errorf("RiseTrans args = %v, want: %v", swe.calls[2], want)
+	}
+}
+
+func TestServer_nilFlags(t *testing.T) {
+	swe := new(testSwe)
+	s := newServer(swe)
+
+	if code, body := post(t, s, "/calc_ut", `{"ut": 2451545, "planet": 0}`); code != http.StatusOK {
+		t.Fatalf("POST /calc_ut = %d %s, want: 200", code, body)
+	}
+
+	if fl := swe.calls[0].([]interface{})[2]; !reflect.DeepEqual(fl, new(swego.CalcFlags)) {
+		t.Errorf("CalcUT fl = %v, want: %v", fl, new(swego.CalcFlags))
+	}
+
+	code, body := post(t, s, "/calc_batch", `{"requests": [{"jd": 2451545, "planet": 0}, {"jd": 2451545, "ut": true, "planet": -5}]}`)
+	if want := `{"results":[{"xx":[1,2,3,4,5,6],"cfl":4},{"xx":[0,0,0,0,0,0],"cfl":-1,"error":"swisseph: illegal planet number"}]}`; code != http.StatusOK || body != want {
+		t.Errorf("POST /calc_batch = %d %s, want: 200 %s", code, body, want)
+	}
+
+	wantRequests := []swego.CalcRequest{
+		{JD: 2451545, Planet: swego.Sun, Flags: new(swego.CalcFlags)},
+		{JD: 2451545, UT: true, Planet: -5, Flags: new(swego.CalcFlags)},
+	}
+
+	if requests := swe.calls[1].([]interface{})[0]; !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("CalcBatch requests = %v, want: %v", requests, wantRequests)
+	}
+}
+
+func TestServer_errors(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		err    error
+		xx     []float64
+		code   int
+	}{
+		{"unknown path", http.MethodPost, "/calc_foo", `{}`, nil, nil, http.StatusNotFound},
+		{"method", http.MethodGet, "/calc_ut", ``, nil, nil, http.StatusMethodNotAllowed},
+		{"malformed", http.MethodPost, "/calc_ut", `{"ut": "now"}`, nil, nil, http.StatusBadRequest},
+		{"unknown field", http.MethodPost, "/calc_ut", `{"jd": 2451545}`, nil, nil, http.StatusBadRequest},
+		{"trailing data", http.MethodPost, "/calc_ut", `{} {}`, nil, nil, http.StatusBadRequest},
+		{"Go field name", http.MethodPost, "/calc_ut", `{"flags": {"TopoLoc": {}}}`, nil, nil, http.StatusBadRequest},
+		{"unknown flag", http.MethodPost, "/calc_ut", `{"flags": {"flags": 128}}`, nil, nil, http.StatusBadRequest},
+		{"ephemerides", http.MethodPost, "/calc_ut", `{"flags": {"flags": 6}}`, nil, nil, http.StatusBadRequest},
+		{"JPL file", http.MethodPost, "/calc_ut", `{"flags": {"jpl_file": "/etc/passwd"}}`, nil, nil, http.StatusBadRequest},
+		{"batch flags", http.MethodPost, "/calc_batch", `{"requests": [{"flags": {"flags": 128}}]}`, nil, nil, http.StatusBadRequest},
+		{"house system", http.MethodPost, "/house_name", `{"hsys": "Z"}`, nil, nil, http.StatusBadRequest},
+		{"calendar", http.MethodPost, "/julday", `{"calendar": 2}`, nil, nil, http.StatusBadRequest},
+		{"library error", http.MethodPost, "/calc_ut", `{}`, swego.Error("illegal planet number"), nil, http.StatusUnprocessableEntity},
+		{"circumpolar", http.MethodPost, "/rise_trans", `{"body": {"planet": 0}, "event": 1}`, &swego.CircumpolarError{Event: swego.CalcRise}, nil, http.StatusUnprocessableEntity},
+		{"NaN", http.MethodPost, "/calc_ut", `{}`, nil, []float64{math.NaN(), 0, 0, 0, 0, 0}, http.StatusUnprocessableEntity},
+		{"infinity", http.MethodPost, "/calc_ut", `{}`, nil, []float64{0, 0, math.Inf(1), 0, 0, 0}, http.StatusUnprocessableEntity},
+		{"call error", http.MethodPost, "/calc_ut", `{}`, errors.New("worker: unexpected exit"), nil, http.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := newServer(&testSwe{err: c.err, xx: c.xx})
+
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, httptest.NewRequest(c.method, c.path, strings.NewReader(c.body)))
+			if rec.Code != c.code {
+				t.Errorf("%s %s = %d, want: %d", c.method, c.path, rec.Code, c.code)
+			}
+
+			var resp errorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || resp.Error == "" {
+				t.Errorf("body = %s, want error response", rec.Body)
+			}
+		})
+	}
+}
+
+func TestOpenAPI(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newServer(new(testSwe)).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET /openapi.json = %d, want: 200", rec.Code)
+	}
+
+	var doc struct {
+		OpenAPI    string                            `json:"openapi"`
+		Paths      map[string]map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}
+
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	for _, e := range endpoints {
+		if _, ok := doc.Paths["/"+e.path]["post"]; !ok {
+			t.Errorf("paths[/%s] has no post operation", e.path)
+		}
+	}
+
+	// all references resolve
+	var check func(v interface{})
+	check = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, v := range v {
+				if ref, ok := v.(string); ok && k == "$ref" {
+					name := strings.TrimPrefix(ref, "#/components/schemas/")
+					if _, ok := doc.Components.Schemas[name]; !ok {
+						t.Errorf("unresolved reference %s", ref)
+					}
+				}
+
+				check(v)
+			}
+		case []interface{}:
+			for _, v := range v {
+				check(v)
+			}
+		}
+	}
+
+	check(doc.Paths)
+	check(doc.Components.Schemas)
+
+	props := doc.Components.Schemas["CalcFlags"].(map[string]interface{})["properties"].(map[string]interface{})
+	for _, name := range []string{"flags", "topo_loc", "sid_mode", "jpl_file", "delta_t"} {
+		if _, ok := props[name]; !ok {
+			t.Errorf("CalcFlags has no property %s", name)
+		}
+	}
+
+	hsys := doc.Components.Schemas["HousesExRequest"].(map[string]interface{})["properties"].(map[string]interface{})["hsys"]
+	if typ := hsys.(map[string]interface{})["type"]; typ != "string" {
+		t.Errorf("HousesExRequest.hsys type = %v, want: string", typ)
+	}
+}
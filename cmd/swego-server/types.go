@@ -0,0 +1,171 @@
+package main
+
+import "github.com/howesteve/swego"
+
+// The types below are the swego types of the method arguments with JSON tags.
+// Types without nested structs are converted to their swego type like the
+// other request fields, the others implement converter.
+
+// converter is implemented by request field types that are not convertible to
+// the argument type of the method.
+type converter interface {
+	// convert returns the argument of the method.
+	convert() interface{}
+}
+
+// GeoLoc is a swego.GeoLoc.
+type GeoLoc struct {
+	Long float64 `json:"long"`
+	Lat  float64 `json:"lat"`
+	Alt  float64 `json:"alt"`
+}
+
+// SidMode is a swego.SidMode.
+type SidMode struct {
+	Mode   swego.Ayanamsa `json:"mode"`
+	T0     float64        `json:"t0"`
+	AyanT0 float64        `json:"ayan_t0"`
+}
+
+// Body is a swego.Body.
+type Body struct {
+	Planet swego.Planet `json:"planet"`
+	Star   string       `json:"star"`
+}
+
+// CalcFlags is a swego.CalcFlags.
+type CalcFlags struct {
+	Flags   int32    `json:"flags"`
+	TopoLoc *GeoLoc  `json:"topo_loc"`
+	SidMode *SidMode `json:"sid_mode"`
+	JPLFile string   `json:"jpl_file"`
+	DeltaT  *float64 `json:"delta_t"`
+}
+
+func (fl *CalcFlags) convert() interface{} { return fl.swego() }
+
+func (fl *CalcFlags) swego() *swego.CalcFlags {
+	return &swego.CalcFlags{
+		Flags:   fl.Flags,
+		TopoLoc: (*swego.GeoLoc)(fl.TopoLoc),
+		SidMode: (*swego.SidMode)(fl.SidMode),
+		JPLFile: fl.JPLFile,
+		DeltaT:  fl.DeltaT,
+	}
+}
+
+// AyanamsaExFlags is a swego.AyanamsaExFlags.
+type AyanamsaExFlags struct {
+	Flags   int32    `json:"flags"`
+	SidMode *SidMode `json:"sid_mode"`
+	DeltaT  *float64 `json:"delta_t"`
+}
+
+func (fl *AyanamsaExFlags) convert() interface{} {
+	return &swego.AyanamsaExFlags{
+		Flags:   fl.Flags,
+		SidMode: (*swego.SidMode)(fl.SidMode),
+		DeltaT:  fl.DeltaT,
+	}
+}
+
+// DateConvertFlags is a swego.DateConvertFlags.
+type DateConvertFlags struct {
+	Calendar swego.CalType `json:"calendar"`
+	DeltaT   *float64      `json:"delta_t"`
+}
+
+// HousesExFlags is a swego.HousesExFlags.
+type HousesExFlags struct {
+	Flags   int32    `json:"flags"`
+	SidMode *SidMode `json:"sid_mode"`
+	DeltaT  *float64 `json:"delta_t"`
+}
+
+func (fl *HousesExFlags) convert() interface{} {
+	return &swego.HousesExFlags{
+		Flags:   fl.Flags,
+		SidMode: (*swego.SidMode)(fl.SidMode),
+		DeltaT:  fl.DeltaT,
+	}
+}
+
+// TimeEquFlags is a swego.TimeEquFlags.
+type TimeEquFlags struct {
+	DeltaT *float64 `json:"delta_t"`
+}
+
+// SidTimeFlags is a swego.SidTimeFlags.
+type SidTimeFlags struct {
+	DeltaT *float64 `json:"delta_t"`
+}
+
+// AzAltFlags is a swego.AzAltFlags.
+type AzAltFlags struct {
+	AtPress   float64  `json:"at_press"`
+	AtTemp    float64  `json:"at_temp"`
+	LapseRate *float64 `json:"lapse_rate"`
+	DeltaT    *float64 `json:"delta_t"`
+}
+
+// EclipseFlags is a swego.EclipseFlags.
+type EclipseFlags struct {
+	Flags   int32    `json:"flags"`
+	JPLFile string   `json:"jpl_file"`
+	DeltaT  *float64 `json:"delta_t"`
+}
+
+// RiseTransFlags is a swego.RiseTransFlags.
+type RiseTransFlags struct {
+	Flags   int32    `json:"flags"`
+	AtPress float64  `json:"at_press"`
+	AtTemp  float64  `json:"at_temp"`
+	HorHgt  *float64 `json:"hor_hgt"`
+	JPLFile string   `json:"jpl_file"`
+	DeltaT  *float64 `json:"delta_t"`
+}
+
+// Atmosphere is a swego.Atmosphere.
+type Atmosphere struct {
+	Pressure    float64 `json:"pressure"`
+	Temperature float64 `json:"temperature"`
+	Humidity    float64 `json:"humidity"`
+	Extinction  float64 `json:"extinction"`
+}
+
+// Observer is a swego.Observer.
+type Observer struct {
+	Age           float64 `json:"age"`
+	SnellenRatio  float64 `json:"snellen_ratio"`
+	Binocular     bool    `json:"binocular"`
+	Magnification float64 `json:"magnification"`
+	Aperture      float64 `json:"aperture"`
+	Transmission  float64 `json:"transmission"`
+}
+
+// HeliacalFlags is a swego.HeliacalFlags.
+type HeliacalFlags struct {
+	Flags      int32      `json:"flags"`
+	Atmosphere Atmosphere `json:"atmosphere"`
+	Observer   Observer   `json:"observer"`
+	JPLFile    string     `json:"jpl_file"`
+	DeltaT     *float64   `json:"delta_t"`
+}
+
+func (fl *HeliacalFlags) convert() interface{} {
+	return &swego.HeliacalFlags{
+		Flags:      fl.Flags,
+		Atmosphere: swego.Atmosphere(fl.Atmosphere),
+		Observer:   swego.Observer(fl.Observer),
+		JPLFile:    fl.JPLFile,
+		DeltaT:     fl.DeltaT,
+	}
+}
+
+// CalcBatchCall is a swego.CalcRequest, a single calculation of CalcBatch.
+type CalcBatchCall struct {
+	JD     float64      `json:"jd"`
+	UT     bool         `json:"ut"`
+	Planet swego.Planet `json:"planet"`
+	Flags  *CalcFlags   `json:"flags"`
+}
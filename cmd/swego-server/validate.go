@@ -0,0 +1,154 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/howesteve/swego"
+)
+
+// houseSystem is a swego.HSys that is encoded as a single character string in
+// JSON, e.g. "P" for Placidus.
+type houseSystem swego.HSys
+
+func (h houseSystem) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(rune(h)))
+}
+
+func (h *houseSystem) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if len(s) != 1 {
+		return fmt.Errorf("invalid house system %q", s)
+	}
+
+	hsys, ok := swego.NewHSys(s[0])
+	if !ok {
+		return fmt.Errorf("invalid house system %q", s)
+	}
+
+	*h = houseSystem(hsys)
+	return nil
+}
+
+// calcFlagsMask contains all calculation flags defined in swephexp.h.
+const calcFlagsMask = swego.FlagEphJPL | swego.FlagEphSwiss | swego.FlagEphMoshier |
+	swego.FlagHelio | swego.FlagTruePos | swego.FlagJ2000 | swego.FlagNoNut |
+	swego.FlagSpeed | swego.FlagNoGDefl | swego.FlagNoAbber | swego.FlagEquatorial |
+	swego.FlagXYZ | swego.FlagRadians | swego.FlagBary | swego.FlagTopo |
+	swego.FlagSidereal | swego.FlagICRS | swego.FlagJPLHor | swego.FlagJPLHorApprox
+
+const ephMask = swego.FlagEphJPL | swego.FlagEphSwiss | swego.FlagEphMoshier
+
+// flagsTypes are the request types of the flags objects of swego.Interface. A missing flags object
+// in a request is replaced by its zero value, not all implementations accept
+// nil flags.
+var flagsTypes = map[reflect.Type]bool{
+	reflect.TypeOf(CalcFlags{}):        true,
+	reflect.TypeOf(AyanamsaExFlags{}):  true,
+	reflect.TypeOf(DateConvertFlags{}): true,
+	reflect.TypeOf(HousesExFlags{}):    true,
+	reflect.TypeOf(TimeEquFlags{}):     true,
+	reflect.TypeOf(SidTimeFlags{}):     true,
+	reflect.TypeOf(AzAltFlags{}):       true,
+	reflect.TypeOf(EclipseFlags{}):     true,
+	reflect.TypeOf(RiseTransFlags{}):   true,
+	reflect.TypeOf(HeliacalFlags{}):    true,
+}
+
+// validate checks the flags objects within request v and replaces nil flags
+// objects by their zero value. The value v must be addressable.
+func validate(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			if !flagsTypes[v.Type().Elem()] {
+				return nil
+			}
+
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		return validate(v.Elem())
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := validate(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if err := validateFlags(v.Addr().Interface()); err != nil {
+			return err
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if err := validate(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateFlags(v interface{}) error {
+	switch fl := v.(type) {
+	case *CalcFlags:
+		return validateCalcFlags(fl.Flags, fl.JPLFile)
+	case *AyanamsaExFlags:
+		return validateCalcFlags(fl.Flags, "")
+	case *HousesExFlags:
+		return validateCalcFlags(fl.Flags, "")
+	case *EclipseFlags:
+		return validateEphemeris(fl.Flags, fl.JPLFile)
+	case *RiseTransFlags:
+		return validateEphemeris(fl.Flags, fl.JPLFile)
+	case *HeliacalFlags:
+		return validateEphemeris(fl.Flags, fl.JPLFile)
+	case *DateConvertFlags:
+		return validateCalendar(fl.Calendar)
+	case *JulDayRequest:
+		return validateCalendar(fl.Calendar)
+	case *RevJulRequest:
+		return validateCalendar(fl.Calendar)
+	}
+
+	return nil
+}
+
+func validateCalcFlags(flags int32, jplFile string) error {
+	if flags&^calcFlagsMask != 0 {
+		return fmt.Errorf("invalid calculation flags %#x", flags&^calcFlagsMask)
+	}
+
+	return validateEphemeris(flags, jplFile)
+}
+
+// validateEphemeris checks that at most one ephemeris is selected in flags.
+// The JPL file name is resolved within the ephemeris path of the server, it
+// may not contain a path.
+func validateEphemeris(flags int32, jplFile string) error {
+	if eph := flags & ephMask; eph&(eph-1) != 0 {
+		return errors.New("multiple ephemeris flags set")
+	}
+
+	if strings.ContainsAny(jplFile, `/\`) || jplFile == ".." {
+		return fmt.Errorf("invalid JPL file name %q", jplFile)
+	}
+
+	return nil
+}
+
+func validateCalendar(ct swego.CalType) error {
+	if ct != swego.Julian && ct != swego.Gregorian {
+		return fmt.Errorf("invalid calendar %d", ct)
+	}
+
+	return nil
+}
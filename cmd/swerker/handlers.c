@@ -52,6 +52,52 @@ static char *mp_put_str(char *data, const char *str) {
   return mp_encode_str(data, str, strlen(str));
 }
 
+// mp_get_doubles decodes an array of at most n doubles into arr, the remaining
+// elements of arr are left untouched.
+static void mp_get_doubles(const char **data, double *arr, size_t n) {
+  uint32_t size = mp_decode_array(data);
+  for (size_t i = 0; i < size; i++) {
+    double v = mp_get_double(data);
+    if (i < n) {
+      arr[i] = v;
+    }
+  }
+}
+
+static char *mp_put_doubles(char *data, const double *arr, uint32_t n) {
+  data = mp_encode_array(data, n);
+  for (size_t i = 0; i < n; i++) {
+    data = mp_encode_double(data, arr[i]);
+  }
+  return data;
+}
+
+// h_rpc_multi executes an array of request envelopes and returns an array with
+// the response of each call. The calls share the library state, so context
+// calls only need to be repeated if the state changes. Calls are executed as
+// long as the response buffer has room for another response, the remaining
+// calls are skipped and must be requested again.
+static char *h_rpc_multi(char *resp, const char **req) {
+  uint32_t n = mp_decode_array(req);
+
+  // The number of executed calls is known afterwards, so space for the largest
+  // array header is reserved.
+  char *hdr = resp;
+  resp += mp_sizeof_array(UINT32_MAX);
+
+  uint32_t i = 0;
+  for (; i < n && rpc_resp_end - resp >= CALLSIZE; i++) {
+    resp = rpc_call(resp, req);
+    if (resp == NULL) {
+      return NULL;
+    }
+  }
+
+  hdr = mp_store_u8(hdr, 0xdd);
+  mp_store_u32(hdr, i);
+  return resp;
+}
+
 static char *h_rpc_funcs(char *resp, __unused const char **req) {
   size_t n = handlers_count();
   resp = mp_encode_array(resp, n);
@@ -126,12 +172,48 @@ static char *h_swe_calc_ut(char *resp, const char **req) {
   return hf_swe_calc(resp, req, swe_calc_ut);
 }
 
+static char *h_swe_calc_pctr(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  int32_t center = (int32_t)mp_get_int(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double xx[6] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_calc_pctr(jd, pl, center, fl, xx, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, xx, 6);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+// mp_get_strn decodes a string into buffer buf of size bytes and terminates
+// it with NUL, longer strings are truncated. The decoded string in the request
+// buffer is not terminated. Returns the length of the copied string.
+static uint32_t mp_get_strn(const char **data, char *buf, size_t size) {
+  uint32_t len = 0;
+  const char *s = mp_decode_str(data, &len);
+  if (len >= size) {
+    len = size - 1;
+  }
+
+  memcpy(buf, s, len);
+  buf[len] = '\0';
+  return len;
+}
+
+// mp_get_star decodes a star name into buffer star of SE_MAX_STNAME bytes.
+// The library writes the resolved star name back into the same buffer.
+static void mp_get_star(const char **data, char *star) {
+  mp_get_strn(data, star, SE_MAX_STNAME);
+}
+
 typedef int32 (* swe_fixstar_func)(char *, double, int32, double *, char *);
 static char *hf_swe_fixstar(char *resp, const char **req, swe_fixstar_func calc) {
-  char star[41] = {0};
-  uint32_t len = 0;
-  const char *name = mp_decode_str(req, &len);
-  strncpy(star, name, len);
+  char star[SE_MAX_STNAME] = {0};
+  mp_get_star(req, star);
 
   double jd = mp_get_double(req);
   int32_t fl = (int32_t)mp_get_int(req);
@@ -159,15 +241,14 @@ static char *h_swe_fixstar_ut(char *resp, const char **req) {
   return hf_swe_fixstar(resp, req, swe_fixstar_ut);
 }
 
-static char *h_swe_fixstar_mag(char *resp, const char **req) {
-  char star[41] = {0};
-  uint32_t len = 0;
-  const char *name = mp_decode_str(req, &len);
-  strncpy(star, name, len);
+typedef int32 (* swe_fixstar_mag_func)(char *, double *, char *);
+static char *hf_swe_fixstar_mag(char *resp, const char **req, swe_fixstar_mag_func calc) {
+  char star[SE_MAX_STNAME] = {0};
+  mp_get_star(req, star);
 
   double mag;
   char err[AS_MAXCH] = {0};
-  int32_t rv = swe_fixstar_mag((char *)star, &mag, err);
+  int32_t rv = calc((char *)star, &mag, err);
 
   resp = mp_encode_array(resp, 4);
   resp = mp_put_str(resp, star);
@@ -177,25 +258,50 @@ static char *h_swe_fixstar_mag(char *resp, const char **req) {
   return resp;
 }
 
+static char *h_swe_fixstar_mag(char *resp, const char **req) {
+  return hf_swe_fixstar_mag(resp, req, swe_fixstar_mag);
+}
+
+static char *h_swe_fixstar2(char *resp, const char **req) {
+  return hf_swe_fixstar(resp, req, swe_fixstar2);
+}
+
+static char *h_swe_fixstar2_ut(char *resp, const char **req) {
+  return hf_swe_fixstar(resp, req, swe_fixstar2_ut);
+}
+
+static char *h_swe_fixstar2_mag(char *resp, const char **req) {
+  return hf_swe_fixstar_mag(resp, req, swe_fixstar2_mag);
+}
+
 static char *h_swe_close(char *resp, __unused const char **req) {
   swe_close();
+
+  if (resp == NULL) {
+    return NULL;
+  }
+
   resp = mp_encode_array(resp, 0);
   return resp;
 }
 
 static char *h_swe_set_ephe_path(char *resp, const char **req) {
-  uint32_t len = 0;
-  const char *path = mp_decode_str(req, &len);
+  char path[AS_MAXCH];
+  mp_get_strn(req, path, sizeof(path));
+
+  swe_set_ephe_path(path);
 
-  swe_set_ephe_path((char *)path);
+  if (resp == NULL) {
+    return NULL;
+  }
 
   resp = mp_encode_array(resp, 0);
   return resp;
 }
 
 static char *h_swe_set_jpl_file(char *resp, const char **req) {
-  uint32_t len = 0;
-  const char *fname = mp_decode_str(req, &len);
+  char fname[AS_MAXCH];
+  uint32_t len = mp_get_strn(req, fname, sizeof(fname));
 
   swex_set_jpl_file_len(fname, len);
 
@@ -257,8 +363,9 @@ static char *hf_swe_get_ayanamsa_ex(char *resp, const char **req, swe_get_ayanam
   char err[AS_MAXCH] = {0};
   int32_t rv = calc(jd, fl, &aya, err);
 
-  resp = mp_encode_array(resp, 2);
+  resp = mp_encode_array(resp, 3);
   resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, aya);
   resp = mp_put_str(resp, err);
   return resp;
 }
@@ -300,168 +407,1240 @@ static char *h_swe_get_ayanamsa_name(char *resp, const char **req) {
   return resp;
 }
 
-// swe_date_conversion
-// swe_julday
-// swe_revjul
-// swe_utc_to_jd
-// swe_jdet_to_utc
-// swe_jdut1_to_utc
-// swe_utc_time_zone
-// swe_houses
-// swe_houses_ex
-// swe_houses_armc
-// swe_house_pos
-// swe_house_name
-// swe_gauquelin_sector
-// swe_sol_eclipse_where
-// swe_lun_occult_where
-// swe_sol_eclipse_how
-// swe_sol_eclipse_when_loc
-// swe_lun_occult_when_loc
-// swe_sol_eclipse_when_glob
-// swe_lun_occult_when_glob
-// swe_lun_eclipse_how
-// swe_lun_eclipse_when
-// swe_lun_eclipse_when_loc
-// swe_pheno
-// swe_pheno_ut
-// swe_refrac
-// swe_refrac_extended
-// swe_set_lapse_rate /* context */
-// swe_azalt
-// swe_azalt_rev
-// swe_rise_trans_true_hor
-// swe_rise_trans
-// swe_nod_aps
-// swe_nod_aps_ut
-// swe_get_orbital_elements
-// swe_orbit_max_min_true_distance
-// swe_deltat
-// swe_deltat_ex
-// swe_time_equ
-// swe_lmt_to_lat
-// swe_lat_to_lmt
-// swe_sidtime0
-// swe_sidtime
-// swe_set_interpolate_nut
-// swe_cotrans
-// swe_cotrans_sp
-// swe_get_tid_acc
-// swe_set_tid_acc /* context */
-// swe_set_delta_t_userdef /* context */
-// swe_degnorm
-// swe_radnorm
-// swe_rad_midp
-// swe_deg_midp
-// swe_split_deg
-// swe_heliacal_ut
-// swe_heliacal_pheno_ut
-// swe_vis_limit_mag
-// swe_heliacal_angle
-// swe_topo_arcus_visionis
-// swe_day_of_week
+static char *h_swe_date_conversion(char *resp, const char **req) {
+  int y = (int)mp_get_int(req);
+  int m = (int)mp_get_int(req);
+  int d = (int)mp_get_int(req);
+  double h = mp_get_double(req);
+  int gf = (int)mp_get_int(req);
 
-static handler_t handlers[] = {
-  {"rpc_funcs",              0, false, h_rpc_funcs}, // keep this always on top!
-  {"test_crash",             0, false, h_test_crash},
-  {"test_error",             0, false, h_test_error},
-  {"swe_version",            0, false, h_swe_version},
-  {"swe_calc",               3, false, h_swe_calc},
-  {"swe_calc_ut",            3, false, h_swe_calc_ut},
-  {"swe_fixstar",            3, false, h_swe_fixstar},
-  {"swe_fixstar_ut",         3, false, h_swe_fixstar_ut},
-  {"swe_fixstar_mag",        1, false, h_swe_fixstar_mag},
-  {"swe_close",              0, true,  h_swe_close},         /* context */
-  {"swe_set_ephe_path",      1, true,  h_swe_set_ephe_path}, /* context */
-  {"swe_set_jpl_file",       1, true,  h_swe_set_jpl_file},  /* context */
-  {"swe_get_planet_name",    1, false, h_swe_get_planet_name},
-  {"swe_set_topo",           3, true,  h_swe_set_topo},      /* context */
-  {"swe_set_sid_mode",       3, true,  h_swe_set_sid_mode},  /* context */
+  double jd = 0;
+  int rv = swe_date_conversion(y, m, d, h, gf == SE_GREG_CAL ? 'g' : 'j', &jd);
 
-#if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 2
-  {"swe_get_ayanamsa_ex",    2, false, h_swe_get_ayanamsa_ex},
-  {"swe_get_ayanamsa_ex_ut", 2, false, h_swe_get_ayanamsa_ex_ut},
-#endif
+  resp = mp_encode_array(resp, 2);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, jd);
+  return resp;
+}
 
-  {"swe_get_ayanamsa",       2, false, h_swe_get_ayanamsa},
-  {"swe_get_ayanamsa_ut",    2, false, h_swe_get_ayanamsa_ut},
-  {"swe_get_ayanamsa_name",  2, false, h_swe_get_ayanamsa_name},
-  // swe_date_conversion
-  // swe_julday
-  // swe_revjul
-  // swe_utc_to_jd
-  // swe_jdet_to_utc
-  // swe_jdut1_to_utc
-  // swe_utc_time_zone
-  // swe_houses
-  // swe_houses_ex
-  // swe_houses_armc
-  // swe_house_pos
-  // swe_house_name
-  // swe_gauquelin_sector
-  // swe_sol_eclipse_where
-  // swe_lun_occult_where
-  // swe_sol_eclipse_how
-  // swe_sol_eclipse_when_loc
-  // swe_lun_occult_when_loc
-  // swe_sol_eclipse_when_glob
-  // swe_lun_occult_when_glob
-  // swe_lun_eclipse_how
-  // swe_lun_eclipse_when
-  // swe_lun_eclipse_when_loc
-  // swe_pheno
-  // swe_pheno_ut
-  // swe_refrac
-  // swe_refrac_extended
-  // swe_set_lapse_rate /* context */
-  // swe_azalt
-  // swe_azalt_rev
-  // swe_rise_trans_true_hor
-  // swe_rise_trans
-  // swe_nod_aps
-  // swe_nod_aps_ut
+static char *h_swe_julday(char *resp, const char **req) {
+  int y = (int)mp_get_int(req);
+  int m = (int)mp_get_int(req);
+  int d = (int)mp_get_int(req);
+  double h = mp_get_double(req);
+  int gf = (int)mp_get_int(req);
 
-#if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 5
-  // swe_get_orbital_elements
-  // swe_orbit_max_min_true_distance
+  double jd = swe_julday(y, m, d, h, gf);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_encode_double(resp, jd);
+  return resp;
+}
+
+static char *h_swe_revjul(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int gf = (int)mp_get_int(req);
+
+  int y, m, d;
+  double h;
+  swe_revjul(jd, gf, &y, &m, &d, &h);
+
+  resp = mp_encode_array(resp, 4);
+  resp = mp_put_int(resp, y);
+  resp = mp_put_int(resp, m);
+  resp = mp_put_int(resp, d);
+  resp = mp_encode_double(resp, h);
+  return resp;
+}
+
+static char *h_swe_utc_to_jd(char *resp, const char **req) {
+  int32_t y = (int32_t)mp_get_int(req);
+  int32_t m = (int32_t)mp_get_int(req);
+  int32_t d = (int32_t)mp_get_int(req);
+  int32_t h = (int32_t)mp_get_int(req);
+  int32_t i = (int32_t)mp_get_int(req);
+  double s = mp_get_double(req);
+  int32_t gf = (int32_t)mp_get_int(req);
+
+  double dret[2] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_utc_to_jd(y, m, d, h, i, s, gf, dret, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_array(resp, 2);
+  resp = mp_encode_double(resp, dret[0]);
+  resp = mp_encode_double(resp, dret[1]);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+typedef void (* swe_jd_to_utc_func)(double, int32, int32 *, int32 *, int32 *, int32 *, int32 *, double *);
+static char *hf_swe_jd_to_utc(char *resp, const char **req, swe_jd_to_utc_func conv) {
+  double jd = mp_get_double(req);
+  int32_t gf = (int32_t)mp_get_int(req);
+
+  int32 y, m, d, h, i;
+  double s;
+  conv(jd, gf, &y, &m, &d, &h, &i, &s);
+
+  resp = mp_encode_array(resp, 6);
+  resp = mp_put_int(resp, y);
+  resp = mp_put_int(resp, m);
+  resp = mp_put_int(resp, d);
+  resp = mp_put_int(resp, h);
+  resp = mp_put_int(resp, i);
+  resp = mp_encode_double(resp, s);
+  return resp;
+}
+
+static char *h_swe_jdet_to_utc(char *resp, const char **req) {
+  return hf_swe_jd_to_utc(resp, req, swe_jdet_to_utc);
+}
+
+static char *h_swe_jdut1_to_utc(char *resp, const char **req) {
+  return hf_swe_jd_to_utc(resp, req, swe_jdut1_to_utc);
+}
+
+static char *h_swe_utc_time_zone(char *resp, const char **req) {
+  int32_t y = (int32_t)mp_get_int(req);
+  int32_t m = (int32_t)mp_get_int(req);
+  int32_t d = (int32_t)mp_get_int(req);
+  int32_t h = (int32_t)mp_get_int(req);
+  int32_t i = (int32_t)mp_get_int(req);
+  double s = mp_get_double(req);
+  double tz = mp_get_double(req);
+
+  int32 oy, om, od, oh, oi;
+  double os;
+  swe_utc_time_zone(y, m, d, h, i, s, tz, &oy, &om, &od, &oh, &oi, &os);
+
+  resp = mp_encode_array(resp, 6);
+  resp = mp_put_int(resp, oy);
+  resp = mp_put_int(resp, om);
+  resp = mp_put_int(resp, od);
+  resp = mp_put_int(resp, oh);
+  resp = mp_put_int(resp, oi);
+  resp = mp_encode_double(resp, os);
+  return resp;
+}
+
+static char *mp_put_houses(char *resp, int hsys, double *cusps, double *ascmc) {
+  size_t n = 13;
+  if (hsys == 'G' || hsys == 'g') {
+    n = 37;
+  }
+
+  resp = mp_encode_array(resp, n);
+  for (size_t i = 0; i < n; i++) {
+    resp = mp_encode_double(resp, cusps[i]);
+  }
+
+  resp = mp_encode_array(resp, 10);
+  for (size_t i = 0; i < 10; i++) {
+    resp = mp_encode_double(resp, ascmc[i]);
+  }
+
+  return resp;
+}
+
+static char *h_swe_houses(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  double geolat = mp_get_double(req);
+  double geolon = mp_get_double(req);
+  int hsys = (int)mp_get_int(req);
+
+  double cusps[37] = {0};
+  double ascmc[10] = {0};
+  int rv = swe_houses(jd, geolat, geolon, hsys, cusps, ascmc);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_houses(resp, hsys, cusps, ascmc);
+  return resp;
+}
+
+static char *h_swe_houses_ex(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geolat = mp_get_double(req);
+  double geolon = mp_get_double(req);
+  int hsys = (int)mp_get_int(req);
+
+  double cusps[37] = {0};
+  double ascmc[10] = {0};
+  int rv = swe_houses_ex(jd, fl, geolat, geolon, hsys, cusps, ascmc);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_houses(resp, hsys, cusps, ascmc);
+  return resp;
+}
+
+static char *h_swe_houses_armc(char *resp, const char **req) {
+  double armc = mp_get_double(req);
+  double geolat = mp_get_double(req);
+  double eps = mp_get_double(req);
+  int hsys = (int)mp_get_int(req);
+
+  double cusps[37] = {0};
+  double ascmc[10] = {0};
+  int rv = swe_houses_armc(armc, geolat, eps, hsys, cusps, ascmc);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_houses(resp, hsys, cusps, ascmc);
+  return resp;
+}
+
+static char *h_swe_houses_ex2(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geolat = mp_get_double(req);
+  double geolon = mp_get_double(req);
+  int hsys = (int)mp_get_int(req);
+
+  double cusps[37] = {0};
+  double ascmc[10] = {0};
+  double cusp_speed[37] = {0};
+  double ascmc_speed[10] = {0};
+  char err[AS_MAXCH] = {0};
+  int rv = swe_houses_ex2(jd, fl, geolat, geolon, hsys, cusps, ascmc, cusp_speed, ascmc_speed, err);
+
+  resp = mp_encode_array(resp, 6);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_houses(resp, hsys, cusps, ascmc);
+  resp = mp_put_houses(resp, hsys, cusp_speed, ascmc_speed);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_houses_armc_ex2(char *resp, const char **req) {
+  double armc = mp_get_double(req);
+  double geolat = mp_get_double(req);
+  double eps = mp_get_double(req);
+  int hsys = (int)mp_get_int(req);
+
+  double cusps[37] = {0};
+  double ascmc[10] = {0};
+  double cusp_speed[37] = {0};
+  double ascmc_speed[10] = {0};
+  char err[AS_MAXCH] = {0};
+  int rv = swe_houses_armc_ex2(armc, geolat, eps, hsys, cusps, ascmc, cusp_speed, ascmc_speed, err);
+
+  resp = mp_encode_array(resp, 6);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_houses(resp, hsys, cusps, ascmc);
+  resp = mp_put_houses(resp, hsys, cusp_speed, ascmc_speed);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_house_pos(char *resp, const char **req) {
+  double armc = mp_get_double(req);
+  double geolat = mp_get_double(req);
+  double eps = mp_get_double(req);
+  int hsys = (int)mp_get_int(req);
+
+  double xpin[2] = {0};
+  mp_get_doubles(req, xpin, 2);
+
+  char err[AS_MAXCH] = {0};
+  double pos = swe_house_pos(armc, geolat, eps, hsys, xpin, err);
+
+  resp = mp_encode_array(resp, 2);
+  resp = mp_encode_double(resp, pos);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_house_name(char *resp, const char **req) {
+  int hsys = (int)mp_get_int(req);
+
+  const char *name = swe_house_name(hsys);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_put_str(resp, name);
+  return resp;
+}
+
+static char *h_swe_gauquelin_sector(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  char star[SE_MAX_STNAME] = {0};
+  mp_get_star(req, star);
+  int32_t fl = (int32_t)mp_get_int(req);
+  int32_t imeth = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  double atpress = mp_get_double(req);
+  double attemp = mp_get_double(req);
+
+  double sect = 0;
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_gauquelin_sector(jd, pl, star, fl, imeth, geopos, atpress, attemp, &sect, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, sect);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_sol_eclipse_where(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double geopos[10] = {0};
+  double attr[20] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_sol_eclipse_where(jd, fl, geopos, attr, err);
+
+  resp = mp_encode_array(resp, 4);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, geopos, 10);
+  resp = mp_put_doubles(resp, attr, 20);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_sol_eclipse_how(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+
+  double attr[20] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_sol_eclipse_how(jd, fl, geopos, attr, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, attr, 20);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_sol_eclipse_when_loc(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  int32_t backward = (int32_t)mp_get_int(req);
+
+  double tret[10] = {0};
+  double attr[20] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_sol_eclipse_when_loc(jd, fl, geopos, tret, attr, backward, err);
+
+  resp = mp_encode_array(resp, 4);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, tret, 10);
+  resp = mp_put_doubles(resp, attr, 20);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_sol_eclipse_when_glob(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  int32_t type = (int32_t)mp_get_int(req);
+  int32_t backward = (int32_t)mp_get_int(req);
+
+  double tret[10] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_sol_eclipse_when_glob(jd, fl, type, tret, backward, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, tret, 10);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_lun_occult_where(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  char star[SE_MAX_STNAME] = {0};
+  mp_get_star(req, star);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double geopos[10] = {0};
+  double attr[20] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_lun_occult_where(jd, pl, star, fl, geopos, attr, err);
+
+  resp = mp_encode_array(resp, 4);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, geopos, 10);
+  resp = mp_put_doubles(resp, attr, 20);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_lun_occult_when_loc(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  char star[SE_MAX_STNAME] = {0};
+  mp_get_star(req, star);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  int32_t backward = (int32_t)mp_get_int(req);
+
+  double tret[10] = {0};
+  double attr[20] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_lun_occult_when_loc(jd, pl, star, fl, geopos, tret, attr, backward, err);
+
+  resp = mp_encode_array(resp, 4);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, tret, 10);
+  resp = mp_put_doubles(resp, attr, 20);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_lun_occult_when_glob(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  char star[SE_MAX_STNAME] = {0};
+  mp_get_star(req, star);
+  int32_t fl = (int32_t)mp_get_int(req);
+  int32_t type = (int32_t)mp_get_int(req);
+  int32_t backward = (int32_t)mp_get_int(req);
+
+  double tret[10] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_lun_occult_when_glob(jd, pl, star, fl, type, tret, backward, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, tret, 10);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_lun_eclipse_how(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+
+  double attr[20] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_lun_eclipse_how(jd, fl, geopos, attr, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, attr, 20);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_lun_eclipse_when(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  int32_t type = (int32_t)mp_get_int(req);
+  int32_t backward = (int32_t)mp_get_int(req);
+
+  double tret[10] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_lun_eclipse_when(jd, fl, type, tret, backward, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, tret, 10);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_lun_eclipse_when_loc(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  int32_t backward = (int32_t)mp_get_int(req);
+
+  double tret[10] = {0};
+  double attr[20] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_lun_eclipse_when_loc(jd, fl, geopos, tret, attr, backward, err);
+
+  resp = mp_encode_array(resp, 4);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, tret, 10);
+  resp = mp_put_doubles(resp, attr, 20);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *hf_swe_pheno(char *resp, const char **req, swe_calc_func pheno) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double attr[20] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = pheno(jd, pl, fl, attr, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, attr, 20);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_pheno(char *resp, const char **req) {
+  return hf_swe_pheno(resp, req, swe_pheno);
+}
+
+static char *h_swe_pheno_ut(char *resp, const char **req) {
+  return hf_swe_pheno(resp, req, swe_pheno_ut);
+}
+
+static char *h_swe_refrac(char *resp, const char **req) {
+  double inalt = mp_get_double(req);
+  double atpress = mp_get_double(req);
+  double attemp = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double alt = swe_refrac(inalt, atpress, attemp, fl);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_encode_double(resp, alt);
+  return resp;
+}
+
+static char *h_swe_refrac_extended(char *resp, const char **req) {
+  double inalt = mp_get_double(req);
+  double geoalt = mp_get_double(req);
+  double atpress = mp_get_double(req);
+  double attemp = mp_get_double(req);
+  double lapse_rate = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double dret[4] = {0};
+  double alt = swe_refrac_extended(inalt, geoalt, atpress, attemp, lapse_rate, fl, dret);
+
+  resp = mp_encode_array(resp, 2);
+  resp = mp_encode_double(resp, alt);
+  resp = mp_put_doubles(resp, dret, 4);
+  return resp;
+}
+
+static char *h_swe_set_lapse_rate(char *resp, const char **req) {
+  double lapse_rate = mp_get_double(req);
+
+  swe_set_lapse_rate(lapse_rate);
+
+  if (resp == NULL) {
+    return NULL;
+  }
+
+  resp = mp_encode_array(resp, 0);
+  return resp;
+}
+
+static char *h_swe_azalt(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  double atpress = mp_get_double(req);
+  double attemp = mp_get_double(req);
+  double xin[3] = {0};
+  mp_get_doubles(req, xin, 3);
+
+  double xaz[3] = {0};
+  swe_azalt(jd, fl, geopos, atpress, attemp, xin, xaz);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_put_doubles(resp, xaz, 3);
+  return resp;
+}
+
+static char *h_swe_azalt_rev(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  double xin[2] = {0};
+  mp_get_doubles(req, xin, 2);
+
+  double xout[3] = {0};
+  swe_azalt_rev(jd, fl, geopos, xin, xout);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_put_doubles(resp, xout, 2);
+  return resp;
+}
+
+static char *hf_swe_rise_trans(char *resp, const char **req, bool true_hor) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  char star[SE_MAX_STNAME] = {0};
+  mp_get_star(req, star);
+  int32_t fl = (int32_t)mp_get_int(req);
+  int32_t rsmi = (int32_t)mp_get_int(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  double atpress = mp_get_double(req);
+  double attemp = mp_get_double(req);
+  double horhgt = true_hor ? mp_get_double(req) : 0;
+
+  double tret[10] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv;
+  if (true_hor) {
+    rv = swe_rise_trans_true_hor(jd, pl, star, fl, rsmi, geopos, atpress, attemp, horhgt, tret, err);
+  } else {
+    rv = swe_rise_trans(jd, pl, star, fl, rsmi, geopos, atpress, attemp, tret, err);
+  }
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, tret[0]);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_rise_trans_true_hor(char *resp, const char **req) {
+  return hf_swe_rise_trans(resp, req, true);
+}
+
+static char *h_swe_rise_trans(char *resp, const char **req) {
+  return hf_swe_rise_trans(resp, req, false);
+}
+
+typedef int32 (* swe_nod_aps_func)(double, int32, int32, int32, double *, double *, double *, double *, char *);
+static char *hf_swe_nod_aps(char *resp, const char **req, swe_nod_aps_func calc) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  int32_t m = (int32_t)mp_get_int(req);
+
+  double xx[4][6] = {{0}};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = calc(jd, pl, fl, m, xx[0], xx[1], xx[2], xx[3], err);
+
+  resp = mp_encode_array(resp, 6);
+  resp = mp_put_int(resp, rv);
+  for (size_t i = 0; i < 4; i++) {
+    resp = mp_encode_array(resp, 6);
+    for (size_t j = 0; j < 6; j++) {
+      resp = mp_encode_double(resp, xx[i][j]);
+    }
+  }
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_nod_aps(char *resp, const char **req) {
+  return hf_swe_nod_aps(resp, req, swe_nod_aps);
+}
+
+static char *h_swe_nod_aps_ut(char *resp, const char **req) {
+  return hf_swe_nod_aps(resp, req, swe_nod_aps_ut);
+}
+
+static char *h_swe_get_orbital_elements(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double dret[50] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_get_orbital_elements(jd, pl, fl, dret, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, dret, 50);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_orbit_max_min_true_distance(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t pl = (int32_t)mp_get_int(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double dmax = 0, dmin = 0, dtrue = 0;
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_orbit_max_min_true_distance(jd, pl, fl, &dmax, &dmin, &dtrue, err);
+
+  resp = mp_encode_array(resp, 5);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, dmax);
+  resp = mp_encode_double(resp, dmin);
+  resp = mp_encode_double(resp, dtrue);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+typedef double (* swe_cross_func)(double, double, int32, char *);
+static char *hf_swe_cross(char *resp, const char **req, swe_cross_func cross) {
+  double x2cross = mp_get_double(req);
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  char err[AS_MAXCH] = {0};
+  double t = cross(x2cross, jd, fl, err);
+
+  resp = mp_encode_array(resp, 2);
+  resp = mp_encode_double(resp, t);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_solcross(char *resp, const char **req) {
+  return hf_swe_cross(resp, req, swe_solcross);
+}
+
+static char *h_swe_solcross_ut(char *resp, const char **req) {
+  return hf_swe_cross(resp, req, swe_solcross_ut);
+}
+
+static char *h_swe_mooncross(char *resp, const char **req) {
+  return hf_swe_cross(resp, req, swe_mooncross);
+}
+
+static char *h_swe_mooncross_ut(char *resp, const char **req) {
+  return hf_swe_cross(resp, req, swe_mooncross_ut);
+}
+
+typedef double (* swe_mooncross_node_func)(double, int32, double *, double *, char *);
+static char *hf_swe_mooncross_node(char *resp, const char **req, swe_mooncross_node_func cross) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double lng = 0, lat = 0;
+  char err[AS_MAXCH] = {0};
+  double t = cross(jd, fl, &lng, &lat, err);
+
+  resp = mp_encode_array(resp, 4);
+  resp = mp_encode_double(resp, t);
+  resp = mp_encode_double(resp, lng);
+  resp = mp_encode_double(resp, lat);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_mooncross_node(char *resp, const char **req) {
+  return hf_swe_mooncross_node(resp, req, swe_mooncross_node);
+}
+
+static char *h_swe_mooncross_node_ut(char *resp, const char **req) {
+  return hf_swe_mooncross_node(resp, req, swe_mooncross_node_ut);
+}
+
+typedef int32 (* swe_helio_cross_func)(int32, double, double, int32, int32, double *, char *);
+static char *hf_swe_helio_cross(char *resp, const char **req, swe_helio_cross_func cross) {
+  int32_t pl = (int32_t)mp_get_int(req);
+  double x2cross = mp_get_double(req);
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+  int32_t dir = (int32_t)mp_get_int(req);
+
+  double t = 0;
+  char err[AS_MAXCH] = {0};
+  int32_t rv = cross(pl, x2cross, jd, fl, dir, &t, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, t);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_helio_cross(char *resp, const char **req) {
+  return hf_swe_helio_cross(resp, req, swe_helio_cross);
+}
+
+static char *h_swe_helio_cross_ut(char *resp, const char **req) {
+  return hf_swe_helio_cross(resp, req, swe_helio_cross_ut);
+}
+
+static char *h_swe_deltat(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+
+  double dt = swe_deltat(jd);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_encode_double(resp, dt);
+  return resp;
+}
+
+static char *h_swe_deltat_ex(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  char err[AS_MAXCH] = {0};
+  double dt = swe_deltat_ex(jd, fl, err);
+
+  resp = mp_encode_array(resp, 2);
+  resp = mp_encode_double(resp, dt);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_time_equ(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+
+  double e = 0;
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_time_equ(jd, &e, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, e);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+typedef int32 (* swe_lmt_lat_func)(double, double, double *, char *);
+static char *hf_swe_lmt_lat(char *resp, const char **req, swe_lmt_lat_func conv) {
+  double jd = mp_get_double(req);
+  double geolon = mp_get_double(req);
+
+  double ret = 0;
+  char err[AS_MAXCH] = {0};
+  int32_t rv = conv(jd, geolon, &ret, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, ret);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_lmt_to_lat(char *resp, const char **req) {
+  return hf_swe_lmt_lat(resp, req, swe_lmt_to_lat);
+}
+
+static char *h_swe_lat_to_lmt(char *resp, const char **req) {
+  return hf_swe_lmt_lat(resp, req, swe_lat_to_lmt);
+}
+
+static char *h_swe_sidtime0(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  double eps = mp_get_double(req);
+  double nut = mp_get_double(req);
+
+  double st = swe_sidtime0(jd, eps, nut);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_encode_double(resp, st);
+  return resp;
+}
+
+static char *h_swe_sidtime(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+
+  double st = swe_sidtime(jd);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_encode_double(resp, st);
+  return resp;
+}
+
+static char *h_swe_set_interpolate_nut(char *resp, const char **req) {
+  AS_BOOL interpolate = mp_get_int(req) != 0;
+
+  swe_set_interpolate_nut(interpolate);
+
+  if (resp == NULL) {
+    return NULL;
+  }
+
+  resp = mp_encode_array(resp, 0);
+  return resp;
+}
+
+static char *h_swe_set_delta_t_userdef(char *resp, const char **req) {
+  double dt = mp_get_double(req);
+
+  swe_set_delta_t_userdef(dt);
+
+  if (resp == NULL) {
+    return NULL;
+  }
+
+  resp = mp_encode_array(resp, 0);
+  return resp;
+}
+
+typedef void (* swe_cotrans_func)(double *, double *, double);
+static char *hf_swe_cotrans(char *resp, const char **req, swe_cotrans_func cotrans, size_t n) {
+  double xpo[6] = {0};
+  mp_get_doubles(req, xpo, n);
+  double eps = mp_get_double(req);
+
+  double xpn[6] = {0};
+  cotrans(xpo, xpn, eps);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_put_doubles(resp, xpn, n);
+  return resp;
+}
+
+static char *h_swe_cotrans(char *resp, const char **req) {
+  return hf_swe_cotrans(resp, req, swe_cotrans, 3);
+}
+
+static char *h_swe_cotrans_sp(char *resp, const char **req) {
+  return hf_swe_cotrans(resp, req, swe_cotrans_sp, 6);
+}
+
+static char *h_swe_get_tid_acc(char *resp, __unused const char **req) {
+  double tid_acc = swe_get_tid_acc();
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_encode_double(resp, tid_acc);
+  return resp;
+}
+
+static char *h_swe_set_tid_acc(char *resp, const char **req) {
+  double tid_acc = mp_get_double(req);
+
+  swe_set_tid_acc(tid_acc);
+
+  if (resp == NULL) {
+    return NULL;
+  }
+
+  resp = mp_encode_array(resp, 0);
+  return resp;
+}
+
+typedef double (* swe_norm_func)(double);
+static char *hf_swe_norm(char *resp, const char **req, swe_norm_func norm) {
+  double x = mp_get_double(req);
+
+  double ret = norm(x);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_encode_double(resp, ret);
+  return resp;
+}
+
+static char *h_swe_degnorm(char *resp, const char **req) {
+  return hf_swe_norm(resp, req, swe_degnorm);
+}
+
+static char *h_swe_radnorm(char *resp, const char **req) {
+  return hf_swe_norm(resp, req, swe_radnorm);
+}
+
+typedef double (* swe_dif_func)(double, double);
+static char *hf_swe_dif(char *resp, const char **req, swe_dif_func dif) {
+  double x1 = mp_get_double(req);
+  double x0 = mp_get_double(req);
+
+  double ret = dif(x1, x0);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_encode_double(resp, ret);
+  return resp;
+}
+
+static char *h_swe_rad_midp(char *resp, const char **req) {
+  return hf_swe_dif(resp, req, swe_rad_midp);
+}
+
+static char *h_swe_deg_midp(char *resp, const char **req) {
+  return hf_swe_dif(resp, req, swe_deg_midp);
+}
+
+static char *h_swe_split_deg(char *resp, const char **req) {
+  double ddeg = mp_get_double(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  int32 deg, min, sec, sgn;
+  double secfr;
+  swe_split_deg(ddeg, fl, &deg, &min, &sec, &secfr, &sgn);
+
+  resp = mp_encode_array(resp, 5);
+  resp = mp_put_int(resp, deg);
+  resp = mp_put_int(resp, min);
+  resp = mp_put_int(resp, sec);
+  resp = mp_encode_double(resp, secfr);
+  resp = mp_put_int(resp, sgn);
+  return resp;
+}
+
+typedef int32 (* swe_heliacal_func)(double, double *, double *, double *, char *, int32, int32, double *, char *);
+static char *hf_swe_heliacal(char *resp, const char **req, swe_heliacal_func calc) {
+  double jd = mp_get_double(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  double datm[4] = {0};
+  mp_get_doubles(req, datm, 4);
+  double dobs[6] = {0};
+  mp_get_doubles(req, dobs, 6);
+  char name[SE_MAX_STNAME] = {0};
+  mp_get_star(req, name);
+  int32_t event = (int32_t)mp_get_int(req);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double dret[50] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = calc(jd, geopos, datm, dobs, name, event, fl, dret, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, dret, 50);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_heliacal_ut(char *resp, const char **req) {
+  return hf_swe_heliacal(resp, req, swe_heliacal_ut);
+}
+
+static char *h_swe_heliacal_pheno_ut(char *resp, const char **req) {
+  return hf_swe_heliacal(resp, req, swe_heliacal_pheno_ut);
+}
+
+static char *h_swe_vis_limit_mag(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  double datm[4] = {0};
+  mp_get_doubles(req, datm, 4);
+  double dobs[6] = {0};
+  mp_get_doubles(req, dobs, 6);
+  char name[SE_MAX_STNAME] = {0};
+  mp_get_star(req, name);
+  int32_t fl = (int32_t)mp_get_int(req);
+
+  double dret[8] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_vis_limit_mag(jd, geopos, datm, dobs, name, fl, dret, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, dret, 8);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_heliacal_angle(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  double datm[4] = {0};
+  mp_get_doubles(req, datm, 4);
+  double dobs[6] = {0};
+  mp_get_doubles(req, dobs, 6);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double mag = mp_get_double(req);
+  double azi_obj = mp_get_double(req);
+  double azi_sun = mp_get_double(req);
+  double azi_moon = mp_get_double(req);
+  double alt_moon = mp_get_double(req);
+
+  double dret[3] = {0};
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_heliacal_angle(jd, geopos, datm, dobs, fl, mag, azi_obj, azi_sun, azi_moon, alt_moon, dret, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_put_doubles(resp, dret, 3);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_topo_arcus_visionis(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+  double geopos[3] = {0};
+  mp_get_doubles(req, geopos, 3);
+  double datm[4] = {0};
+  mp_get_doubles(req, datm, 4);
+  double dobs[6] = {0};
+  mp_get_doubles(req, dobs, 6);
+  int32_t fl = (int32_t)mp_get_int(req);
+  double mag = mp_get_double(req);
+  double azi_obj = mp_get_double(req);
+  double alt_obj = mp_get_double(req);
+  double azi_sun = mp_get_double(req);
+  double azi_moon = mp_get_double(req);
+  double alt_moon = mp_get_double(req);
+
+  double dret = 0;
+  char err[AS_MAXCH] = {0};
+  int32_t rv = swe_topo_arcus_visionis(jd, geopos, datm, dobs, fl, mag, azi_obj, alt_obj, azi_sun, azi_moon, alt_moon, &dret, err);
+
+  resp = mp_encode_array(resp, 3);
+  resp = mp_put_int(resp, rv);
+  resp = mp_encode_double(resp, dret);
+  resp = mp_put_str(resp, err);
+  return resp;
+}
+
+static char *h_swe_difdegn(char *resp, const char **req) {
+  return hf_swe_dif(resp, req, swe_difdegn);
+}
+
+static char *h_swe_difdeg2n(char *resp, const char **req) {
+  return hf_swe_dif(resp, req, swe_difdeg2n);
+}
+
+static char *h_swe_difrad2n(char *resp, const char **req) {
+  return hf_swe_dif(resp, req, swe_difrad2n);
+}
+
+static char *h_swe_d2l(char *resp, const char **req) {
+  double x = mp_get_double(req);
+
+  int32 l = swe_d2l(x);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_put_int(resp, l);
+  return resp;
+}
+
+static char *h_swe_day_of_week(char *resp, const char **req) {
+  double jd = mp_get_double(req);
+
+  int dow = swe_day_of_week(jd);
+
+  resp = mp_encode_array(resp, 1);
+  resp = mp_put_int(resp, dow);
+  return resp;
+}
+
+static handler_t handlers[] = {
+  {"rpc_funcs",              0, false, h_rpc_funcs}, // keep this always on top!
+  {"rpc_multi",              1, false, h_rpc_multi},
+  {"test_crash",             0, false, h_test_crash},
+  {"test_error",             0, false, h_test_error},
+  {"swe_version",            0, false, h_swe_version},
+  {"swe_calc",               3, false, h_swe_calc},
+  {"swe_calc_ut",            3, false, h_swe_calc_ut},
+
+#if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 7
+  {"swe_calc_pctr",          4, false, h_swe_calc_pctr},
+#endif
+
+  {"swe_fixstar",            3, false, h_swe_fixstar},
+  {"swe_fixstar_ut",         3, false, h_swe_fixstar_ut},
+  {"swe_fixstar_mag",        1, false, h_swe_fixstar_mag},
+
+#if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 7
+  {"swe_fixstar2",           3, false, h_swe_fixstar2},
+  {"swe_fixstar2_ut",        3, false, h_swe_fixstar2_ut},
+  {"swe_fixstar2_mag",       1, false, h_swe_fixstar2_mag},
+#endif
+
+  {"swe_close",              0, true,  h_swe_close},         /* context */
+  {"swe_set_ephe_path",      1, true,  h_swe_set_ephe_path}, /* context */
+  {"swe_set_jpl_file",       1, true,  h_swe_set_jpl_file},  /* context */
+  {"swe_get_planet_name",    1, false, h_swe_get_planet_name},
+  {"swe_set_topo",           3, true,  h_swe_set_topo},      /* context */
+  {"swe_set_sid_mode",       3, true,  h_swe_set_sid_mode},  /* context */
+
+#if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 2
+  {"swe_get_ayanamsa_ex",    2, false, h_swe_get_ayanamsa_ex},
+  {"swe_get_ayanamsa_ex_ut", 2, false, h_swe_get_ayanamsa_ex_ut},
+#endif
+
+  {"swe_get_ayanamsa",       1, false, h_swe_get_ayanamsa},
+  {"swe_get_ayanamsa_ut",    1, false, h_swe_get_ayanamsa_ut},
+  {"swe_get_ayanamsa_name",  1, false, h_swe_get_ayanamsa_name},
+  {"swe_date_conversion",    5, false, h_swe_date_conversion},
+  {"swe_julday",             5, false, h_swe_julday},
+  {"swe_revjul",             2, false, h_swe_revjul},
+  {"swe_utc_to_jd",          7, false, h_swe_utc_to_jd},
+  {"swe_jdet_to_utc",        2, false, h_swe_jdet_to_utc},
+  {"swe_jdut1_to_utc",       2, false, h_swe_jdut1_to_utc},
+  {"swe_utc_time_zone",      7, false, h_swe_utc_time_zone},
+  {"swe_houses",             4, false, h_swe_houses},
+  {"swe_houses_ex",          5, false, h_swe_houses_ex},
+  {"swe_houses_armc",        4, false, h_swe_houses_armc},
+
+#if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 8
+  {"swe_houses_ex2",         5, false, h_swe_houses_ex2},
+  {"swe_houses_armc_ex2",    4, false, h_swe_houses_armc_ex2},
+#endif
+
+  {"swe_house_pos",          5, false, h_swe_house_pos},
+  {"swe_house_name",         1, false, h_swe_house_name},
+  {"swe_gauquelin_sector",   8, false, h_swe_gauquelin_sector},
+  {"swe_sol_eclipse_where",  2, false, h_swe_sol_eclipse_where},
+  {"swe_lun_occult_where",   4, false, h_swe_lun_occult_where},
+  {"swe_sol_eclipse_how",    3, false, h_swe_sol_eclipse_how},
+  {"swe_sol_eclipse_when_loc", 4, false, h_swe_sol_eclipse_when_loc},
+  {"swe_lun_occult_when_loc", 6, false, h_swe_lun_occult_when_loc},
+  {"swe_sol_eclipse_when_glob", 4, false, h_swe_sol_eclipse_when_glob},
+  {"swe_lun_occult_when_glob", 6, false, h_swe_lun_occult_when_glob},
+  {"swe_lun_eclipse_how",    3, false, h_swe_lun_eclipse_how},
+  {"swe_lun_eclipse_when",   4, false, h_swe_lun_eclipse_when},
+  {"swe_lun_eclipse_when_loc", 4, false, h_swe_lun_eclipse_when_loc},
+  {"swe_pheno",              3, false, h_swe_pheno},
+  {"swe_pheno_ut",           3, false, h_swe_pheno_ut},
+  {"swe_refrac",             4, false, h_swe_refrac},
+  {"swe_refrac_extended",    6, false, h_swe_refrac_extended},
+  {"swe_set_lapse_rate",     1, true,  h_swe_set_lapse_rate}, /* context */
+  {"swe_azalt",              6, false, h_swe_azalt},
+  {"swe_azalt_rev",          4, false, h_swe_azalt_rev},
+  {"swe_rise_trans_true_hor", 9, false, h_swe_rise_trans_true_hor},
+  {"swe_rise_trans",         8, false, h_swe_rise_trans},
+  {"swe_nod_aps",            4, false, h_swe_nod_aps},
+  {"swe_nod_aps_ut",         4, false, h_swe_nod_aps_ut},
+
+#if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 5
+  {"swe_get_orbital_elements", 3, false, h_swe_get_orbital_elements},
+  {"swe_orbit_max_min_true_distance", 3, false, h_swe_orbit_max_min_true_distance},
+#endif
+
+#if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 10
+  {"swe_solcross",           3, false, h_swe_solcross},
+  {"swe_solcross_ut",        3, false, h_swe_solcross_ut},
+  {"swe_mooncross",          3, false, h_swe_mooncross},
+  {"swe_mooncross_ut",       3, false, h_swe_mooncross_ut},
+  {"swe_mooncross_node",     2, false, h_swe_mooncross_node},
+  {"swe_mooncross_node_ut",  2, false, h_swe_mooncross_node_ut},
+  {"swe_helio_cross",        5, false, h_swe_helio_cross},
+  {"swe_helio_cross_ut",     5, false, h_swe_helio_cross_ut},
 #endif
 
-  // swe_deltat
-  // swe_deltat_ex
-  // swe_time_equ
-  // swe_lmt_to_lat
-  // swe_lat_to_lmt
-  // swe_sidtime0
-  // swe_sidtime
+  {"swe_deltat",             1, false, h_swe_deltat},
+  {"swe_deltat_ex",          2, false, h_swe_deltat_ex},
+  {"swe_time_equ",           1, false, h_swe_time_equ},
+  {"swe_lmt_to_lat",         2, false, h_swe_lmt_to_lat},
+  {"swe_lat_to_lmt",         2, false, h_swe_lat_to_lmt},
+  {"swe_sidtime0",           3, false, h_swe_sidtime0},
+  {"swe_sidtime",            1, false, h_swe_sidtime},
 
 #if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 6
-  // swe_set_interpolate_nut /* context */
+  {"swe_set_interpolate_nut", 1, true, h_swe_set_interpolate_nut}, /* context */
 #endif
 
-  // swe_cotrans
-  // swe_cotrans_sp
-  // swe_get_tid_acc
-  // swe_set_tid_acc /* context */
+  {"swe_cotrans",            2, false, h_swe_cotrans},
+  {"swe_cotrans_sp",         2, false, h_swe_cotrans_sp},
+  {"swe_get_tid_acc",        0, false, h_swe_get_tid_acc},
+  {"swe_set_tid_acc",        1, true,  h_swe_set_tid_acc}, /* context */
 
 #if SWEX_VERSION_MAJOR == 2 && SWEX_VERSION_MINOR >= 5
-  // swe_set_delta_t_userdef /* context */
+  {"swe_set_delta_t_userdef", 1, true, h_swe_set_delta_t_userdef}, /* context */
 #endif
 
-  // swe_degnorm
-  // swe_radnorm
-  // swe_rad_midp
-  // swe_deg_midp
-  // swe_split_deg
-  // swe_heliacal_ut
-  // swe_heliacal_pheno_ut
-  // swe_vis_limit_mag
-  // swe_heliacal_angle
-  // swe_topo_arcus_visionis
-  // swe_difdegn
-  // swe_difdeg2n
-  // swe_difrad2n
-  // swe_d2l
-  // swe_day_of_week
+  {"swe_degnorm",            1, false, h_swe_degnorm},
+  {"swe_radnorm",            1, false, h_swe_radnorm},
+  {"swe_rad_midp",           2, false, h_swe_rad_midp},
+  {"swe_deg_midp",           2, false, h_swe_deg_midp},
+  {"swe_split_deg",          2, false, h_swe_split_deg},
+  {"swe_heliacal_ut",        7, false, h_swe_heliacal_ut},
+  {"swe_heliacal_pheno_ut",  7, false, h_swe_heliacal_pheno_ut},
+  {"swe_vis_limit_mag",      6, false, h_swe_vis_limit_mag},
+  {"swe_heliacal_angle",     10, false, h_swe_heliacal_angle},
+  {"swe_topo_arcus_visionis", 11, false, h_swe_topo_arcus_visionis},
+  {"swe_difdegn",            2, false, h_swe_difdegn},
+  {"swe_difdeg2n",           2, false, h_swe_difdeg2n},
+  {"swe_difrad2n",           2, false, h_swe_difrad2n},
+  {"swe_d2l",                1, false, h_swe_d2l},
+  {"swe_day_of_week",        1, false, h_swe_day_of_week},
 };
 
 size_t handlers_count() {
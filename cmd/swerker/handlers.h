@@ -14,6 +14,14 @@ struct handler {
   handler_callback_t callback;
 };
 
+// rpc_call executes the request envelope in req and writes the response to
+// resp. It returns NULL if the request is invalid, the error is reported by
+// the transport. It is implemented by the worker.
+char *rpc_call(char *resp, const char **req);
+
+// rpc_resp_end points to the end of the response buffer.
+extern const char *rpc_resp_end;
+
 void handlers_init();
 size_t handlers_count();
 handler_t *handlers_get(size_t idx);
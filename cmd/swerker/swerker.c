@@ -12,142 +12,146 @@
 #include "tr.h"
 #include "handlers.h"
 
-int main(int argc, char const *argv[]) {
-  handlers_init();
-  tr_init(argc, argv);
-
-  char req[REQSIZE];
-  char resp[RESPSIZE];
-
-  while (true) {
-nextreq:
-    memset(req, 0, REQSIZE);
-    memset(resp, 0, RESPSIZE);
-    const char *reqbuf = req;
-    char *respbuf = resp;
-
-    reqbuf = tr_recv(req);
-    if (reqbuf == NULL) {
-      goto nextreq;
-    }
-
-    const char *input = (char *)req;
-    if (!mp_check(&input, reqbuf)) {
-      tr_error("input is not valid msgpack", NULL, 0);
-      goto nextreq;
-    }
-
-    // Reset pointer to start of request buffer.
-    reqbuf = req;
+// The buffers are too large for the stack.
+static char req[REQSIZE];
+static char resp[RESPSIZE];
+
+const char *rpc_resp_end = resp + RESPSIZE;
+
+// rpc_err holds the error of the last failed call, it is reported by main.
+static struct {
+  const char *msg;
+  char dbg[DBGSIZE];
+  size_t dbglen;
+} rpc_err;
+
+static char *rpc_fail(const char *msg) {
+  rpc_err.msg = msg;
+  return NULL;
+}
 
-    uint32_t fields = mp_decode_array(&reqbuf);
-    if (fields != 3) {
-      char dbg[DBGSIZE];
-      size_t dbglen = 0;
+char *rpc_call(char *respbuf, const char **reqbuf) {
+  uint32_t fields = mp_decode_array(reqbuf);
+  if (fields != 3) {
 #if DEBUG
-      dbglen = sprintf(dbg, "size=%u", fields);
+    rpc_err.dbglen = sprintf(rpc_err.dbg, "size=%u", fields);
 #endif
-      tr_error("array with 3 values expected (envelope)", dbg, dbglen);
-      goto nextreq;
-    }
+    return rpc_fail("array with 3 values expected (envelope)");
+  }
 
-    // Execute context calls first.
-    // The type of the context value is either array or nil.
-    if (mp_typeof(*reqbuf) == MP_NIL) {
-      mp_decode_nil(&reqbuf);
-    } else {
-      uint32_t size = mp_decode_array(&reqbuf);
-      for (size_t i = 0; i < size; i++) {
-        uint32_t fields = mp_decode_array(&reqbuf);
-        if (fields != 2) {
-          char dbg[DBGSIZE];
-          size_t dbglen = 0;
+  // Execute context calls first.
+  // The type of the context value is either array or nil.
+  if (mp_typeof(**reqbuf) == MP_NIL) {
+    mp_decode_nil(reqbuf);
+  } else {
+    uint32_t size = mp_decode_array(reqbuf);
+    for (size_t i = 0; i < size; i++) {
+      uint32_t fields = mp_decode_array(reqbuf);
+      if (fields != 2) {
 #if DEBUG
-          dbglen = sprintf(dbg, "size=%u", fields);
+        rpc_err.dbglen = sprintf(rpc_err.dbg, "size=%u", fields);
 #endif
-          tr_error("array with 2 values expected (ccall envelope)", dbg, dbglen);
-          goto nextreq;
-        }
+        return rpc_fail("array with 2 values expected (ccall envelope)");
+      }
 
-        uint8_t idx = mp_load_u8(&reqbuf);
-        handler_t *h = handlers_get(idx);
-        if (h == NULL) {
-          char dbg[DBGSIZE];
-          size_t dbglen = 0;
+      uint8_t idx = mp_load_u8(reqbuf);
+      handler_t *h = handlers_get(idx);
+      if (h == NULL) {
 #if DEBUG
-          dbglen = sprintf(dbg, "func=%u", idx);
+        rpc_err.dbglen = sprintf(rpc_err.dbg, "func=%u", idx);
 #endif
-          tr_error("invalid index (ccall function)", dbg, dbglen);
-          goto nextreq;
-        }
+        return rpc_fail("invalid index (ccall function)");
+      }
 
-        // The type of the arguments value is either array or nil.
-        if (mp_typeof(*reqbuf) == MP_NIL) {
-          mp_decode_nil(&reqbuf);
-        } else {
-          uint32_t argc = mp_decode_array(&reqbuf);
-          if (h->argc != argc) {
-            char dbg[DBGSIZE];
-            size_t dbglen = 0;
+      // The type of the arguments value is either array or nil.
+      if (mp_typeof(**reqbuf) == MP_NIL) {
+        mp_decode_nil(reqbuf);
+      } else {
+        uint32_t argc = mp_decode_array(reqbuf);
+        if (h->argc != argc) {
 #if DEBUG
-            dbglen = sprintf(dbg, "func=%u(%s) argc=%zu/%u", idx, h->name, h->argc, argc);
+          rpc_err.dbglen = sprintf(rpc_err.dbg, "func=%u(%s) argc=%zu/%u", idx, h->name, h->argc, argc);
 #endif
-            tr_error("invalid number of arguments (ccall function)", dbg, dbglen);
-            goto nextreq;
-          }
+          return rpc_fail("invalid number of arguments (ccall function)");
         }
+      }
 
-        if (!h->ccall) {
-          char dbg[DBGSIZE];
-          size_t dbglen = 0;
+      if (!h->ccall) {
 #if DEBUG
-            dbglen = sprintf(dbg, "func=%u(%s)", idx, h->name);
+        rpc_err.dbglen = sprintf(rpc_err.dbg, "func=%u(%s)", idx, h->name);
 #endif
-            tr_error("function is invalid as context call", dbg, dbglen);
-        }
-
-        h->callback(NULL, &reqbuf);
+        return rpc_fail("function is invalid as context call");
       }
+
+      h->callback(NULL, reqbuf);
     }
+  }
 
-    // Execute actual call.
-    uint8_t idx = mp_load_u8(&reqbuf);
-    handler_t *h = handlers_get(idx);
-    if (h == NULL) {
-      char dbg[DBGSIZE];
-      size_t dbglen = 0;
+  // Execute actual call.
+  uint8_t idx = mp_load_u8(reqbuf);
+  handler_t *h = handlers_get(idx);
+  if (h == NULL) {
 #if DEBUG
-      dbglen = sprintf(dbg, "func=%u", idx);
+    rpc_err.dbglen = sprintf(rpc_err.dbg, "func=%u", idx);
 #endif
-      tr_error("invalid index (function)", dbg, dbglen);
-      goto nextreq;
-    }
+    return rpc_fail("invalid index (function)");
+  }
 
-    // The type of the arguments value is either array or nil.
-    if (mp_typeof(*reqbuf) == MP_NIL) {
-      // If the type is nil, invalidate the request buffer.
-      reqbuf = NULL;
-    } else {
-      uint32_t argc = mp_decode_array(&reqbuf);
-      if (h->argc != argc) {
-        char dbg[DBGSIZE];
-        size_t dbglen = 0;
+  // The type of the arguments value is either array or nil. Handlers without
+  // arguments get a NULL request buffer.
+  const char *args = NULL;
+  const char **argsbuf = reqbuf;
+  if (mp_typeof(**reqbuf) == MP_NIL) {
+    mp_decode_nil(reqbuf);
+    argsbuf = &args;
+  } else {
+    uint32_t argc = mp_decode_array(reqbuf);
+    if (h->argc != argc) {
 #if DEBUG
-        dbglen = sprintf(dbg, "func=%u(%s) argc=%zu/%u", idx, h->name, h->argc, argc);
+      rpc_err.dbglen = sprintf(rpc_err.dbg, "func=%u(%s) argc=%zu/%u", idx, h->name, h->argc, argc);
 #endif
-        tr_error("invalid number of arguments", dbg, dbglen);
-        goto nextreq;
-      }
+      return rpc_fail("invalid number of arguments");
     }
+  }
 
-    respbuf = h->callback(respbuf, &reqbuf);
-    if (respbuf == NULL) {
-      char dbg[DBGSIZE];
-      size_t dbglen = 0;
+  respbuf = h->callback(respbuf, argsbuf);
+  if (respbuf == NULL && rpc_err.msg == NULL) {
 #if DEBUG
-      dbglen = sprintf(dbg, "func=%u(%s)", idx, h->name);
+    rpc_err.dbglen = sprintf(rpc_err.dbg, "func=%u(%s)", idx, h->name);
 #endif
-      tr_error("function call failed", dbg, dbglen);
+    return rpc_fail("function call failed");
+  }
+
+  return respbuf;
+}
+
+int main(int argc, char const *argv[]) {
+  handlers_init();
+  tr_init(argc, argv);
+
+  while (true) {
+nextreq:
+    memset(&rpc_err, 0, sizeof(rpc_err));
+    const char *reqbuf = req;
+    char *respbuf = resp;
+
+    reqbuf = tr_recv(req);
+    if (reqbuf == NULL) {
+      goto nextreq;
+    }
+
+    const char *input = (char *)req;
+    if (!mp_check(&input, reqbuf)) {
+      tr_error("input is not valid msgpack", NULL, 0);
+      goto nextreq;
+    }
+
+    // Reset pointer to start of request buffer.
+    reqbuf = req;
+
+    respbuf = rpc_call(respbuf, &reqbuf);
+    if (respbuf == NULL) {
+      tr_error(rpc_err.msg, rpc_err.dbg, rpc_err.dbglen);
       goto nextreq;
     }
 
@@ -0,0 +1,149 @@
+#include <stdlib.h>
+#include <stdio.h>
+#include <string.h>
+
+#include "tr.h"
+#include "handlers.h"
+
+// The Lich framing is shared by the transports, they only differ in how the
+// input and output streams are set up by tr_init.
+FILE *tr_in;
+FILE *tr_out;
+
+bool tr_send_funcs() {
+  // Same as calling rpc_funcs function (index 0).
+  static char data[CALLSIZE];
+  char *buf = data;
+  handler_t *h = handlers_get(0);
+  buf = h->callback(buf, NULL);
+
+  return tr_send(data, buf);
+}
+
+char *tr_recv(char *buf) {
+  int c = fgetc(tr_in);
+  if (c == EOF || c == '\n') {
+    exit(EXIT_SUCCESS);
+  }
+
+  uint64_t len = 0;
+  while ('0' <= c && c <= '9') {
+    len *= 10;
+    len += c - '0';
+
+    c = fgetc(tr_in);
+    if (c == EOF) {
+      char dbg[DBGSIZE];
+      size_t dbglen = 0;
+#if DEBUG
+      dbglen = sprintf(dbg, "len=%llu", len);
+#endif
+      tr_error("reading unexpected EOF (length)", dbg, dbglen);
+      return NULL;
+    }
+  }
+
+  // We limit input data to REQSIZE bytes to protect against buffer overflows
+  // and unbounded buffer allocations.
+  if (len > REQSIZE) {
+    char dbg[DBGSIZE];
+    size_t dbglen = 0;
+#if DEBUG
+    dbglen = sprintf(dbg, "len=%llu, limit=%d", len, REQSIZE);
+#endif
+    tr_error("input data is more than request size limit", dbg, dbglen);
+    return NULL;
+  }
+
+  // char is already received in while loop
+  if (c != '<') {
+    char dbg[DBGSIZE];
+    size_t dbglen = 0;
+#if DEBUG
+    dbglen = sprintf(dbg, "c='%c' c=%d", c, c);
+#endif
+    tr_error("reading unexpected open type marker", dbg, dbglen);
+    return NULL;
+  }
+
+  size_t n = 0;
+  while (n < len) {
+    c = fgetc(tr_in);
+    if (c == EOF) {
+      tr_error("reading unexpected EOF (body)", NULL, 0);
+      return NULL;
+    }
+
+    buf[n++] = c;
+  }
+
+  c = fgetc(tr_in);
+  if (c != '>') {
+    char dbg[DBGSIZE];
+    size_t dbglen = 0;
+#if DEBUG
+    dbglen = sprintf(dbg, "c='%c' c=%d", c, c);
+#endif
+    tr_error("reading unexpected close type marker", dbg, dbglen);
+    return NULL;
+  }
+
+  if (len == 0) {
+    tr_error("input data expected", NULL, 0);
+    return NULL;
+  }
+
+  return buf;
+}
+
+bool tr_send(char *data, char *end) {
+  size_t len = end - data;
+
+  int n = fprintf(tr_out, "%lu<", len);
+  fwrite(data, len, sizeof(char), tr_out);
+  putc('>', tr_out);
+
+  if (n < 0 || ferror(tr_out)) {
+    // Write to the output is failed, so write error to stderr as last resort.
+#if DEBUG
+    fprintf(stderr, "DEBUG: len=%zu data=", len);
+    fwrite(data, len, sizeof(char), stderr);
+    putc('\n', stderr);
+#endif
+    fprintf(stderr, "ERROR: failed to write reponse\n");
+    return false;
+  }
+
+  if (fflush(tr_out) != 0) {
+    // Flush to the output is failed, so write error to stderr as last resort.
+    fprintf(stderr, "ERROR: failed to flush reponse\n");
+    return false;
+  }
+
+  return true;
+}
+
+void tr_error(const char *msg, const char *dbg, size_t dbglen) {
+  char data[CALLSIZE];
+  char *buf = data;
+
+  int size = 1;
+#if DEBUG
+  if (dbglen != 0) {
+    size = 2;
+  }
+#endif
+
+  buf = mp_encode_map(buf, size);
+  buf = mp_encode_str(buf, "err", 3);
+  buf = mp_encode_str(buf, msg, strlen(msg));
+
+#if DEBUG
+  if (dbglen != 0) {
+    buf = mp_encode_str(buf, "dbg", 3);
+    buf = mp_encode_str(buf, dbg, dbglen);
+  }
+#endif
+
+  tr_send(data, buf);
+}
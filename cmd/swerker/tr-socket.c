@@ -0,0 +1,243 @@
+#include <errno.h>
+#include <stdlib.h>
+#include <stdio.h>
+#include <string.h>
+#include <unistd.h>
+#include <signal.h>
+#include <netdb.h>
+#include <sys/socket.h>
+#include <sys/un.h>
+#include <sys/wait.h>
+
+#include "tr.h"
+#include "handlers.h"
+
+static void usage(const char *prog) {
+  fprintf(stderr, "usage: %s -unix path | -tcp [host]:port [-n max]\n", prog);
+  exit(EXIT_FAILURE);
+}
+
+static int listen_unix(const char *path) {
+  struct sockaddr_un addr = {0};
+  if (strlen(path) >= sizeof(addr.sun_path)) {
+    fprintf(stderr, "ERROR: socket path too long\n");
+    return -1;
+  }
+
+  addr.sun_family = AF_UNIX;
+  strcpy(addr.sun_path, path);
+  unlink(path);
+
+  int fd = socket(AF_UNIX, SOCK_STREAM, 0);
+  if (fd < 0) {
+    perror("ERROR: socket");
+    return -1;
+  }
+
+  if (bind(fd, (struct sockaddr *)&addr, sizeof(addr)) != 0) {
+    perror("ERROR: bind");
+    close(fd);
+    return -1;
+  }
+
+  return fd;
+}
+
+static int listen_tcp(const char *hostport) {
+  char host[256] = {0};
+  const char *port = strrchr(hostport, ':');
+  if (port == NULL || port - hostport >= sizeof(host)) {
+    fprintf(stderr, "ERROR: invalid address %s\n", hostport);
+    return -1;
+  }
+
+  strncpy(host, hostport, port - hostport);
+  port++;
+
+  struct addrinfo hints = {0};
+  hints.ai_family = AF_UNSPEC;
+  hints.ai_socktype = SOCK_STREAM;
+  hints.ai_flags = AI_PASSIVE;
+
+  struct addrinfo *res;
+  int rv = getaddrinfo(host[0] == '\0' ? NULL : host, port, &hints, &res);
+  if (rv != 0) {
+    fprintf(stderr, "ERROR: getaddrinfo: %s\n", gai_strerror(rv));
+    return -1;
+  }
+
+  int fd = -1;
+  for (struct addrinfo *ai = res; ai != NULL; ai = ai->ai_next) {
+    fd = socket(ai->ai_family, ai->ai_socktype, ai->ai_protocol);
+    if (fd < 0) {
+      continue;
+    }
+
+    int on = 1;
+    setsockopt(fd, SOL_SOCKET, SO_REUSEADDR, &on, sizeof(on));
+
+    if (bind(fd, ai->ai_addr, ai->ai_addrlen) == 0) {
+      break;
+    }
+
+    close(fd);
+    fd = -1;
+  }
+
+  freeaddrinfo(res);
+  if (fd < 0) {
+    perror("ERROR: bind");
+  }
+
+  return fd;
+}
+
+// children is decremented by the SIGCHLD handler, the accept loop blocks
+// SIGCHLD while it reads and increments it.
+static volatile sig_atomic_t children = 0;
+
+static void child_exited(__unused int sig) {
+  while (waitpid(-1, NULL, WNOHANG) > 0) {
+    children--;
+  }
+}
+
+// socket_path is the path of the unix socket, it is unlinked when the listening
+// process exits. It is NULL in the worker processes.
+static const char *socket_path = NULL;
+
+static void unlink_socket(void) {
+  if (socket_path != NULL) {
+    unlink(socket_path);
+  }
+}
+
+static void terminated(int sig) {
+  unlink_socket();
+  signal(sig, SIG_DFL);
+  raise(sig);
+}
+
+// tr_init listens on the socket and forks a worker process for each accepted
+// connection, the library state is not shared between connections. It only
+// returns in the worker process.
+void tr_init(int argc, char const *argv[]) {
+  const char *unix_path = NULL;
+  const char *tcp_addr = NULL;
+  long max = 0;
+
+  for (size_t i = 1; i < argc; i++) {
+    if (strcmp(argv[i], "-unix") == 0 && i + 1 < argc) {
+      unix_path = argv[++i];
+    } else if (strcmp(argv[i], "-tcp") == 0 && i + 1 < argc) {
+      tcp_addr = argv[++i];
+    } else if (strcmp(argv[i], "-n") == 0 && i + 1 < argc) {
+      max = strtol(argv[++i], NULL, 10);
+    } else if (strncmp(argv[i], "-dangerous_enable_test_functions", 32) == 0) {
+      handlers_test_functions_enabled = true;
+    } else {
+      usage(argv[0]);
+    }
+  }
+
+  if ((unix_path == NULL) == (tcp_addr == NULL)) {
+    usage(argv[0]);
+  }
+
+  int lfd = unix_path != NULL ? listen_unix(unix_path) : listen_tcp(tcp_addr);
+  if (lfd < 0) {
+    exit(EXIT_FAILURE);
+  }
+
+  socket_path = unix_path;
+  atexit(unlink_socket);
+  signal(SIGTERM, terminated);
+  signal(SIGINT, terminated);
+  signal(SIGHUP, terminated);
+
+  if (listen(lfd, SOMAXCONN) != 0) {
+    perror("ERROR: listen");
+    exit(EXIT_FAILURE);
+  }
+
+  // A closed connection fails the write of the response instead of killing
+  // the worker.
+  signal(SIGPIPE, SIG_IGN);
+  signal(SIGCHLD, child_exited);
+
+  sigset_t chld;
+  sigemptyset(&chld);
+  sigaddset(&chld, SIGCHLD);
+
+  while (true) {
+    int fd = accept(lfd, NULL, NULL);
+    if (fd < 0) {
+      switch (errno) {
+      case EINTR: // interrupted by SIGCHLD
+      case ECONNABORTED:
+        continue;
+      case EMFILE:
+      case ENFILE:
+      case ENOBUFS:
+      case ENOMEM:
+        // Out of resources, wait for workers to exit instead of spinning.
+        perror("ERROR: accept");
+        sleep(1);
+        continue;
+      default:
+        perror("ERROR: accept");
+        exit(EXIT_FAILURE);
+      }
+    }
+
+    // A child exiting before it is counted is reaped after the increment.
+    sigprocmask(SIG_BLOCK, &chld, NULL);
+
+    // Connections above the limit are closed, the client sees EOF before the
+    // RPC functions are received.
+    if (max > 0 && children >= max) {
+      sigprocmask(SIG_UNBLOCK, &chld, NULL);
+      close(fd);
+      continue;
+    }
+
+    pid_t pid = fork();
+    if (pid < 0) {
+      perror("ERROR: fork");
+      sigprocmask(SIG_UNBLOCK, &chld, NULL);
+      close(fd);
+      continue;
+    }
+
+    if (pid > 0) {
+      children++;
+      sigprocmask(SIG_UNBLOCK, &chld, NULL);
+      close(fd);
+      continue;
+    }
+
+    close(lfd);
+    socket_path = NULL;
+    signal(SIGCHLD, SIG_DFL);
+    signal(SIGTERM, SIG_DFL);
+    signal(SIGINT, SIG_DFL);
+    signal(SIGHUP, SIG_DFL);
+    sigprocmask(SIG_UNBLOCK, &chld, NULL);
+
+    tr_in = fdopen(fd, "r");
+    tr_out = fdopen(dup(fd), "w");
+    if (tr_in == NULL || tr_out == NULL) {
+      exit(EXIT_FAILURE);
+    }
+
+    setvbuf(tr_in, NULL, _IOFBF, CALLSIZE);
+    setvbuf(tr_out, NULL, _IOFBF, RESPSIZE);
+
+    // Write RPC functions.
+    if (!tr_send_funcs()) {
+      exit(EXIT_FAILURE);
+    }
+
+    return;
+  }
+}
@@ -1,12 +1,19 @@
 #include <stdbool.h>
 #include <stdint.h>
+#include <stdio.h>
 #include "msgpuck.h"
 
-#define REQSIZE 1024
-#define RESPSIZE 1024
+#define REQSIZE (1 << 20)
+#define RESPSIZE (1 << 20)
+#define CALLSIZE 4096 // upper bound of the response size of a single call
 #define DBGSIZE 512
 
+// The input and output streams of the Lich framed requests and responses.
+extern FILE *tr_in;
+extern FILE *tr_out;
+
 void tr_init(int argc, char const *argv[]);
+bool tr_send_funcs();
 char *tr_recv(char *buf);
 bool tr_send(char *data, char *end);
 void tr_error(const char *msg, const char *dbg, size_t dbglen);
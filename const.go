@@ -224,3 +224,129 @@ const (
 	SplitDegKeepSign  = 16   // don't round to next zodiac sign/nakshatra
 	SplitDegKeepDeg   = 32   // don't round to next degree
 )
+
+// Eclipse types defined in swephexp.h.
+const (
+	EclCentral       EclipseType = 1
+	EclNonCentral    EclipseType = 2
+	EclTotal         EclipseType = 4
+	EclAnnular       EclipseType = 8
+	EclPartial       EclipseType = 16
+	EclAnnularTotal  EclipseType = 32
+	EclHybrid        EclipseType = 32 // = annular-total
+	EclPenumbral     EclipseType = 64
+	EclAllTypesSolar EclipseType = EclCentral | EclNonCentral | EclTotal | EclAnnular | EclPartial | EclAnnularTotal
+	EclAllTypesLunar EclipseType = EclTotal | EclPartial | EclPenumbral
+)
+
+// Eclipse visibility bits defined in swephexp.h.
+const (
+	EclVisible          EclipseType = 128
+	EclMaxVisible       EclipseType = 256
+	Ecl1stVisible       EclipseType = 512   // begin of partial eclipse
+	EclPartBegVisible   EclipseType = 512   // begin of partial eclipse
+	Ecl2ndVisible       EclipseType = 1024  // begin of total eclipse
+	EclTotBegVisible    EclipseType = 1024  // begin of total eclipse
+	Ecl3rdVisible       EclipseType = 2048  // end of total eclipse
+	EclTotEndVisible    EclipseType = 2048  // end of total eclipse
+	Ecl4thVisible       EclipseType = 4096  // end of partial eclipse
+	EclPartEndVisible   EclipseType = 4096  // end of partial eclipse
+	EclPenumbBegVisible EclipseType = 8192  // begin of penumbral eclipse
+	EclPenumbEndVisible EclipseType = 16384 // end of penumbral eclipse
+	EclOccBegDaylight   EclipseType = 8192  // occultation begins during the day
+	EclOccEndDaylight   EclipseType = 16384 // occultation ends during the day
+)
+
+// EclOneTry limits an occultation search to the next conjunction of the moon
+// with the occulted body.
+const EclOneTry EclipseType = 32 * 1024
+
+// Rise and transit events defined in swephexp.h.
+const (
+	CalcRise     RiseTransEvent = 1
+	CalcSet      RiseTransEvent = 2
+	CalcMTransit RiseTransEvent = 4 // upper meridian transit
+	CalcITransit RiseTransEvent = 8 // lower meridian transit
+)
+
+// Rise and set bits that are or'ed to CalcRise or CalcSet.
+const (
+	BitDiscCenter      RiseTransEvent = 256   // rise or set of disc center
+	BitDiscBottom      RiseTransEvent = 8192  // rise or set of lower limb
+	BitGeoctrNoEclLat  RiseTransEvent = 128   // geocentric position without ecliptic latitude
+	BitNoRefraction    RiseTransEvent = 512   // no refraction
+	BitCivilTwilight   RiseTransEvent = 1024  // civil twilight
+	BitNauticTwilight  RiseTransEvent = 2048  // nautical twilight
+	BitAstroTwilight   RiseTransEvent = 4096  // astronomical twilight
+	BitFixedDiscSize   RiseTransEvent = 16384 // neglect the effect of distance on disc size
+	BitForceSlowMethod RiseTransEvent = 32768 // use the slow rise and set algorithm
+	BitHinduRising     RiseTransEvent = BitDiscCenter | BitNoRefraction | BitGeoctrNoEclLat
+)
+
+// Coordinate conversions of AzAlt and AzAltRev defined in swephexp.h.
+const (
+	Ecl2Hor AzAltMode = 0 // ecliptic to horizontal coordinates
+	Equ2Hor AzAltMode = 1 // equatorial to horizontal coordinates
+	Hor2Ecl AzAltMode = 0 // horizontal to ecliptic coordinates
+	Hor2Equ AzAltMode = 1 // horizontal to equatorial coordinates
+)
+
+// Refraction directions defined in swephexp.h.
+const (
+	TrueToApp RefracMode = 0 // true to apparent altitude
+	AppToTrue RefracMode = 1 // apparent to true altitude
+)
+
+// Methods of GauquelinSector, see swe_gauquelin_sector.
+const (
+	GauqLat              GauquelinMethod = 0 // Placidus house position with ecliptic latitude
+	GauqNoLat            GauquelinMethod = 1 // Placidus house position without ecliptic latitude
+	GauqDiscCenter       GauquelinMethod = 2 // rise and set of disc center without refraction
+	GauqDiscCenterRefrac GauquelinMethod = 3 // rise and set of disc center with refraction
+	GauqUpperLimb        GauquelinMethod = 4 // rise and set of upper limb without refraction
+	GauqUpperLimbRefrac  GauquelinMethod = 5 // rise and set of upper limb with refraction
+)
+
+// DefaultLapseRate is the attenuation of the atmospheric temperature with the
+// altitude in °K/m that is used by the Swiss Ephemeris if the lapse rate is not
+// set (SE_LAPSE_RATE).
+const DefaultLapseRate = 0.0065
+
+// Heliacal events defined in swephexp.h.
+const (
+	HeliacalRising    HeliacalEvent = 1
+	HeliacalSetting   HeliacalEvent = 2
+	MorningFirst      HeliacalEvent = HeliacalRising
+	EveningLast       HeliacalEvent = HeliacalSetting
+	EveningFirst      HeliacalEvent = 3
+	MorningLast       HeliacalEvent = 4
+	AcronychalRising  HeliacalEvent = 5 // not implemented by the library
+	AcronychalSetting HeliacalEvent = 6 // not implemented by the library
+	CosmicalSetting   HeliacalEvent = AcronychalSetting
+)
+
+// Heliacal flags defined in swephexp.h.
+const (
+	HelFlagLongSearch     = 1 << 7
+	HelFlagHighPrecision  = 1 << 8
+	HelFlagOpticalParams  = 1 << 9
+	HelFlagNoDetails      = 1 << 10
+	HelFlagSearch1Period  = 1 << 11
+	HelFlagVisLimDark     = 1 << 12
+	HelFlagVisLimNoMoon   = 1 << 13
+	HelFlagVisLimPhotopic = 1 << 14
+	HelFlagVisLimScotopic = 1 << 15
+	HelFlagAV             = 1 << 16
+	HelFlagAVKindVR       = 1 << 16
+	HelFlagAVKindPTO      = 1 << 17
+	HelFlagAVKindMin7     = 1 << 18
+	HelFlagAVKindMin9     = 1 << 19
+	HelFlagAVKind         = HelFlagAVKindVR | HelFlagAVKindPTO | HelFlagAVKindMin7 | HelFlagAVKindMin9
+)
+
+// Vision modes defined in swephexp.h.
+const (
+	Photopic  VisionMode = 0
+	Scotopic  VisionMode = 1
+	Mixedopic VisionMode = 2 // near the limit between photopic and scotopic
+)
@@ -0,0 +1,102 @@
+package swego
+
+import "context"
+
+// ContextInterface is like Interface, but each method takes a context as
+// first argument. An implementation returns the context error if the context
+// is done before the call is started. Implementations that can abandon a
+// running call also return early if the context is done during the call.
+// See Interface for the documentation of the methods. SplitDeg is not part of
+// ContextInterface, it does not call into the library.
+type ContextInterface interface {
+	Version(ctx context.Context) (string, error)
+
+	PlanetName(ctx context.Context, pl Planet) (string, error)
+
+	Calc(ctx context.Context, et float64, pl Planet, fl *CalcFlags) (xx []float64, cfl int, err error)
+	CalcUT(ctx context.Context, ut float64, pl Planet, fl *CalcFlags) (xx []float64, cfl int, err error)
+	CalcPctr(ctx context.Context, et float64, pl, center Planet, fl *CalcFlags) (xx []float64, cfl int, err error)
+	CalcBatch(ctx context.Context, requests []CalcRequest) []CalcResult
+
+	FixStar(ctx context.Context, star string, et float64, fl *CalcFlags) (name string, xx []float64, cfl int, err error)
+	FixStarUT(ctx context.Context, star string, ut float64, fl *CalcFlags) (name string, xx []float64, cfl int, err error)
+	FixStarMag(ctx context.Context, star string) (name string, mag float64, err error)
+	FixStar2(ctx context.Context, star string, et float64, fl *CalcFlags) (name string, xx []float64, cfl int, err error)
+	FixStar2UT(ctx context.Context, star string, ut float64, fl *CalcFlags) (name string, xx []float64, cfl int, err error)
+	FixStar2Mag(ctx context.Context, star string) (name string, mag float64, err error)
+
+	NodAps(ctx context.Context, et float64, pl Planet, fl *CalcFlags, m NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error)
+	NodApsUT(ctx context.Context, ut float64, pl Planet, fl *CalcFlags, m NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error)
+
+	GetOrbitalElements(ctx context.Context, et float64, pl Planet, fl *CalcFlags) (OrbitalElements, error)
+	OrbitMaxMinTrueDistance(ctx context.Context, et float64, pl Planet, fl *CalcFlags) (dmax, dmin, dtrue float64, err error)
+
+	SolCross(ctx context.Context, x2cross, et float64, fl *CalcFlags) (float64, error)
+	SolCrossUT(ctx context.Context, x2cross, ut float64, fl *CalcFlags) (float64, error)
+	MoonCross(ctx context.Context, x2cross, et float64, fl *CalcFlags) (float64, error)
+	MoonCrossUT(ctx context.Context, x2cross, ut float64, fl *CalcFlags) (float64, error)
+	MoonCrossNode(ctx context.Context, et float64, fl *CalcFlags) (t, lng, lat float64, err error)
+	MoonCrossNodeUT(ctx context.Context, ut float64, fl *CalcFlags) (t, lng, lat float64, err error)
+	HelioCross(ctx context.Context, pl Planet, x2cross, et float64, fl *CalcFlags, backward bool) (float64, error)
+	HelioCrossUT(ctx context.Context, pl Planet, x2cross, ut float64, fl *CalcFlags, backward bool) (float64, error)
+
+	GetAyanamsaEx(ctx context.Context, et float64, fl *AyanamsaExFlags) (float64, error)
+	GetAyanamsaExUT(ctx context.Context, ut float64, fl *AyanamsaExFlags) (float64, error)
+	GetAyanamsaName(ctx context.Context, ayan Ayanamsa) (string, error)
+
+	JulDay(ctx context.Context, y, m, d int, h float64, ct CalType) (float64, error)
+	RevJul(ctx context.Context, jd float64, ct CalType) (y, m, d int, h float64, err error)
+	UTCToJD(ctx context.Context, y, m, d, h, i int, s float64, fl *DateConvertFlags) (et, ut float64, err error)
+	JdETToUTC(ctx context.Context, et float64, fl *DateConvertFlags) (y, m, d, h, i int, s float64, err error)
+	JdUT1ToUTC(ctx context.Context, ut1 float64, fl *DateConvertFlags) (y, m, d, h, i int, s float64, err error)
+	UTCTimeZone(ctx context.Context, y, m, d, h, i int, s, tz float64) (int, int, int, int, int, float64, error)
+
+	HousesEx(ctx context.Context, ut float64, fl *HousesExFlags, geolat, geolon float64, hsys HSys) ([]float64, []float64, error)
+	HousesARMC(ctx context.Context, armc, geolat, eps float64, hsys HSys) ([]float64, []float64, error)
+	HousesEx2(ctx context.Context, ut float64, fl *HousesExFlags, geolat, geolon float64, hsys HSys) (Houses, error)
+	HousesARMCEx2(ctx context.Context, armc, geolat, eps float64, hsys HSys) (Houses, error)
+	HousePos(ctx context.Context, armc, geolat, eps float64, hsys HSys, pllng, pllat float64) (float64, error)
+	GauquelinSector(ctx context.Context, ut float64, body Body, loc GeoLoc, method GauquelinMethod, atpress, attemp float64, fl *CalcFlags) (float64, error)
+	HouseName(ctx context.Context, hsys HSys) (string, error)
+
+	SolEclipseWhenGlob(ctx context.Context, ut float64, fl *EclipseFlags, typ EclipseType, backward bool) (SolarEclipse, error)
+	SolEclipseWhenLoc(ctx context.Context, ut float64, fl *EclipseFlags, loc GeoLoc, backward bool) (SolarEclipse, error)
+	SolEclipseWhere(ctx context.Context, ut float64, fl *EclipseFlags) (SolarEclipse, error)
+	SolEclipseHow(ctx context.Context, ut float64, fl *EclipseFlags, loc GeoLoc) (SolarEclipse, error)
+
+	LunOccultWhenGlob(ctx context.Context, ut float64, body Body, fl *EclipseFlags, typ EclipseType, backward bool) (Occultation, error)
+	LunOccultWhenLoc(ctx context.Context, ut float64, body Body, fl *EclipseFlags, loc GeoLoc, backward bool) (Occultation, error)
+	LunOccultWhere(ctx context.Context, ut float64, body Body, fl *EclipseFlags) (Occultation, error)
+
+	LunEclipseWhen(ctx context.Context, ut float64, fl *EclipseFlags, typ EclipseType, backward bool) (LunarEclipse, error)
+	LunEclipseWhenLoc(ctx context.Context, ut float64, fl *EclipseFlags, loc GeoLoc, backward bool) (LunarEclipse, error)
+	LunEclipseHow(ctx context.Context, ut float64, fl *EclipseFlags, loc GeoLoc) (LunarEclipse, error)
+
+	Pheno(ctx context.Context, et float64, pl Planet, fl *CalcFlags) (Phenomena, error)
+	PhenoUT(ctx context.Context, ut float64, pl Planet, fl *CalcFlags) (Phenomena, error)
+
+	RiseTrans(ctx context.Context, ut float64, body Body, loc GeoLoc, event RiseTransEvent, fl *RiseTransFlags) (float64, error)
+
+	AzAlt(ctx context.Context, ut float64, loc GeoLoc, mode AzAltMode, lng, lat float64, fl *AzAltFlags) (az, trueAlt, appAlt float64, err error)
+	AzAltRev(ctx context.Context, ut float64, loc GeoLoc, mode AzAltMode, az, alt float64, fl *AzAltFlags) (lng, lat float64, err error)
+	Refrac(ctx context.Context, alt, atpress, attemp float64, mode RefracMode) (float64, error)
+	RefracExtended(ctx context.Context, alt, geoalt, atpress, attemp, lapseRate float64, mode RefracMode) (float64, Refraction, error)
+
+	HeliacalUT(ctx context.Context, ut float64, loc GeoLoc, body Body, event HeliacalEvent, fl *HeliacalFlags) (HeliacalTimes, error)
+	HeliacalPhenoUT(ctx context.Context, ut float64, loc GeoLoc, body Body, event HeliacalEvent, fl *HeliacalFlags) (HeliacalPheno, error)
+	VisLimitMag(ctx context.Context, ut float64, loc GeoLoc, body Body, fl *HeliacalFlags) (VisLimit, error)
+	HeliacalAngle(ctx context.Context, ut float64, loc GeoLoc, mag, objAz, sunAz, moonAz, moonAlt float64, fl *HeliacalFlags) (angle, arcVis, sunAlt float64, err error)
+	TopoArcusVisionis(ctx context.Context, ut float64, loc GeoLoc, mag, objAz, objAlt, sunAz, moonAz, moonAlt float64, fl *HeliacalFlags) (float64, error)
+
+	DeltaTEx(ctx context.Context, jd float64, eph Ephemeris) (float64, error)
+
+	TimeEqu(ctx context.Context, jd float64, fl *TimeEquFlags) (float64, error)
+	LMTToLAT(ctx context.Context, jdLMT, geolon float64, fl *TimeEquFlags) (float64, error)
+	LATToLMT(ctx context.Context, jdLAT, geolon float64, fl *TimeEquFlags) (float64, error)
+
+	SidTime0(ctx context.Context, ut, eps, nut float64, fl *SidTimeFlags) (float64, error)
+	SidTime(ctx context.Context, ut float64, fl *SidTimeFlags) (float64, error)
+
+	CoTrans(ctx context.Context, xpo []float64, eps float64) ([]float64, error)
+	CoTransSp(ctx context.Context, xpo []float64, eps float64) ([]float64, error)
+}
@@ -0,0 +1,284 @@
+package swego
+
+import "math"
+
+const (
+	degToRad = math.Pi / 180
+	radToDeg = 180 / math.Pi
+)
+
+// CoTrans converts the polar coordinates xpo (longitude, latitude, distance)
+// between the ecliptic and equatorial coordinate systems. For ecliptic to
+// equatorial coordinates eps must be the negative obliquity of the ecliptic,
+// for equatorial to ecliptic coordinates the positive obliquity. Angles are
+// in degrees, missing values of xpo are taken as 0. It is the Go
+// implementation of swe_cotrans.
+func CoTrans(xpo []float64, eps float64) []float64 {
+	var x [3]float64
+	copy(x[:], xpo)
+	dist := x[2]
+
+	x[0] *= degToRad
+	x[1] *= degToRad
+	x[2] = 1
+	x = cartPol(coorTrf(polCart(x), eps*degToRad))
+
+	return []float64{x[0] * radToDeg, x[1] * radToDeg, dist}
+}
+
+// CoTransSp is like CoTrans, but xpo contains speeds as returned by Calc
+// with FlagSpeed in addition to the position. It is the Go implementation of
+// swe_cotrans_sp.
+func CoTransSp(xpo []float64, eps float64) []float64 {
+	var x [6]float64
+	copy(x[:], xpo)
+	dist, distSpeed := x[2], x[5]
+
+	x[0] *= degToRad
+	x[1] *= degToRad
+	x[2] = 1 // avoids problems with polCartSp if the distance is 0
+	x[3] *= degToRad
+	x[4] *= degToRad
+
+	x = polCartSp(x)
+	e := eps * degToRad
+	pos := coorTrf([3]float64{x[0], x[1], x[2]}, e)
+	speed := coorTrf([3]float64{x[3], x[4], x[5]}, e)
+	x = cartPolSp([6]float64{pos[0], pos[1], pos[2], speed[0], speed[1], speed[2]})
+
+	return []float64{x[0] * radToDeg, x[1] * radToDeg, dist, x[3] * radToDeg, x[4] * radToDeg, distSpeed}
+}
+
+// coorTrf rotates the cartesian coordinates x about the x-axis by eps
+// radians.
+func coorTrf(x [3]float64, eps float64) [3]float64 {
+	sin, cos := math.Sincos(eps)
+	return [3]float64{
+		x[0],
+		x[1]*cos + x[2]*sin,
+		-x[1]*sin + x[2]*cos,
+	}
+}
+
+// cartPol converts the cartesian coordinates x to polar coordinates.
+func cartPol(x [3]float64) (l [3]float64) {
+	if x[0] == 0 && x[1] == 0 && x[2] == 0 {
+		return l
+	}
+
+	rxy := x[0]*x[0] + x[1]*x[1]
+	l[2] = math.Sqrt(rxy + x[2]*x[2])
+	rxy = math.Sqrt(rxy)
+	l[0] = math.Atan2(x[1], x[0])
+	if l[0] < 0 {
+		l[0] += 2 * math.Pi
+	}
+
+	switch {
+	case rxy != 0:
+		l[1] = math.Atan(x[2] / rxy)
+	case x[2] >= 0:
+		l[1] = math.Pi / 2
+	default:
+		l[1] = -math.Pi / 2
+	}
+
+	return l
+}
+
+// polCart converts the polar coordinates l to cartesian coordinates.
+func polCart(l [3]float64) [3]float64 {
+	cosl1 := math.Cos(l[1])
+	return [3]float64{
+		l[2] * cosl1 * math.Cos(l[0]),
+		l[2] * cosl1 * math.Sin(l[0]),
+		l[2] * math.Sin(l[1]),
+	}
+}
+
+// cartPolSp converts the cartesian position and speed x to polar
+// coordinates. If the position is 0 the direction of motion is returned.
+func cartPolSp(x [6]float64) (l [6]float64) {
+	if x[0] == 0 && x[1] == 0 && x[2] == 0 {
+		dir := cartPol([3]float64{x[3], x[4], x[5]})
+		l[0], l[1] = dir[0], dir[1]
+		l[5] = math.Sqrt(x[3]*x[3] + x[4]*x[4] + x[5]*x[5])
+		return l
+	}
+
+	if x[3] == 0 && x[4] == 0 && x[5] == 0 {
+		pos := cartPol([3]float64{x[0], x[1], x[2]})
+		copy(l[:], pos[:])
+		return l
+	}
+
+	rxy := x[0]*x[0] + x[1]*x[1]
+	l[2] = math.Sqrt(rxy + x[2]*x[2])
+	rxy = math.Sqrt(rxy)
+	l[0] = math.Atan2(x[1], x[0])
+	if l[0] < 0 {
+		l[0] += 2 * math.Pi
+	}
+	l[1] = math.Atan(x[2] / rxy)
+
+	// Rotate the coordinate system by the longitude about the z-axis and
+	// then by the latitude about the new y-axis, the speeds along the new
+	// axes are the speeds in longitude, latitude and distance.
+	coslon := x[0] / rxy
+	sinlon := x[1] / rxy
+	coslat := rxy / l[2]
+	sinlat := x[2] / l[2]
+	vx := x[3]*coslon + x[4]*sinlon
+	vy := -x[3]*sinlon + x[4]*coslon
+	l[3] = vy / rxy
+	l[4] = (-sinlat*vx + coslat*x[5]) / l[2]
+	l[5] = coslat*vx + sinlat*x[5]
+	return l
+}
+
+// polCartSp converts the polar position and speed l to cartesian
+// coordinates.
+func polCartSp(l [6]float64) (x [6]float64) {
+	if l[3] == 0 && l[4] == 0 && l[5] == 0 {
+		pos := polCart([3]float64{l[0], l[1], l[2]})
+		copy(x[:], pos[:])
+		return x
+	}
+
+	sinlon, coslon := math.Sincos(l[0])
+	sinlat, coslat := math.Sincos(l[1])
+	x[0] = l[2] * coslat * coslon
+	x[1] = l[2] * coslat * sinlon
+	x[2] = l[2] * sinlat
+
+	rxy := math.Sqrt(x[0]*x[0] + x[1]*x[1])
+	vlat := l[4] * l[2]
+	x[5] = sinlat*l[5] + coslat*vlat
+	vr := coslat*l[5] - sinlat*vlat
+	vlon := l[3] * rxy
+	x[3] = coslon*vr - sinlon*vlon
+	x[4] = sinlon*vr + coslon*vlon
+	return x
+}
+
+// Ecliptic represents ecliptic polar coordinates and their speeds as
+// returned by Calc without FlagEquatorial and FlagXYZ. Angles are in
+// degrees, distances in AU.
+type Ecliptic struct {
+	Long      float64
+	Lat       float64
+	Dist      float64
+	LongSpeed float64 // degrees per day
+	LatSpeed  float64 // degrees per day
+	DistSpeed float64 // AU per day
+}
+
+// NewEcliptic returns the ecliptic coordinates in xx as returned by Calc,
+// missing values are taken as 0.
+func NewEcliptic(xx []float64) Ecliptic {
+	var x [6]float64
+	copy(x[:], xx)
+	return Ecliptic{x[0], x[1], x[2], x[3], x[4], x[5]}
+}
+
+// Coords returns c in the layout of Calc.
+func (c Ecliptic) Coords() []float64 {
+	return []float64{c.Long, c.Lat, c.Dist, c.LongSpeed, c.LatSpeed, c.DistSpeed}
+}
+
+// Equatorial converts c to equatorial coordinates using the obliquity of the
+// ecliptic eps in degrees, see Calc with EclNut for the true obliquity.
+func (c Ecliptic) Equatorial(eps float64) Equatorial {
+	return NewEquatorial(CoTransSp(c.Coords(), -eps))
+}
+
+// Cartesian converts c to cartesian coordinates in the ecliptic frame.
+func (c Ecliptic) Cartesian() Cartesian {
+	return polarToCartesian(c.Coords())
+}
+
+// Equatorial represents equatorial polar coordinates and their speeds as
+// returned by Calc with FlagEquatorial. Angles are in degrees, distances in
+// AU.
+type Equatorial struct {
+	RA        float64 // right ascension
+	Dec       float64 // declination
+	Dist      float64
+	RASpeed   float64 // degrees per day
+	DecSpeed  float64 // degrees per day
+	DistSpeed float64 // AU per day
+}
+
+// NewEquatorial returns the equatorial coordinates in xx as returned by Calc
+// with FlagEquatorial, missing values are taken as 0.
+func NewEquatorial(xx []float64) Equatorial {
+	var x [6]float64
+	copy(x[:], xx)
+	return Equatorial{x[0], x[1], x[2], x[3], x[4], x[5]}
+}
+
+// Coords returns c in the layout of Calc.
+func (c Equatorial) Coords() []float64 {
+	return []float64{c.RA, c.Dec, c.Dist, c.RASpeed, c.DecSpeed, c.DistSpeed}
+}
+
+// Ecliptic converts c to ecliptic coordinates using the obliquity of the
+// ecliptic eps in degrees.
+func (c Equatorial) Ecliptic(eps float64) Ecliptic {
+	return NewEcliptic(CoTransSp(c.Coords(), eps))
+}
+
+// Cartesian converts c to cartesian coordinates in the equatorial frame.
+func (c Equatorial) Cartesian() Cartesian {
+	return polarToCartesian(c.Coords())
+}
+
+// Cartesian represents rectangular coordinates and their speeds as returned
+// by Calc with FlagXYZ. The frame is ecliptic or, with FlagEquatorial,
+// equatorial. Distances are in AU, speeds in AU per day.
+type Cartesian struct {
+	X      float64
+	Y      float64
+	Z      float64
+	SpeedX float64
+	SpeedY float64
+	SpeedZ float64
+}
+
+// NewCartesian returns the cartesian coordinates in xx as returned by Calc
+// with FlagXYZ, missing values are taken as 0.
+func NewCartesian(xx []float64) Cartesian {
+	var x [6]float64
+	copy(x[:], xx)
+	return Cartesian{x[0], x[1], x[2], x[3], x[4], x[5]}
+}
+
+// Coords returns c in the layout of Calc.
+func (c Cartesian) Coords() []float64 {
+	return []float64{c.X, c.Y, c.Z, c.SpeedX, c.SpeedY, c.SpeedZ}
+}
+
+// Ecliptic converts c, which must be in the ecliptic frame, to polar
+// coordinates.
+func (c Cartesian) Ecliptic() Ecliptic {
+	return NewEcliptic(c.polar())
+}
+
+// Equatorial converts c, which must be in the equatorial frame, to polar
+// coordinates.
+func (c Cartesian) Equatorial() Equatorial {
+	return NewEquatorial(c.polar())
+}
+
+func (c Cartesian) polar() []float64 {
+	l := cartPolSp([6]float64{c.X, c.Y, c.Z, c.SpeedX, c.SpeedY, c.SpeedZ})
+	return []float64{l[0] * radToDeg, l[1] * radToDeg, l[2], l[3] * radToDeg, l[4] * radToDeg, l[5]}
+}
+
+func polarToCartesian(xx []float64) Cartesian {
+	x := polCartSp([6]float64{
+		xx[0] * degToRad, xx[1] * degToRad, xx[2],
+		xx[3] * degToRad, xx[4] * degToRad, xx[5],
+	})
+	return NewCartesian(x[:])
+}
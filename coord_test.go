@@ -0,0 +1,84 @@
+package swego
+
+import (
+	"math"
+	"testing"
+)
+
+func inDeltaSlice(lhs, rhs []float64, delta float64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i := range lhs {
+		if math.Abs(lhs[i]-rhs[i]) >= delta {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestCoTrans(t *testing.T) {
+	// Reference values from swe_cotrans and swe_cotrans_sp.
+	in := []float64{123.4, -5.6, 1.2, 0.98, -0.01, 0.001}
+	cases := []struct {
+		fn   func([]float64, float64) []float64
+		xpo  []float64
+		eps  float64
+		want []float64
+	}{
+		{CoTrans, in, -23.44, []float64{124.366790, 13.944262, 1.2}},
+		{CoTransSp, in, -23.44, []float64{124.366790, 13.944262, 1.2, 0.976700, -0.229798, 0.001}},
+		{CoTransSp, in, 23.44, []float64{127.134706, -24.836988, 1.2, 1.045631, 0.225634, 0.001}},
+		{CoTransSp, in[:2], 23.44, []float64{127.134706, -24.836988, 0, 0, 0, 0}},
+	}
+
+	for _, c := range cases {
+		if got := c.fn(c.xpo, c.eps); !inDeltaSlice(got, c.want, 1e-6) {
+			t.Errorf("CoTrans(%v, %f) = %v, want: %v", c.xpo, c.eps, got, c.want)
+		}
+	}
+}
+
+func TestEcliptic_Equatorial(t *testing.T) {
+	// Mars at J2000 computed by Calc with and without FlagEquatorial.
+	ecl := NewEcliptic([]float64{327.963313, -1.067783, 1.849687, 0.775673, 0.012476, 0.005425})
+	want := []float64{330.516821, -13.182476, 1.849687, 0.742804, 0.280275, 0.005425}
+	eps := 23.437683
+
+	equ := ecl.Equatorial(eps)
+	if got := equ.Coords(); !inDeltaSlice(got, want, 1e-5) {
+		t.Errorf("Equatorial() = %v, want: %v", got, want)
+	}
+
+	if got := equ.Ecliptic(eps).Coords(); !inDeltaSlice(got, ecl.Coords(), 1e-9) {
+		t.Errorf("Ecliptic() = %v, want: %v", got, ecl.Coords())
+	}
+}
+
+func TestEcliptic_Cartesian(t *testing.T) {
+	ecl := NewEcliptic([]float64{327.963313, -1.067783, 1.849687, 0.775673, 0.012476, 0.005425})
+	want := []float64{1.567723, -0.981019, -0.034469, 0.017885, 0.018343, 0.000302}
+
+	xyz := ecl.Cartesian()
+	if got := xyz.Coords(); !inDeltaSlice(got, want, 1e-5) {
+		t.Errorf("Cartesian() = %v, want: %v", got, want)
+	}
+
+	if got := xyz.Ecliptic().Coords(); !inDeltaSlice(got, ecl.Coords(), 1e-9) {
+		t.Errorf("Ecliptic() = %v, want: %v", got, ecl.Coords())
+	}
+
+	equ := NewEquatorial(ecl.Coords())
+	if got := equ.Cartesian().Equatorial().Coords(); !inDeltaSlice(got, equ.Coords(), 1e-9) {
+		t.Errorf("Equatorial() = %v, want: %v", got, equ.Coords())
+	}
+}
+
+func TestNewCartesian(t *testing.T) {
+	got := NewCartesian([]float64{1, 2, 3})
+	if want := (Cartesian{X: 1, Y: 2, Z: 3}); got != want {
+		t.Errorf("NewCartesian() = %+v, want: %+v", got, want)
+	}
+}
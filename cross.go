@@ -0,0 +1,38 @@
+package swego
+
+import "sort"
+
+// Ingress represents the entry of the sun into a zodiac sign.
+type Ingress struct {
+	Sign int     // 0 for Aries, 1 for Taurus, ..., 11 for Pisces
+	UT   float64 // Julian Date (in Universal Time) of the ingress
+}
+
+// SolarIngresses returns the ingresses of the sun into the 12 zodiac signs
+// in Gregorian calendar year y, in chronological order. Calculation flags fl
+// are passed to SolCrossUT, so FlagSidereal yields the ingresses into the
+// sidereal signs.
+func SolarIngresses(swe Interface, y int, fl *CalcFlags) ([]Ingress, error) {
+	start, err := swe.JulDay(y, 1, 1, 0, Gregorian)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sun crosses each longitude once a year, none of the sign
+	// boundaries is crossed near the turn of the year.
+	ingresses := make([]Ingress, 12)
+	for i := range ingresses {
+		t, err := swe.SolCrossUT(float64(i*30), start, fl)
+		if err != nil {
+			return nil, err
+		}
+
+		ingresses[i] = Ingress{Sign: i, UT: t}
+	}
+
+	sort.Slice(ingresses, func(i, j int) bool {
+		return ingresses[i].UT < ingresses[j].UT
+	})
+
+	return ingresses, nil
+}
@@ -0,0 +1,61 @@
+package swego
+
+import (
+	"reflect"
+	"testing"
+)
+
+// crossTestSwe returns 2451545 for January 1 and fixed crossing times for
+// SolCrossUT, other methods of Interface are not implemented.
+type crossTestSwe struct {
+	Interface
+	crossings map[float64]float64
+}
+
+func (swe *crossTestSwe) JulDay(y, m, d int, h float64, ct CalType) (float64, error) {
+	return 2451545, nil
+}
+
+func (swe *crossTestSwe) SolCrossUT(x2cross, ut float64, fl *CalcFlags) (float64, error) {
+	if ut != 2451545 {
+		return 0, Error("unexpected start of search")
+	}
+
+	if t, ok := swe.crossings[x2cross]; ok {
+		return t, nil
+	}
+
+	return 0, Error("crossing not found")
+}
+
+func TestSolarIngresses(t *testing.T) {
+	swe := &crossTestSwe{crossings: make(map[float64]float64)}
+	for i := 0; i < 12; i++ {
+		// Aries ingress around March 20th, signs roughly 30 days apart.
+		swe.crossings[float64(i*30)] = 2451545 + float64((i*30+79)%365)
+	}
+
+	got, err := SolarIngresses(swe, 2000, nil)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	var signs []int
+	for i, in := range got {
+		signs = append(signs, in.Sign)
+		if i > 0 && in.UT < got[i-1].UT {
+			t.Errorf("ingresses not in chronological order: %v", got)
+		}
+	}
+
+	if want := []int{10, 11, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(signs, want) {
+		t.Errorf("signs = %v, want: %v", signs, want)
+	}
+}
+
+func TestSolarIngresses_error(t *testing.T) {
+	swe := &crossTestSwe{}
+	if _, err := SolarIngresses(swe, 2000, nil); err != Error("crossing not found") {
+		t.Errorf("err = %v, want: %v", err, Error("crossing not found"))
+	}
+}
@@ -0,0 +1,241 @@
+package swego
+
+// EclipseType is the type of eclipse type and visibility bits.
+type EclipseType int32
+
+// EclipseFlags represents the library state of the eclipse functions.
+type EclipseFlags struct {
+	Flags   int32    // Ephemeris flag
+	JPLFile string   // Argument to swe_set_jpl_file
+	DeltaT  *float64 // Argument to swe_set_delta_t_userdef, nil resets it.
+}
+
+// SetEphemeris sets the ephemeris flag in fl.
+func (fl *EclipseFlags) SetEphemeris(eph Ephemeris) { fl.Flags |= int32(eph) }
+
+// SetDeltaT sets f as delta T in flags object fl.
+// Set fl.DeltaT to nil to reset the value within the Swiss Ephemeris.
+func (fl *EclipseFlags) SetDeltaT(f float64) { fl.DeltaT = &f }
+
+// SolarEclipse represents the circumstances of a solar eclipse. Which fields
+// are set depends on the method that returned the value. All times are Julian
+// Dates in Universal Time, a time is 0 if the phase does not occur.
+type SolarEclipse struct {
+	Type EclipseType // eclipse type and visibility bits
+
+	// Type bits decoded by SetType.
+	Central    bool
+	NonCentral bool
+	Total      bool
+	Annular    bool
+	Hybrid     bool // annular-total
+	Partial    bool
+
+	// Local visibility bits decoded by SetType.
+	Visible              bool
+	MaxVisible           bool
+	FirstContactVisible  bool
+	SecondContactVisible bool
+	ThirdContactVisible  bool
+	FourthContactVisible bool
+
+	Max float64 // time of maximum eclipse
+
+	// Global contact times set by SetGlobalTimes.
+	Noon        float64 // time of eclipse at local apparent noon
+	Begin       float64 // begin of eclipse
+	End         float64 // end of eclipse
+	TotalBegin  float64 // begin of totality
+	TotalEnd    float64 // end of totality
+	CenterBegin float64 // begin of center line
+	CenterEnd   float64 // end of center line
+
+	// Local contact times set by SetLocalTimes.
+	FirstContact  float64
+	SecondContact float64
+	ThirdContact  float64
+	FourthContact float64
+	Sunrise       float64 // sunrise between first and fourth contact
+	Sunset        float64 // sunset between first and fourth contact
+
+	// Attributes set by SetAttributes.
+	Magnitude      float64 // fraction of solar diameter covered by moon
+	DiameterRatio  float64 // ratio of lunar diameter to solar one
+	Obscuration    float64 // fraction of solar disc covered by moon
+	ShadowDiameter float64 // diameter of core shadow in km
+	SunAzimuth     float64
+	SunAlt         float64 // true altitude of sun above horizon
+	SunAppAlt      float64 // apparent altitude of sun above horizon
+	Elongation     float64 // angular distance of moon from sun in degrees
+	MagnitudeNASA  float64 // eclipse magnitude as defined by NASA
+	Saros          int     // saros series number
+	SarosMember    int     // saros series member number
+
+	CentralLine GeoLoc // geographic location of the central line at Max
+}
+
+// SetType sets the eclipse type bits t in e and decodes them into the boolean
+// fields of e.
+func (e *SolarEclipse) SetType(t EclipseType) {
+	e.Type = t
+	e.Central = t&EclCentral != 0
+	e.NonCentral = t&EclNonCentral != 0
+	e.Total = t&EclTotal != 0
+	e.Annular = t&EclAnnular != 0
+	e.Hybrid = t&EclHybrid != 0
+	e.Partial = t&EclPartial != 0
+	e.Visible = t&EclVisible != 0
+	e.MaxVisible = t&EclMaxVisible != 0
+	e.FirstContactVisible = t&Ecl1stVisible != 0
+	e.SecondContactVisible = t&Ecl2ndVisible != 0
+	e.ThirdContactVisible = t&Ecl3rdVisible != 0
+	e.FourthContactVisible = t&Ecl4thVisible != 0
+}
+
+// SetGlobalTimes sets the global contact times in e from tret as returned by
+// swe_sol_eclipse_when_glob.
+func (e *SolarEclipse) SetGlobalTimes(tret []float64) {
+	if len(tret) < 8 {
+		return
+	}
+
+	e.Max = tret[0]
+	e.Noon = tret[1]
+	e.Begin = tret[2]
+	e.End = tret[3]
+	e.TotalBegin = tret[4]
+	e.TotalEnd = tret[5]
+	e.CenterBegin = tret[6]
+	e.CenterEnd = tret[7]
+}
+
+// SetLocalTimes sets the local contact times in e from tret as returned by
+// swe_sol_eclipse_when_loc.
+func (e *SolarEclipse) SetLocalTimes(tret []float64) {
+	if len(tret) < 7 {
+		return
+	}
+
+	e.Max = tret[0]
+	e.FirstContact = tret[1]
+	e.SecondContact = tret[2]
+	e.ThirdContact = tret[3]
+	e.FourthContact = tret[4]
+	e.Sunrise = tret[5]
+	e.Sunset = tret[6]
+}
+
+// SetAttributes sets the eclipse attributes in e from attr as returned by
+// swe_sol_eclipse_when_loc, swe_sol_eclipse_where and swe_sol_eclipse_how.
+func (e *SolarEclipse) SetAttributes(attr []float64) {
+	if len(attr) < 11 {
+		return
+	}
+
+	e.Magnitude = attr[0]
+	e.DiameterRatio = attr[1]
+	e.Obscuration = attr[2]
+	e.ShadowDiameter = attr[3]
+	e.SunAzimuth = attr[4]
+	e.SunAlt = attr[5]
+	e.SunAppAlt = attr[6]
+	e.Elongation = attr[7]
+	e.MagnitudeNASA = attr[8]
+	e.Saros = int(attr[9])
+	e.SarosMember = int(attr[10])
+}
+
+// LunarEclipse represents the circumstances of a lunar eclipse. Which fields
+// are set depends on the method that returned the value. All times are Julian
+// Dates in Universal Time, a time is 0 if the phase does not occur.
+type LunarEclipse struct {
+	Type EclipseType // eclipse type and visibility bits
+
+	// Type bits decoded by SetType.
+	Total     bool
+	Partial   bool
+	Penumbral bool
+
+	// Local visibility bits decoded by SetType.
+	Visible               bool
+	MaxVisible            bool
+	PartialBeginVisible   bool
+	PartialEndVisible     bool
+	TotalBeginVisible     bool
+	TotalEndVisible       bool
+	PenumbralBeginVisible bool
+	PenumbralEndVisible   bool
+
+	// Contact times set by SetTimes.
+	Max            float64 // time of maximum eclipse
+	PartialBegin   float64
+	PartialEnd     float64
+	TotalBegin     float64
+	TotalEnd       float64
+	PenumbralBegin float64
+	PenumbralEnd   float64
+	Moonrise       float64 // moonrise during the eclipse, local only
+	Moonset        float64 // moonset during the eclipse, local only
+
+	// Attributes set by SetAttributes.
+	UmbralMagnitude    float64
+	PenumbralMagnitude float64
+	MoonAzimuth        float64
+	MoonAlt            float64 // true altitude of moon above horizon
+	MoonAppAlt         float64 // apparent altitude of moon above horizon
+	OppositionDistance float64 // distance of moon from opposition in degrees
+	Saros              int     // saros series number
+	SarosMember        int     // saros series member number
+}
+
+// SetType sets the eclipse type bits t in e and decodes them into the boolean
+// fields of e.
+func (e *LunarEclipse) SetType(t EclipseType) {
+	e.Type = t
+	e.Total = t&EclTotal != 0
+	e.Partial = t&EclPartial != 0
+	e.Penumbral = t&EclPenumbral != 0
+	e.Visible = t&EclVisible != 0
+	e.MaxVisible = t&EclMaxVisible != 0
+	e.PartialBeginVisible = t&EclPartBegVisible != 0
+	e.PartialEndVisible = t&EclPartEndVisible != 0
+	e.TotalBeginVisible = t&EclTotBegVisible != 0
+	e.TotalEndVisible = t&EclTotEndVisible != 0
+	e.PenumbralBeginVisible = t&EclPenumbBegVisible != 0
+	e.PenumbralEndVisible = t&EclPenumbEndVisible != 0
+}
+
+// SetTimes sets the contact times in e from tret as returned by
+// swe_lun_eclipse_when and swe_lun_eclipse_when_loc.
+func (e *LunarEclipse) SetTimes(tret []float64) {
+	if len(tret) < 10 {
+		return
+	}
+
+	e.Max = tret[0]
+	e.PartialBegin = tret[2]
+	e.PartialEnd = tret[3]
+	e.TotalBegin = tret[4]
+	e.TotalEnd = tret[5]
+	e.PenumbralBegin = tret[6]
+	e.PenumbralEnd = tret[7]
+	e.Moonrise = tret[8]
+	e.Moonset = tret[9]
+}
+
+// SetAttributes sets the eclipse attributes in e from attr as returned by
+// swe_lun_eclipse_when_loc and swe_lun_eclipse_how.
+func (e *LunarEclipse) SetAttributes(attr []float64) {
+	if len(attr) < 11 {
+		return
+	}
+
+	e.UmbralMagnitude = attr[0]
+	e.PenumbralMagnitude = attr[1]
+	e.MoonAzimuth = attr[4]
+	e.MoonAlt = attr[5]
+	e.MoonAppAlt = attr[6]
+	e.OppositionDistance = attr[7]
+	e.Saros = int(attr[9])
+	e.SarosMember = int(attr[10])
+}
@@ -0,0 +1,83 @@
+package swego
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSolarEclipse_SetType(t *testing.T) {
+	var e SolarEclipse
+	e.SetType(EclNonCentral | EclPartial | EclVisible | Ecl4thVisible)
+
+	want := SolarEclipse{
+		Type:                 EclNonCentral | EclPartial | EclVisible | Ecl4thVisible,
+		NonCentral:           true,
+		Partial:              true,
+		Visible:              true,
+		FourthContactVisible: true,
+	}
+
+	if e != want {
+		t.Errorf("e = %+v, want: %+v", e, want)
+	}
+}
+
+func TestSolarEclipse_SetType_hybrid(t *testing.T) {
+	var e SolarEclipse
+	e.SetType(EclCentral | EclAnnularTotal)
+
+	if !e.Central || !e.Hybrid || e.Total || e.Annular {
+		t.Errorf("e = %+v, want: central hybrid eclipse", e)
+	}
+}
+
+func TestSolarEclipse_SetGlobalTimes(t *testing.T) {
+	var e SolarEclipse
+	e.SetGlobalTimes([]float64{1, 2, 3, 4, 5, 6, 7, 8, 0, 0})
+
+	got := []float64{e.Max, e.Noon, e.Begin, e.End, e.TotalBegin, e.TotalEnd, e.CenterBegin, e.CenterEnd}
+	for i, f := range got {
+		if f != float64(i+1) {
+			t.Errorf("time %d = %f, want: %d", i, f, i+1)
+		}
+	}
+}
+
+func TestSolarEclipse_SetAttributes_short(t *testing.T) {
+	var e SolarEclipse
+	e.SetAttributes([]float64{1, 2, 3})
+
+	if e != (SolarEclipse{}) {
+		t.Errorf("e = %+v, want: zero value", e)
+	}
+}
+
+func TestLunarEclipse_SetType(t *testing.T) {
+	var e LunarEclipse
+	e.SetType(EclTotal | EclVisible | EclMaxVisible | EclTotEndVisible | EclPenumbBegVisible)
+
+	want := LunarEclipse{
+		Type:                  EclTotal | EclVisible | EclMaxVisible | EclTotEndVisible | EclPenumbBegVisible,
+		Total:                 true,
+		Visible:               true,
+		MaxVisible:            true,
+		TotalEndVisible:       true,
+		PenumbralBeginVisible: true,
+	}
+
+	if e != want {
+		t.Errorf("e = %+v, want: %+v", e, want)
+	}
+}
+
+func TestLunarEclipse_SetTimes(t *testing.T) {
+	var e LunarEclipse
+	e.SetTimes([]float64{1, 0, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	got := []float64{e.Max, e.PartialBegin, e.PartialEnd, e.TotalBegin, e.TotalEnd,
+		e.PenumbralBegin, e.PenumbralEnd, e.Moonrise, e.Moonset}
+	want := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("times = %v, want: %v", got, want)
+	}
+}
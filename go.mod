@@ -5,4 +5,14 @@ go 1.18
 require (
 	github.com/philhofer/fwd v1.1.1
 	github.com/tinylib/msgp v1.1.6
+	google.golang.org/grpc v1.56.3
+	google.golang.org/protobuf v1.33.0
+)
+
+require (
+	github.com/golang/protobuf v1.5.3 // indirect
+	golang.org/x/net v0.9.0 // indirect
+	golang.org/x/sys v0.7.0 // indirect
+	golang.org/x/text v0.9.0 // indirect
+	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1 // indirect
 )
@@ -0,0 +1,196 @@
+package swego
+
+import (
+	"errors"
+	"strconv"
+)
+
+// HeliacalEvent is the type of heliacal event constants.
+type HeliacalEvent int32
+
+// VisionMode is the type of vision mode constants returned by VisLimitMag.
+type VisionMode int32
+
+// Atmosphere represents the atmospheric conditions of the heliacal functions.
+// Zero values are replaced by defaults within the Swiss Ephemeris.
+type Atmosphere struct {
+	Pressure    float64 // Atmospheric pressure in mbar (hPa)
+	Temperature float64 // Atmospheric temperature in °C
+	Humidity    float64 // Relative humidity in %
+
+	// Extinction is the meteorological range in km if it is at least 1 or the
+	// total atmospheric extinction coefficient if it is between 0 and 1. The
+	// extinction coefficient is computed from the other values if it is 0.
+	Extinction float64
+}
+
+// Observer represents the observer of the heliacal functions. Zero values are
+// replaced by defaults within the Swiss Ephemeris. The optical parameters are
+// only used if flag HelFlagOpticalParams is set.
+type Observer struct {
+	Age          float64 // Age of the observer in years, defaults to 36
+	SnellenRatio float64 // Visual acuity of the observer, defaults to 1
+
+	Binocular     bool
+	Magnification float64 // Telescope magnification, 0 or 1 is the naked eye
+	Aperture      float64 // Optical aperture (telescope diameter) in mm
+	Transmission  float64 // Optical transmission
+}
+
+// HeliacalFlags represents the library state, atmospheric conditions and
+// observer of the heliacal functions.
+type HeliacalFlags struct {
+	Flags      int32 // Ephemeris flag or'ed with HelFlag* constants
+	Atmosphere Atmosphere
+	Observer   Observer
+	JPLFile    string   // Argument to swe_set_jpl_file
+	DeltaT     *float64 // Argument to swe_set_delta_t_userdef, nil resets it.
+}
+
+// SetEphemeris sets the ephemeris flag in fl.
+func (fl *HeliacalFlags) SetEphemeris(eph Ephemeris) { fl.Flags |= int32(eph) }
+
+// SetDeltaT sets f as delta T in flags object fl.
+// Set fl.DeltaT to nil to reset the value within the Swiss Ephemeris.
+func (fl *HeliacalFlags) SetDeltaT(f float64) { fl.DeltaT = &f }
+
+// HeliacalName returns the object name of b as understood by the heliacal
+// functions. These functions recognize the planets from the sun to Neptune by
+// name and asteroids by their number, other names are looked up in the fixed
+// star catalog.
+func (b Body) HeliacalName() string {
+	if b.Star != "" {
+		return b.Star
+	}
+
+	if b.Planet > AstOffset {
+		return strconv.Itoa(int(b.Planet - AstOffset))
+	}
+
+	return b.Planet.String()
+}
+
+// HeliacalTimes represents the result of swe_heliacal_ut. All times are Julian
+// Dates in Universal Time.
+type HeliacalTimes struct {
+	Start   float64 // beginning of visibility
+	Optimum float64 // optimum visibility, 0 if HelFlagAV is set
+	End     float64 // end of visibility, 0 if HelFlagAV is set
+}
+
+// SetTimes sets the times in h from dret as returned by swe_heliacal_ut.
+func (h *HeliacalTimes) SetTimes(dret []float64) {
+	if len(dret) < 3 {
+		return
+	}
+
+	h.Start = dret[0]
+	h.Optimum = dret[1]
+	h.End = dret[2]
+}
+
+// HeliacalPheno represents the details of a heliacal event as returned by
+// swe_heliacal_pheno_ut. All angles are in degrees, all times are Julian Dates
+// in Universal Time.
+type HeliacalPheno struct {
+	ObjAlt        float64 // topocentric altitude of object (unrefracted)
+	ObjAppAlt     float64 // apparent altitude of object (refracted)
+	ObjGeoAlt     float64 // geocentric altitude of object
+	ObjAzimuth    float64
+	SunAlt        float64 // topocentric altitude of sun
+	SunAzimuth    float64
+	TopoArcVis    float64 // actual topocentric arcus visionis
+	GeoArcVis     float64 // actual geocentric arcus visionis
+	AzimuthDiff   float64 // actual difference between object's and sun's azimuth
+	LongDiff      float64 // actual longitude difference between object and sun
+	Extinction    float64 // extinction coefficient
+	MinTopoArcVis float64 // smallest topocentric arcus visionis
+	FirstVisible  float64 // first time object is visible, according to VR
+	BestVisible   float64 // optimum time object is visible, according to VR
+	LastVisible   float64 // last time object is visible, according to VR
+	BestYallop    float64 // best time object is visible, according to Yallop
+	MoonWidth     float64 // crescent width of moon
+	YallopQ       float64 // q-test value of Yallop
+	YallopQCrit   float64 // q-test criterion of Yallop
+	ObjParallax   float64 // parallax of object
+	ObjMagnitude  float64 // magnitude of object
+	ObjRiseSet    float64 // rise or set time of object
+	SunRiseSet    float64 // rise or set time of sun
+	Lag           float64 // rise or set time of object minus that of sun
+	VisDuration   float64 // visibility duration in days
+	MoonLength    float64 // crescent length of moon
+	Elongation    float64
+	Illumination  float64 // illumination in %
+}
+
+// SetAttributes sets the details in p from darr as returned by
+// swe_heliacal_pheno_ut.
+func (p *HeliacalPheno) SetAttributes(darr []float64) {
+	if len(darr) < 28 {
+		return
+	}
+
+	p.ObjAlt = darr[0]
+	p.ObjAppAlt = darr[1]
+	p.ObjGeoAlt = darr[2]
+	p.ObjAzimuth = darr[3]
+	p.SunAlt = darr[4]
+	p.SunAzimuth = darr[5]
+	p.TopoArcVis = darr[6]
+	p.GeoArcVis = darr[7]
+	p.AzimuthDiff = darr[8]
+	p.LongDiff = darr[9]
+	p.Extinction = darr[10]
+	p.MinTopoArcVis = darr[11]
+	p.FirstVisible = darr[12]
+	p.BestVisible = darr[13]
+	p.LastVisible = darr[14]
+	p.BestYallop = darr[15]
+	p.MoonWidth = darr[16]
+	p.YallopQ = darr[17]
+	p.YallopQCrit = darr[18]
+	p.ObjParallax = darr[19]
+	p.ObjMagnitude = darr[20]
+	p.ObjRiseSet = darr[21]
+	p.SunRiseSet = darr[22]
+	p.Lag = darr[23]
+	p.VisDuration = darr[24]
+	p.MoonLength = darr[25]
+	p.Elongation = darr[26]
+	p.Illumination = darr[27]
+}
+
+// VisLimit represents the result of swe_vis_limit_mag. All angles are in
+// degrees.
+type VisLimit struct {
+	Mode         VisionMode // vision mode the limiting magnitude is computed for
+	LimitingMag  float64    // the object is visible if it is brighter than this
+	ObjAlt       float64
+	ObjAzimuth   float64
+	SunAlt       float64
+	SunAzimuth   float64
+	MoonAlt      float64
+	MoonAzimuth  float64
+	ObjMagnitude float64
+}
+
+// SetAttributes sets the values in v from dret as returned by
+// swe_vis_limit_mag.
+func (v *VisLimit) SetAttributes(dret []float64) {
+	if len(dret) < 8 {
+		return
+	}
+
+	v.LimitingMag = dret[0]
+	v.ObjAlt = dret[1]
+	v.ObjAzimuth = dret[2]
+	v.SunAlt = dret[3]
+	v.SunAzimuth = dret[4]
+	v.MoonAlt = dret[5]
+	v.MoonAzimuth = dret[6]
+	v.ObjMagnitude = dret[7]
+}
+
+// ErrBelowHorizon is returned by VisLimitMag if the object is below the local
+// horizon.
+var ErrBelowHorizon = errors.New("swisseph: object is below local horizon")
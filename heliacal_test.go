@@ -0,0 +1,42 @@
+package swego
+
+import "testing"
+
+func TestBody_HeliacalName(t *testing.T) {
+	cases := []struct {
+		body Body
+		want string
+	}{
+		{Body{Planet: Venus}, "Venus"},
+		{Body{Planet: Mercury}, "Mercury"},
+		{Body{Planet: AstOffset + 433}, "433"},
+		{Body{Star: "Sirius"}, "Sirius"},
+		{Body{Planet: Moon, Star: "Aldebaran"}, "Aldebaran"},
+	}
+
+	for _, c := range cases {
+		if got := c.body.HeliacalName(); got != c.want {
+			t.Errorf("%+v.HeliacalName() = %q, want: %q", c.body, got, c.want)
+		}
+	}
+}
+
+func TestVisLimit_SetAttributes(t *testing.T) {
+	var v VisLimit
+	v.SetAttributes([]float64{1, 2, 3, 4, 5, 6, 7, 8})
+
+	want := VisLimit{
+		LimitingMag:  1,
+		ObjAlt:       2,
+		ObjAzimuth:   3,
+		SunAlt:       4,
+		SunAzimuth:   5,
+		MoonAlt:      6,
+		MoonAzimuth:  7,
+		ObjMagnitude: 8,
+	}
+
+	if v != want {
+		t.Errorf("v = %+v, want: %+v", v, want)
+	}
+}
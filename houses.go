@@ -0,0 +1,63 @@
+package swego
+
+// GauquelinMethod is the type of Gauquelin sector method constants.
+type GauquelinMethod int32
+
+// Houses represents the house cusps, the related points and their speeds as
+// returned by swe_houses_ex2 and swe_houses_armc_ex2. Speeds are in degrees
+// per day.
+type Houses struct {
+	// Cusps and CuspSpeeds are indexed by house number, index 0 is unused.
+	// Both contain 13 values or 37 values for Gauquelin sectors.
+	Cusps      []float64
+	CuspSpeeds []float64
+
+	Asc    float64
+	MC     float64
+	ARMC   float64
+	Vertex float64
+	EquAsc float64 // "equatorial ascendant"
+	CoAsc1 float64 // "co-ascendant" (W. Koch)
+	CoAsc2 float64 // "co-ascendant" (M. Munkasey)
+	PolAsc float64 // "polar ascendant" (M. Munkasey)
+
+	AscSpeed    float64
+	MCSpeed     float64
+	ARMCSpeed   float64
+	VertexSpeed float64
+	EquAscSpeed float64
+	CoAsc1Speed float64
+	CoAsc2Speed float64
+	PolAscSpeed float64
+
+	// Warning is set if the library reports a problem that did not prevent
+	// the calculation, for example the fallback to Porphyry houses within
+	// the polar circles.
+	Warning string
+}
+
+// SetAscMC sets the related house positions in h from ascmc and their
+// speeds from speeds as returned by swe_houses_ex2.
+func (h *Houses) SetAscMC(ascmc, speeds []float64) {
+	if len(ascmc) < 8 || len(speeds) < 8 {
+		return
+	}
+
+	h.Asc = ascmc[Asc]
+	h.MC = ascmc[MC]
+	h.ARMC = ascmc[ARMC]
+	h.Vertex = ascmc[Vertex]
+	h.EquAsc = ascmc[EquAsc]
+	h.CoAsc1 = ascmc[CoAsc1]
+	h.CoAsc2 = ascmc[CoAsc2]
+	h.PolAsc = ascmc[PolAsc]
+
+	h.AscSpeed = speeds[Asc]
+	h.MCSpeed = speeds[MC]
+	h.ARMCSpeed = speeds[ARMC]
+	h.VertexSpeed = speeds[Vertex]
+	h.EquAscSpeed = speeds[EquAsc]
+	h.CoAsc1Speed = speeds[CoAsc1]
+	h.CoAsc2Speed = speeds[CoAsc2]
+	h.PolAscSpeed = speeds[PolAsc]
+}
@@ -0,0 +1,22 @@
+package swego
+
+import "testing"
+
+func TestHouses_SetAscMC(t *testing.T) {
+	var h Houses
+	h.SetAscMC([]float64{1, 2, 3, 4, 5, 6, 7, 8, 0, 0}, []float64{11, 12, 13, 14, 15, 16, 17, 18, 0, 0})
+
+	if h.Asc != 1 || h.MC != 2 || h.ARMC != 3 || h.PolAsc != 8 {
+		t.Errorf("h = %+v, want positions 1 to 8", h)
+	}
+
+	if h.AscSpeed != 11 || h.MCSpeed != 12 || h.ARMCSpeed != 13 || h.PolAscSpeed != 18 {
+		t.Errorf("h = %+v, want speeds 11 to 18", h)
+	}
+
+	h = Houses{}
+	h.SetAscMC([]float64{1, 2, 3}, nil)
+	if h.Asc != 0 {
+		t.Errorf("h = %+v, want zero value", h)
+	}
+}
@@ -0,0 +1,103 @@
+package swego
+
+// Body identifies a planet or a fixed star.
+type Body struct {
+	Planet Planet
+	Star   string // Fixed star name, see FixStar. Overrides Planet if not empty.
+}
+
+// Occultation represents the circumstances of an occultation of a planet or
+// fixed star by the moon. The embedded SolarEclipse fields have the same
+// meaning, with the occulted body in place of the sun.
+type Occultation struct {
+	SolarEclipse
+
+	// Daylight bits decoded by SetType.
+	BeginDaylight bool // occultation begins during the day
+	EndDaylight   bool // occultation ends during the day
+}
+
+// SetType sets the occultation type bits t in o and decodes them into the
+// boolean fields of o.
+func (o *Occultation) SetType(t EclipseType) {
+	o.SolarEclipse.SetType(t)
+	o.BeginDaylight = t&EclOccBegDaylight != 0
+	o.EndDaylight = t&EclOccEndDaylight != 0
+}
+
+// OccultationIter iterates over the occultations of a body by the moon within
+// a range of Julian Dates. Each conjunction of the moon with the body is tested
+// once, so the search for bodies that are never occulted terminates at the end
+// of the range.
+//
+//	it := swego.NewOccultationIter(swe, swego.Body{Star: "Regulus"}, fl, start, end)
+//	for it.Next() {
+//		o := it.Occultation()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type OccultationIter struct {
+	swe  Interface
+	body Body
+	fl   *EclipseFlags
+	t    float64
+	end  float64
+	occ  Occultation
+	err  error
+}
+
+// NewOccultationIter returns an iterator over the occultations of body with a
+// maximum between Julian Dates (in Universal Time) start and end.
+func NewOccultationIter(swe Interface, body Body, fl *EclipseFlags, start, end float64) *OccultationIter {
+	return &OccultationIter{swe: swe, body: body, fl: fl, t: start, end: end}
+}
+
+// Next advances the iterator to the next occultation, which will then be
+// available through the Occultation method. It returns false when the end of
+// the range is reached or an error occurred.
+func (it *OccultationIter) Next() bool {
+	start := it.t
+	for it.err == nil && it.t < it.end {
+		o, err := it.swe.LunOccultWhenGlob(it.t, it.body, it.fl, EclOneTry, false)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		// Max is a suitable start time for the next search if there is no
+		// occultation at the tested conjunction. The moon has moved well past
+		// the body a day after the maximum of an occultation.
+		next := o.Max
+		if o.Type != 0 {
+			next++
+		}
+
+		if next <= it.t {
+			next = it.t + 1
+		}
+
+		it.t = next
+
+		if o.Type == 0 || o.Max < start {
+			continue
+		}
+
+		if o.Max > it.end {
+			it.t = it.end
+			return false
+		}
+
+		it.occ = o
+		return true
+	}
+
+	return false
+}
+
+// Occultation returns the occultation found by the most recent call to Next.
+func (it *OccultationIter) Occultation() Occultation { return it.occ }
+
+// Err returns the first error that was encountered by the iterator.
+func (it *OccultationIter) Err() error { return it.err }
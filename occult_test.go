@@ -0,0 +1,91 @@
+package swego
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// occultTestSwe returns the occultations in results for consecutive calls to
+// LunOccultWhenGlob, other methods of Interface are not implemented.
+type occultTestSwe struct {
+	Interface
+	results []Occultation
+	starts  []float64
+	err     error
+}
+
+func (swe *occultTestSwe) LunOccultWhenGlob(ut float64, body Body, fl *EclipseFlags, typ EclipseType, backward bool) (Occultation, error) {
+	swe.starts = append(swe.starts, ut)
+	if typ != EclOneTry || backward {
+		return Occultation{}, errors.New("unexpected search flags")
+	}
+
+	if len(swe.results) == 0 {
+		return Occultation{}, swe.err
+	}
+
+	o := swe.results[0]
+	swe.results = swe.results[1:]
+	return o, nil
+}
+
+func occultAt(typ EclipseType, max float64) (o Occultation) {
+	o.SetType(typ)
+	o.Max = max
+	return o
+}
+
+func TestOccultationIter(t *testing.T) {
+	swe := &occultTestSwe{results: []Occultation{
+		occultAt(EclTotal, 105),
+		occultAt(0, 130), // no occultation at this conjunction
+		occultAt(EclPartial, 160),
+		occultAt(EclTotal, 190), // outside of range
+	}}
+
+	it := NewOccultationIter(swe, Body{Star: "Aldebaran"}, nil, 100, 180)
+
+	var got []float64
+	for it.Next() {
+		got = append(got, it.Occultation().Max)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if want := []float64{105, 160}; !reflect.DeepEqual(got, want) {
+		t.Errorf("max = %v, want: %v", got, want)
+	}
+
+	if want := []float64{100, 106, 130, 161}; !reflect.DeepEqual(swe.starts, want) {
+		t.Errorf("starts = %v, want: %v", swe.starts, want)
+	}
+
+	if it.Next() {
+		t.Error("Next() = true after end of range, want: false")
+	}
+}
+
+func TestOccultationIter_error(t *testing.T) {
+	swe := &occultTestSwe{err: Error("occultation never occurs")}
+
+	it := NewOccultationIter(swe, Body{Star: "Polaris"}, nil, 100, 180)
+	if it.Next() {
+		t.Error("Next() = true, want: false")
+	}
+
+	if err := it.Err(); err != swe.err {
+		t.Errorf("err = %v, want: %v", err, swe.err)
+	}
+}
+
+func TestOccultation_SetType(t *testing.T) {
+	var o Occultation
+	o.SetType(EclTotal | EclVisible | EclOccBegDaylight)
+
+	if !o.Total || !o.Visible || !o.BeginDaylight || o.EndDaylight {
+		t.Errorf("o = %+v, want: visible total occultation beginning during the day", o)
+	}
+}
@@ -0,0 +1,50 @@
+package swego
+
+// OrbitalElements represents the osculating orbital elements of a planet as
+// returned by swe_get_orbital_elements. Angles are in degrees, distances in
+// AU.
+type OrbitalElements struct {
+	SemiMajorAxis      float64
+	Eccentricity       float64
+	Inclination        float64
+	AscNode            float64 // longitude of the ascending node
+	ArgPerihelion      float64 // argument of perihelion
+	LongPerihelion     float64 // longitude of perihelion
+	MeanAnomaly        float64 // mean anomaly at epoch
+	TrueAnomaly        float64 // true anomaly at epoch
+	EccentricAnomaly   float64 // eccentric anomaly at epoch
+	MeanLongitude      float64 // mean longitude at epoch
+	SiderealPeriod     float64 // sidereal orbital period in tropical years
+	DailyMotion        float64 // mean daily motion in degrees
+	TropicalPeriod     float64 // tropical period in years
+	SynodicPeriod      float64 // synodic period in days, negative for inner planets and the moon, 0 for the earth
+	PerihelionPassage  float64 // Julian Date (in Ephemeris Time) of perihelion passage
+	PerihelionDistance float64
+	AphelionDistance   float64
+}
+
+// SetElements sets the orbital elements in o from dret as returned by
+// swe_get_orbital_elements.
+func (o *OrbitalElements) SetElements(dret []float64) {
+	if len(dret) < 17 {
+		return
+	}
+
+	o.SemiMajorAxis = dret[0]
+	o.Eccentricity = dret[1]
+	o.Inclination = dret[2]
+	o.AscNode = dret[3]
+	o.ArgPerihelion = dret[4]
+	o.LongPerihelion = dret[5]
+	o.MeanAnomaly = dret[6]
+	o.TrueAnomaly = dret[7]
+	o.EccentricAnomaly = dret[8]
+	o.MeanLongitude = dret[9]
+	o.SiderealPeriod = dret[10]
+	o.DailyMotion = dret[11]
+	o.TropicalPeriod = dret[12]
+	o.SynodicPeriod = dret[13]
+	o.PerihelionPassage = dret[14]
+	o.PerihelionDistance = dret[15]
+	o.AphelionDistance = dret[16]
+}
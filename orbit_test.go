@@ -0,0 +1,43 @@
+package swego
+
+import "testing"
+
+func TestOrbitalElements_SetElements(t *testing.T) {
+	dret := make([]float64, 50)
+	for i := range dret[:17] {
+		dret[i] = float64(i + 1)
+	}
+
+	var o OrbitalElements
+	o.SetElements(dret)
+
+	want := OrbitalElements{
+		SemiMajorAxis:      1,
+		Eccentricity:       2,
+		Inclination:        3,
+		AscNode:            4,
+		ArgPerihelion:      5,
+		LongPerihelion:     6,
+		MeanAnomaly:        7,
+		TrueAnomaly:        8,
+		EccentricAnomaly:   9,
+		MeanLongitude:      10,
+		SiderealPeriod:     11,
+		DailyMotion:        12,
+		TropicalPeriod:     13,
+		SynodicPeriod:      14,
+		PerihelionPassage:  15,
+		PerihelionDistance: 16,
+		AphelionDistance:   17,
+	}
+
+	if o != want {
+		t.Errorf("o = %+v, want: %+v", o, want)
+	}
+
+	o = OrbitalElements{}
+	o.SetElements(dret[:3])
+	if o != (OrbitalElements{}) {
+		t.Errorf("o = %+v, want zero value", o)
+	}
+}
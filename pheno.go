@@ -0,0 +1,27 @@
+package swego
+
+// Phenomena represents the phenomena of a planet as returned by swe_pheno and
+// swe_pheno_ut. All angles are in degrees.
+type Phenomena struct {
+	PhaseAngle         float64 // angle between sun, planet and earth
+	Phase              float64 // illuminated fraction of the disc
+	Elongation         float64 // angular distance of the planet from the sun
+	DiscDiameter       float64 // apparent diameter of the disc
+	Magnitude          float64 // apparent visual magnitude
+	HorizontalParallax float64 // equatorial horizontal parallax, moon only
+}
+
+// SetAttributes sets the phenomena in p from attr as returned by swe_pheno and
+// swe_pheno_ut.
+func (p *Phenomena) SetAttributes(attr []float64) {
+	if len(attr) < 6 {
+		return
+	}
+
+	p.PhaseAngle = attr[0]
+	p.Phase = attr[1]
+	p.Elongation = attr[2]
+	p.DiscDiameter = attr[3]
+	p.Magnitude = attr[4]
+	p.HorizontalParallax = attr[5]
+}
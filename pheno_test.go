@@ -0,0 +1,27 @@
+package swego
+
+import "testing"
+
+func TestPhenomena_SetAttributes(t *testing.T) {
+	var p Phenomena
+	p.SetAttributes([]float64{1, 2, 3, 4, 5, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+
+	want := Phenomena{
+		PhaseAngle:         1,
+		Phase:              2,
+		Elongation:         3,
+		DiscDiameter:       4,
+		Magnitude:          5,
+		HorizontalParallax: 6,
+	}
+
+	if p != want {
+		t.Errorf("p = %+v, want: %+v", p, want)
+	}
+
+	p = Phenomena{}
+	p.SetAttributes([]float64{1, 2, 3})
+	if p != (Phenomena{}) {
+		t.Errorf("p = %+v, want zero value", p)
+	}
+}
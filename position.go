@@ -0,0 +1,117 @@
+package swego
+
+import "fmt"
+
+// ReturnedFlags represents the calculation flags returned by Calc, CalcUT and
+// CalcPctr. They may differ from the flags passed to the calculation, e.g.
+// if the library had to fall back to another ephemeris.
+type ReturnedFlags int32
+
+const ephMask = FlagEphJPL | FlagEphSwiss | FlagEphMoshier
+
+// UsedEphemeris returns the ephemeris that was used in the calculation.
+func (f ReturnedFlags) UsedEphemeris() Ephemeris { return requestedEphemeris(int32(f)) }
+
+// Has reports whether all bits of flag are set in f.
+func (f ReturnedFlags) Has(flag int32) bool { return int32(f)&flag == flag }
+
+// requestedEphemeris returns the ephemeris the library selects for flags fl.
+// Like the library, the JPL ephemeris takes precedence over the Swiss
+// Ephemeris, which takes precedence over the Moshier ephemeris.
+func requestedEphemeris(fl int32) Ephemeris {
+	switch {
+	case fl&FlagEphJPL != 0:
+		return JPL
+	case fl&FlagEphSwiss != 0:
+		return Swiss
+	case fl&FlagEphMoshier != 0:
+		return Moshier
+	}
+
+	return DefaultEph
+}
+
+func ephemerisName(eph Ephemeris) string {
+	switch eph {
+	case JPL:
+		return "JPL"
+	case Swiss:
+		return "Swiss Ephemeris"
+	case Moshier:
+		return "Moshier"
+	}
+
+	return fmt.Sprintf("Ephemeris(%d)", int32(eph))
+}
+
+// Position represents the position and speed of a planet as returned by
+// Calc, CalcUT and CalcPctr. The meaning of the coordinates depends on the
+// calculation flags, e.g. with FlagEquatorial Lon is the right ascension and
+// Lat the declination, see Ecliptic, Equatorial and Cartesian.
+type Position struct {
+	Lon       float64
+	Lat       float64
+	Dist      float64
+	LonSpeed  float64
+	LatSpeed  float64
+	DistSpeed float64
+	Flags     ReturnedFlags
+
+	// Warning is set if the library silently fell back from the requested
+	// ephemeris to another one, usually because the ephemeris files were not
+	// found. The position is valid but less precise than requested.
+	Warning string
+}
+
+// NewPosition returns the position xx and returned flags cfl as returned by
+// Calc, CalcUT or CalcPctr called with calculation flags fl.
+func NewPosition(xx []float64, cfl int, fl int32) Position {
+	var x [6]float64
+	copy(x[:], xx)
+	p := Position{x[0], x[1], x[2], x[3], x[4], x[5], ReturnedFlags(cfl), ""}
+
+	req := requestedEphemeris(fl)
+	if used := p.Flags.UsedEphemeris(); int32(p.Flags)&ephMask != 0 && used != req {
+		p.Warning = fmt.Sprintf("%s requested, fell back to %s", ephemerisName(req), ephemerisName(used))
+	}
+
+	return p
+}
+
+// Coords returns p in the layout of Calc.
+func (p Position) Coords() []float64 {
+	return []float64{p.Lon, p.Lat, p.Dist, p.LonSpeed, p.LatSpeed, p.DistSpeed}
+}
+
+// IsRetrograde reports whether the planet moves backwards in longitude (or
+// right ascension). It requires the position to be calculated with
+// FlagSpeed.
+func (p Position) IsRetrograde() bool { return p.LonSpeed < 0 }
+
+// CalcPosition is like Calc, but returns the result as Position.
+func CalcPosition(swe Interface, et float64, pl Planet, fl *CalcFlags) (Position, error) {
+	xx, cfl, err := swe.Calc(et, pl, fl)
+	if err != nil {
+		return Position{}, err
+	}
+
+	return NewPosition(xx, cfl, calcFlags(fl)), nil
+}
+
+// CalcPositionUT is like CalcUT, but returns the result as Position.
+func CalcPositionUT(swe Interface, ut float64, pl Planet, fl *CalcFlags) (Position, error) {
+	xx, cfl, err := swe.CalcUT(ut, pl, fl)
+	if err != nil {
+		return Position{}, err
+	}
+
+	return NewPosition(xx, cfl, calcFlags(fl)), nil
+}
+
+func calcFlags(fl *CalcFlags) int32 {
+	if fl == nil {
+		return 0
+	}
+
+	return fl.Flags
+}
@@ -0,0 +1,113 @@
+package swego
+
+import (
+	"reflect"
+	"testing"
+)
+
+// calcTestSwe implements Calc and CalcUT, other methods of Interface are not
+// implemented.
+type calcTestSwe struct {
+	Interface
+	xx  []float64
+	cfl int
+	err error
+}
+
+func (swe *calcTestSwe) Calc(et float64, pl Planet, fl *CalcFlags) ([]float64, int, error) {
+	return swe.xx, swe.cfl, swe.err
+}
+
+func (swe *calcTestSwe) CalcUT(ut float64, pl Planet, fl *CalcFlags) ([]float64, int, error) {
+	return swe.xx, swe.cfl, swe.err
+}
+
+func TestReturnedFlags_UsedEphemeris(t *testing.T) {
+	cases := []struct {
+		f    ReturnedFlags
+		want Ephemeris
+	}{
+		{FlagEphJPL | FlagSpeed, JPL},
+		{FlagEphSwiss | FlagSpeed, Swiss},
+		{FlagEphMoshier | FlagSpeed, Moshier},
+		{FlagEphJPL | FlagEphMoshier, JPL},
+		{FlagSpeed, DefaultEph},
+	}
+
+	for _, c := range cases {
+		if got := c.f.UsedEphemeris(); got != c.want {
+			t.Errorf("ReturnedFlags(%d).UsedEphemeris() = %d, want: %d", c.f, got, c.want)
+		}
+	}
+}
+
+func TestReturnedFlags_Has(t *testing.T) {
+	f := ReturnedFlags(FlagEphSwiss | FlagSpeed | FlagNoGDefl)
+	if !f.Has(FlagSpeed) {
+		t.Error("Has(FlagSpeed) = false, want: true")
+	}
+
+	if f.Has(FlagAstrometric) {
+		t.Error("Has(FlagAstrometric) = true, want: false")
+	}
+}
+
+func TestNewPosition(t *testing.T) {
+	xx := []float64{280.37, 0.0002, 0.98, 1.02, -0.0001, -0.00001}
+	cases := []struct {
+		cfl     int
+		fl      int32
+		warning string
+	}{
+		{FlagEphSwiss | FlagSpeed, FlagEphSwiss | FlagSpeed, ""},
+		{FlagEphSwiss | FlagSpeed, FlagSpeed, ""},
+		{FlagEphMoshier | FlagSpeed, FlagEphMoshier | FlagSpeed, ""},
+		{FlagEphMoshier | FlagSpeed, FlagSpeed, "Swiss Ephemeris requested, fell back to Moshier"},
+		{FlagEphMoshier, FlagEphJPL, "JPL requested, fell back to Moshier"},
+		{FlagEphSwiss, FlagEphJPL, "JPL requested, fell back to Swiss Ephemeris"},
+		{FlagSpeed, FlagEphJPL | FlagSpeed, ""},
+	}
+
+	for _, c := range cases {
+		p := NewPosition(xx, c.cfl, c.fl)
+		if p.Warning != c.warning {
+			t.Errorf("NewPosition(%d, %d).Warning = %q, want: %q", c.cfl, c.fl, p.Warning, c.warning)
+		}
+
+		if p.Flags != ReturnedFlags(c.cfl) {
+			t.Errorf("NewPosition(%d, %d).Flags = %d, want: %d", c.cfl, c.fl, p.Flags, c.cfl)
+		}
+	}
+}
+
+func TestCalcPosition(t *testing.T) {
+	xx := []float64{120.5, -1.25, 2.5, -0.1, 0.01, 0.001}
+	swe := &calcTestSwe{xx: xx, cfl: FlagEphMoshier | FlagSpeed}
+	fl := &CalcFlags{Flags: FlagEphSwiss | FlagSpeed}
+
+	for _, fn := range []func(Interface, float64, Planet, *CalcFlags) (Position, error){CalcPosition, CalcPositionUT} {
+		p, err := fn(swe, 2451545, Mars, fl)
+		if err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+
+		if got := p.Coords(); !reflect.DeepEqual(got, xx) {
+			t.Errorf("Coords() = %v, want: %v", got, xx)
+		}
+
+		if !p.IsRetrograde() {
+			t.Error("IsRetrograde() = false, want: true")
+		}
+
+		if p.Warning == "" {
+			t.Error("Warning = \"\", want fallback warning")
+		}
+	}
+}
+
+func TestCalcPosition_error(t *testing.T) {
+	swe := &calcTestSwe{err: Error("error")}
+	if _, err := CalcPosition(swe, 2451545, Mars, nil); err != Error("error") {
+		t.Errorf("err = %v, want: \"error\"", err)
+	}
+}
@@ -0,0 +1,44 @@
+package swego
+
+import "fmt"
+
+// RiseTransEvent is the type of rise and transit event constants and bits.
+type RiseTransEvent int32
+
+// RiseTransFlags represents the library state and atmospheric conditions of
+// swe_rise_trans and swe_rise_trans_true_hor.
+type RiseTransFlags struct {
+	Flags   int32    // Ephemeris flag
+	AtPress float64  // Atmospheric pressure in mbar (hPa), 0 estimates it
+	AtTemp  float64  // Atmospheric temperature in °C
+	HorHgt  *float64 // Height of the true horizon in degrees, nil uses swe_rise_trans.
+	JPLFile string   // Argument to swe_set_jpl_file
+	DeltaT  *float64 // Argument to swe_set_delta_t_userdef, nil resets it.
+}
+
+// SetEphemeris sets the ephemeris flag in fl.
+func (fl *RiseTransFlags) SetEphemeris(eph Ephemeris) { fl.Flags |= int32(eph) }
+
+// SetHorHgt sets f as height of the true horizon in flags object fl.
+// Set fl.HorHgt to nil to use the mathematical horizon.
+func (fl *RiseTransFlags) SetHorHgt(f float64) { fl.HorHgt = &f }
+
+// SetDeltaT sets f as delta T in flags object fl.
+// Set fl.DeltaT to nil to reset the value within the Swiss Ephemeris.
+func (fl *RiseTransFlags) SetDeltaT(f float64) { fl.DeltaT = &f }
+
+// CircumpolarError is returned by RiseTrans if no rise or set of the body is
+// found, because it is circumpolar or never rises at the location.
+type CircumpolarError struct {
+	Body  Body
+	Event RiseTransEvent
+}
+
+func (e *CircumpolarError) Error() string {
+	name := e.Body.Star
+	if name == "" {
+		name = e.Body.Planet.String()
+	}
+
+	return fmt.Sprintf("swisseph: no rise or set of %s found, body is circumpolar or never rises", name)
+}
@@ -0,0 +1,20 @@
+package swego
+
+import "testing"
+
+func TestCircumpolarError_Error(t *testing.T) {
+	cases := []struct {
+		body Body
+		want string
+	}{
+		{Body{Planet: Sun}, "swisseph: no rise or set of Sun found, body is circumpolar or never rises"},
+		{Body{Star: "Polaris"}, "swisseph: no rise or set of Polaris found, body is circumpolar or never rises"},
+	}
+
+	for _, c := range cases {
+		err := &CircumpolarError{Body: c.body, Event: CalcRise}
+		if got := err.Error(); got != c.want {
+			t.Errorf("Error() = %q, want: %q", got, c.want)
+		}
+	}
+}
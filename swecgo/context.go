@@ -0,0 +1,681 @@
+//go:build (linux && cgo) || (darwin && cgo)
+// +build linux,cgo darwin,cgo
+
+package swecgo
+
+import (
+	"context"
+
+	"github.com/howesteve/swego"
+)
+
+// WithContext returns library swe as swego.ContextInterface. The C library
+// cannot abandon a running call, a call returns the context error only if the
+// context is done before the call is started, including while waiting for
+// another call to release the library.
+// If swe is not returned by this package, it panics.
+func WithContext(swe Library) swego.ContextInterface {
+	switch w := swe.(type) {
+	case *wrapper:
+		return ctxWrapper{w}
+	case exclLocked:
+		return ctxWrapper{w.wrapper}
+	}
+
+	panic("swecgo: unknown library implementation")
+}
+
+type ctxWrapper struct {
+	w *wrapper
+}
+
+var _ swego.ContextInterface = ctxWrapper{} // assert interface
+
+// lock acquires the wrapper unless ctx is done before the library is
+// released by other calls.
+func (w ctxWrapper) lock(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := w.w.locker.LockContext(ctx); err != nil {
+		return err
+	}
+
+	// both ctx and the lock may have been ready
+	if err := ctx.Err(); err != nil {
+		w.w.locker.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+func (w ctxWrapper) unlock() { w.w.locker.Unlock() }
+
+func (w ctxWrapper) Version(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	return w.w.Version()
+}
+
+func (w ctxWrapper) PlanetName(ctx context.Context, pl swego.Planet) (string, error) {
+	if err := w.lock(ctx); err != nil {
+		return "", err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.PlanetName(pl)
+}
+
+func (w ctxWrapper) Calc(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) (xx []float64, cfl int, err error) {
+	if err := w.lock(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.Calc(et, pl, fl)
+}
+
+func (w ctxWrapper) CalcUT(ctx context.Context, ut float64, pl swego.Planet, fl *swego.CalcFlags) (xx []float64, cfl int, err error) {
+	if err := w.lock(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.CalcUT(ut, pl, fl)
+}
+
+func (w ctxWrapper) CalcBatch(ctx context.Context, requests []swego.CalcRequest) []swego.CalcResult {
+	results := make([]swego.CalcResult, len(requests))
+	if err := w.lock(ctx); err != nil {
+		setBatchError(results, err)
+		return results
+	}
+
+	defer w.unlock()
+	calcBatch(ctx, requests, results)
+	return results
+}
+
+func (w ctxWrapper) CalcPctr(ctx context.Context, et float64, pl, center swego.Planet, fl *swego.CalcFlags) (xx []float64, cfl int, err error) {
+	if err := w.lock(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.CalcPctr(et, pl, center, fl)
+}
+
+func (w ctxWrapper) FixStar(ctx context.Context, star string, et float64, fl *swego.CalcFlags) (name string, xx []float64, cfl int, err error) {
+	if err := w.lock(ctx); err != nil {
+		return "", nil, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.FixStar(star, et, fl)
+}
+
+func (w ctxWrapper) FixStarUT(ctx context.Context, star string, ut float64, fl *swego.CalcFlags) (name string, xx []float64, cfl int, err error) {
+	if err := w.lock(ctx); err != nil {
+		return "", nil, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.FixStarUT(star, ut, fl)
+}
+
+func (w ctxWrapper) FixStarMag(ctx context.Context, star string) (name string, mag float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return "", 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.FixStarMag(star)
+}
+
+func (w ctxWrapper) FixStar2(ctx context.Context, star string, et float64, fl *swego.CalcFlags) (name string, xx []float64, cfl int, err error) {
+	if err := w.lock(ctx); err != nil {
+		return "", nil, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.FixStar2(star, et, fl)
+}
+
+func (w ctxWrapper) FixStar2UT(ctx context.Context, star string, ut float64, fl *swego.CalcFlags) (name string, xx []float64, cfl int, err error) {
+	if err := w.lock(ctx); err != nil {
+		return "", nil, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.FixStar2UT(star, ut, fl)
+}
+
+func (w ctxWrapper) FixStar2Mag(ctx context.Context, star string) (name string, mag float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return "", 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.FixStar2Mag(star)
+}
+
+func (w ctxWrapper) NodAps(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags, m swego.NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.NodAps(et, pl, fl, m)
+}
+
+func (w ctxWrapper) NodApsUT(ctx context.Context, ut float64, pl swego.Planet, fl *swego.CalcFlags, m swego.NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.NodApsUT(ut, pl, fl, m)
+}
+
+func (w ctxWrapper) GetOrbitalElements(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) (swego.OrbitalElements, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.OrbitalElements{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.GetOrbitalElements(et, pl, fl)
+}
+
+func (w ctxWrapper) OrbitMaxMinTrueDistance(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) (dmax, dmin, dtrue float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.OrbitMaxMinTrueDistance(et, pl, fl)
+}
+
+func (w ctxWrapper) SolCross(ctx context.Context, x2cross, et float64, fl *swego.CalcFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.SolCross(x2cross, et, fl)
+}
+
+func (w ctxWrapper) SolCrossUT(ctx context.Context, x2cross, ut float64, fl *swego.CalcFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.SolCrossUT(x2cross, ut, fl)
+}
+
+func (w ctxWrapper) MoonCross(ctx context.Context, x2cross, et float64, fl *swego.CalcFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.MoonCross(x2cross, et, fl)
+}
+
+func (w ctxWrapper) MoonCrossUT(ctx context.Context, x2cross, ut float64, fl *swego.CalcFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.MoonCrossUT(x2cross, ut, fl)
+}
+
+func (w ctxWrapper) MoonCrossNode(ctx context.Context, et float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.MoonCrossNode(et, fl)
+}
+
+func (w ctxWrapper) MoonCrossNodeUT(ctx context.Context, ut float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.MoonCrossNodeUT(ut, fl)
+}
+
+func (w ctxWrapper) HelioCross(ctx context.Context, pl swego.Planet, x2cross, et float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HelioCross(pl, x2cross, et, fl, backward)
+}
+
+func (w ctxWrapper) HelioCrossUT(ctx context.Context, pl swego.Planet, x2cross, ut float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HelioCrossUT(pl, x2cross, ut, fl, backward)
+}
+
+func (w ctxWrapper) GetAyanamsaEx(ctx context.Context, et float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.GetAyanamsaEx(et, fl)
+}
+
+func (w ctxWrapper) GetAyanamsaExUT(ctx context.Context, ut float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.GetAyanamsaExUT(ut, fl)
+}
+
+func (w ctxWrapper) GetAyanamsaName(ctx context.Context, ayan swego.Ayanamsa) (string, error) {
+	if err := w.lock(ctx); err != nil {
+		return "", err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.GetAyanamsaName(ayan)
+}
+
+func (w ctxWrapper) JulDay(ctx context.Context, y, m, d int, h float64, ct swego.CalType) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return w.w.JulDay(y, m, d, h, ct)
+}
+
+func (w ctxWrapper) RevJul(ctx context.Context, jd float64, ct swego.CalType) (y, m, d int, h float64, err error) {
+	if err := ctx.Err(); err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	return w.w.RevJul(jd, ct)
+}
+
+func (w ctxWrapper) UTCToJD(ctx context.Context, y, m, d, h, i int, s float64, fl *swego.DateConvertFlags) (et, ut float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.UTCToJD(y, m, d, h, i, s, fl)
+}
+
+func (w ctxWrapper) JdETToUTC(ctx context.Context, et float64, fl *swego.DateConvertFlags) (y, m, d, h, i int, s float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, 0, 0, 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.JdETToUTC(et, fl)
+}
+
+func (w ctxWrapper) JdUT1ToUTC(ctx context.Context, ut1 float64, fl *swego.DateConvertFlags) (y, m, d, h, i int, s float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, 0, 0, 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.JdUT1ToUTC(ut1, fl)
+}
+
+func (w ctxWrapper) UTCTimeZone(ctx context.Context, y, m, d, h, i int, s, tz float64) (int, int, int, int, int, float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, 0, 0, 0, 0, 0, err
+	}
+
+	return w.w.UTCTimeZone(y, m, d, h, i, s, tz)
+}
+
+func (w ctxWrapper) HousesEx(ctx context.Context, ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) ([]float64, []float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HousesEx(ut, fl, geolat, geolon, hsys)
+}
+
+func (w ctxWrapper) HousesARMC(ctx context.Context, armc, geolat, eps float64, hsys swego.HSys) ([]float64, []float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HousesARMC(armc, geolat, eps, hsys)
+}
+
+func (w ctxWrapper) HousesEx2(ctx context.Context, ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) (swego.Houses, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.Houses{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HousesEx2(ut, fl, geolat, geolon, hsys)
+}
+
+func (w ctxWrapper) HousesARMCEx2(ctx context.Context, armc, geolat, eps float64, hsys swego.HSys) (swego.Houses, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.Houses{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HousesARMCEx2(armc, geolat, eps, hsys)
+}
+
+func (w ctxWrapper) HousePos(ctx context.Context, armc, geolat, eps float64, hsys swego.HSys, pllng, pllat float64) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HousePos(armc, geolat, eps, hsys, pllng, pllat)
+}
+
+func (w ctxWrapper) GauquelinSector(ctx context.Context, ut float64, body swego.Body, loc swego.GeoLoc, method swego.GauquelinMethod, atpress, attemp float64, fl *swego.CalcFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.GauquelinSector(ut, body, loc, method, atpress, attemp, fl)
+}
+
+func (w ctxWrapper) HouseName(ctx context.Context, hsys swego.HSys) (string, error) {
+	if err := w.lock(ctx); err != nil {
+		return "", err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HouseName(hsys)
+}
+
+func (w ctxWrapper) SolEclipseWhenGlob(ctx context.Context, ut float64, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (swego.SolarEclipse, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.SolarEclipse{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.SolEclipseWhenGlob(ut, fl, typ, backward)
+}
+
+func (w ctxWrapper) SolEclipseWhenLoc(ctx context.Context, ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (swego.SolarEclipse, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.SolarEclipse{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.SolEclipseWhenLoc(ut, fl, loc, backward)
+}
+
+func (w ctxWrapper) SolEclipseWhere(ctx context.Context, ut float64, fl *swego.EclipseFlags) (swego.SolarEclipse, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.SolarEclipse{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.SolEclipseWhere(ut, fl)
+}
+
+func (w ctxWrapper) SolEclipseHow(ctx context.Context, ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc) (swego.SolarEclipse, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.SolarEclipse{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.SolEclipseHow(ut, fl, loc)
+}
+
+func (w ctxWrapper) LunOccultWhenGlob(ctx context.Context, ut float64, body swego.Body, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (swego.Occultation, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.Occultation{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.LunOccultWhenGlob(ut, body, fl, typ, backward)
+}
+
+func (w ctxWrapper) LunOccultWhenLoc(ctx context.Context, ut float64, body swego.Body, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (swego.Occultation, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.Occultation{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.LunOccultWhenLoc(ut, body, fl, loc, backward)
+}
+
+func (w ctxWrapper) LunOccultWhere(ctx context.Context, ut float64, body swego.Body, fl *swego.EclipseFlags) (swego.Occultation, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.Occultation{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.LunOccultWhere(ut, body, fl)
+}
+
+func (w ctxWrapper) LunEclipseWhen(ctx context.Context, ut float64, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (swego.LunarEclipse, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.LunarEclipse{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.LunEclipseWhen(ut, fl, typ, backward)
+}
+
+func (w ctxWrapper) LunEclipseWhenLoc(ctx context.Context, ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (swego.LunarEclipse, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.LunarEclipse{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.LunEclipseWhenLoc(ut, fl, loc, backward)
+}
+
+func (w ctxWrapper) LunEclipseHow(ctx context.Context, ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc) (swego.LunarEclipse, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.LunarEclipse{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.LunEclipseHow(ut, fl, loc)
+}
+
+func (w ctxWrapper) Pheno(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) (swego.Phenomena, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.Phenomena{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.Pheno(et, pl, fl)
+}
+
+func (w ctxWrapper) PhenoUT(ctx context.Context, ut float64, pl swego.Planet, fl *swego.CalcFlags) (swego.Phenomena, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.Phenomena{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.PhenoUT(ut, pl, fl)
+}
+
+func (w ctxWrapper) RiseTrans(ctx context.Context, ut float64, body swego.Body, loc swego.GeoLoc, event swego.RiseTransEvent, fl *swego.RiseTransFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.RiseTrans(ut, body, loc, event, fl)
+}
+
+func (w ctxWrapper) AzAlt(ctx context.Context, ut float64, loc swego.GeoLoc, mode swego.AzAltMode, lng, lat float64, fl *swego.AzAltFlags) (az, trueAlt, appAlt float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.AzAlt(ut, loc, mode, lng, lat, fl)
+}
+
+func (w ctxWrapper) AzAltRev(ctx context.Context, ut float64, loc swego.GeoLoc, mode swego.AzAltMode, az, alt float64, fl *swego.AzAltFlags) (lng, lat float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.AzAltRev(ut, loc, mode, az, alt, fl)
+}
+
+func (w ctxWrapper) Refrac(ctx context.Context, alt, atpress, attemp float64, mode swego.RefracMode) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.Refrac(alt, atpress, attemp, mode)
+}
+
+func (w ctxWrapper) RefracExtended(ctx context.Context, alt, geoalt, atpress, attemp, lapseRate float64, mode swego.RefracMode) (float64, swego.Refraction, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, swego.Refraction{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.RefracExtended(alt, geoalt, atpress, attemp, lapseRate, mode)
+}
+
+func (w ctxWrapper) HeliacalUT(ctx context.Context, ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) (swego.HeliacalTimes, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.HeliacalTimes{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HeliacalUT(ut, loc, body, event, fl)
+}
+
+func (w ctxWrapper) HeliacalPhenoUT(ctx context.Context, ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) (swego.HeliacalPheno, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.HeliacalPheno{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HeliacalPhenoUT(ut, loc, body, event, fl)
+}
+
+func (w ctxWrapper) VisLimitMag(ctx context.Context, ut float64, loc swego.GeoLoc, body swego.Body, fl *swego.HeliacalFlags) (swego.VisLimit, error) {
+	if err := w.lock(ctx); err != nil {
+		return swego.VisLimit{}, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.VisLimitMag(ut, loc, body, fl)
+}
+
+func (w ctxWrapper) HeliacalAngle(ctx context.Context, ut float64, loc swego.GeoLoc, mag, objAz, sunAz, moonAz, moonAlt float64, fl *swego.HeliacalFlags) (angle, arcVis, sunAlt float64, err error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, 0, 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.HeliacalAngle(ut, loc, mag, objAz, sunAz, moonAz, moonAlt, fl)
+}
+
+func (w ctxWrapper) TopoArcusVisionis(ctx context.Context, ut float64, loc swego.GeoLoc, mag, objAz, objAlt, sunAz, moonAz, moonAlt float64, fl *swego.HeliacalFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.TopoArcusVisionis(ut, loc, mag, objAz, objAlt, sunAz, moonAz, moonAlt, fl)
+}
+
+func (w ctxWrapper) DeltaTEx(ctx context.Context, jd float64, eph swego.Ephemeris) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.DeltaTEx(jd, eph)
+}
+
+func (w ctxWrapper) TimeEqu(ctx context.Context, jd float64, fl *swego.TimeEquFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.TimeEqu(jd, fl)
+}
+
+func (w ctxWrapper) LMTToLAT(ctx context.Context, jdLMT, geolon float64, fl *swego.TimeEquFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.LMTToLAT(jdLMT, geolon, fl)
+}
+
+func (w ctxWrapper) LATToLMT(ctx context.Context, jdLAT, geolon float64, fl *swego.TimeEquFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.LATToLMT(jdLAT, geolon, fl)
+}
+
+func (w ctxWrapper) SidTime0(ctx context.Context, ut, eps, nut float64, fl *swego.SidTimeFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.SidTime0(ut, eps, nut, fl)
+}
+
+func (w ctxWrapper) SidTime(ctx context.Context, ut float64, fl *swego.SidTimeFlags) (float64, error) {
+	if err := w.lock(ctx); err != nil {
+		return 0, err
+	}
+
+	defer w.unlock()
+	return unlockedWrapper.SidTime(ut, fl)
+}
+
+func (w ctxWrapper) CoTrans(ctx context.Context, xpo []float64, eps float64) ([]float64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return w.w.CoTrans(xpo, eps)
+}
+
+func (w ctxWrapper) CoTransSp(ctx context.Context, xpo []float64, eps float64) ([]float64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return w.w.CoTransSp(xpo, eps)
+}
@@ -0,0 +1,84 @@
+//go:build (linux && cgo) || (darwin && cgo)
+// +build linux,cgo darwin,cgo
+
+package swecgo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/howesteve/swego"
+)
+
+func TestWithContext(t *testing.T) {
+	ctxSwe := WithContext(swe)
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier | swego.FlagSpeed}
+
+	want, _, err := swe.Calc(2451545, swego.Mars, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got, _, err := ctxSwe.Calc(context.Background(), 2451545, swego.Mars, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("xx = %v, want: %v", got, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, _, err := ctxSwe.Calc(ctx, 2451545, swego.Mars, fl); err != context.Canceled {
+		t.Errorf("Calc() err = %v, want: %v", err, context.Canceled)
+	}
+
+	if _, err := ctxSwe.JulDay(ctx, 2000, 1, 1, 12, swego.Gregorian); err != context.Canceled {
+		t.Errorf("JulDay() err = %v, want: %v", err, context.Canceled)
+	}
+}
+
+func TestWithContext_lockWait(t *testing.T) {
+	ctxSwe := WithContext(swe)
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+
+	Locked(swe, func(locked Library) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// the library is locked by the callback, so the call gives up waiting
+		if _, _, err := ctxSwe.Calc(ctx, 2451545, swego.Mars, fl); err != context.DeadlineExceeded {
+			t.Errorf("err = %v, want: %v", err, context.DeadlineExceeded)
+		}
+
+		// the locked library does not wait
+		if _, _, err := WithContext(locked).Calc(context.Background(), 2451545, swego.Mars, fl); err != nil {
+			t.Errorf("err = %v, want: nil", err)
+		}
+	})
+}
+
+func TestWithContext_CalcBatch(t *testing.T) {
+	ctxSwe := WithContext(swe)
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	requests := []swego.CalcRequest{{JD: 2451545, Planet: swego.Sun, Flags: fl}, {JD: 2451545, Planet: swego.Moon, Flags: fl}}
+
+	for i, r := range ctxSwe.CalcBatch(context.Background(), requests) {
+		if r.Err != nil {
+			t.Errorf("results[%d].Err = %v, want: nil", i, r.Err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i, r := range ctxSwe.CalcBatch(ctx, requests) {
+		if r.Err != context.Canceled {
+			t.Errorf("results[%d].Err = %v, want: %v", i, r.Err, context.Canceled)
+		}
+	}
+}
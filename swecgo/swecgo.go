@@ -10,6 +10,7 @@
 package swecgo
 
 import (
+	"context"
 	"sync"
 
 	"github.com/howesteve/swego"
@@ -26,6 +27,7 @@ type Library interface {
 	//  RevJul
 	//  JdETToUTC
 	//  JdUT1ToUTC
+	//  UTCTimeZone
 	//  HouseName
 	//  SidTime
 	//  SidTime0
@@ -61,7 +63,7 @@ func OpenWithPath(ephePath string) Library {
 func Interface() Library {
 	winit.Do(func() {
 		checkLibrary()
-		wrap = &wrapper{locker: new(sync.Mutex)}
+		wrap = &wrapper{locker: make(chanLock, 1)}
 	})
 
 	return wrap
@@ -74,7 +76,29 @@ var wrap Library
 // It protect stateful library functions with a mutex. When the wrapper is
 // exclusively locked, the mutex is temporary replaced by a no-op lock.
 type wrapper struct {
-	locker sync.Locker
+	locker ctxLocker
+}
+
+// ctxLocker is a lock that can give up waiting when a context is done.
+type ctxLocker interface {
+	sync.Locker
+	LockContext(ctx context.Context) error
+}
+
+// chanLock is a mutex that implements ctxLocker, the channel must have a
+// buffer size of 1.
+type chanLock chan struct{}
+
+func (l chanLock) Lock()   { l <- struct{}{} }
+func (l chanLock) Unlock() { <-l }
+
+func (l chanLock) LockContext(ctx context.Context) error {
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (w *wrapper) acquire() { w.locker.Lock() }
@@ -82,8 +106,9 @@ func (w *wrapper) release() { w.locker.Unlock() }
 
 type unlocked struct{}
 
-func (unlocked) Lock()   {}
-func (unlocked) Unlock() {}
+func (unlocked) Lock()                                 {}
+func (unlocked) Unlock()                               {}
+func (unlocked) LockContext(ctx context.Context) error { return nil }
 
 var unlockedWrapper = &wrapper{locker: unlocked{}}
 
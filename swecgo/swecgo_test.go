@@ -4,6 +4,7 @@
 package swecgo
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -172,6 +173,29 @@ func Test_wrapper_Calc(t *testing.T) {
 	}
 }
 
+func Test_wrapper_CalcPctr_error(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		pl, center swego.Planet
+		fl         int32
+	}{
+		{swego.Earth, swego.Mars, swego.FlagEphMoshier},
+		{swego.Mars, swego.Mars, 0},
+	}
+
+	for _, c := range cases {
+		_, cfl, err := swe.CalcPctr(2451545, c.pl, c.center, &swego.CalcFlags{Flags: c.fl})
+		if _, ok := err.(swego.Error); !ok {
+			t.Errorf("CalcPctr(%s, %s) err = %v, want: %T value", c.pl, c.center, err, swego.Error(""))
+		}
+
+		if cfl != -1 {
+			t.Errorf("CalcPctr(%s, %s) cfl = %d, want: -1", c.pl, c.center, cfl)
+		}
+	}
+}
+
 func Test_wrapper_Calc_error(t *testing.T) {
 	t.Parallel()
 
@@ -205,6 +229,135 @@ func Test_wrapper_Calc_error(t *testing.T) {
 	}
 }
 
+func Test_wrapper_CalcBatch(t *testing.T) {
+	t.Parallel()
+
+	fl1 := &swego.CalcFlags{Flags: swego.FlagEphMoshier | swego.FlagSpeed}
+	fl2 := &swego.CalcFlags{
+		Flags:   swego.FlagEphMoshier | swego.FlagEquatorial | swego.FlagTopo,
+		TopoLoc: &swego.GeoLoc{Long: 5.1, Lat: 52.1},
+	}
+	requests := []swego.CalcRequest{
+		{JD: 2451545, Planet: swego.Sun, Flags: fl1},
+		{JD: 2451545, UT: true, Planet: swego.Moon, Flags: fl1},
+		{JD: 2451545, Planet: 99, Flags: fl1},
+		{JD: 2451545, Planet: swego.Mars, Flags: fl2},
+		{JD: 2451545, UT: true, Planet: swego.Mars, Flags: fl1},
+	}
+
+	results := swe.CalcBatch(requests)
+	if len(results) != len(requests) {
+		t.Fatalf("len(results) = %d, want: %d", len(results), len(requests))
+	}
+
+	for i, r := range requests {
+		fn := swe.Calc
+		if r.UT {
+			fn = swe.CalcUT
+		}
+
+		xx, cfl, err := fn(r.JD, r.Planet, r.Flags)
+		want := swego.CalcResult{XX: xx, Flags: cfl, Err: err}
+		if got := results[i]; !reflect.DeepEqual(got, want) {
+			t.Errorf("results[%d] = %v, want: %v", i, got, want)
+		}
+	}
+
+	if results[2].Err == nil {
+		t.Error("results[2].Err = nil, want error")
+	}
+}
+
+func Test_wrapper_FixStar(t *testing.T) {
+	t.Parallel()
+
+	type result struct {
+		name string
+		xx   []float64
+	}
+
+	cases := []struct {
+		fn   func(string, float64, *swego.CalcFlags) (string, []float64, int, error)
+		star string
+		want result
+	}{
+		{swe.FixStar, "Aldebaran", result{"Aldebaran,alTau", []float64{69.790327, -5.467598}}},
+		{swe.FixStarUT, ",alTau", result{"Aldebaran,alTau", []float64{69.790327, -5.467598}}},
+		{swe.FixStar2, "Spica", result{"Spica,alVir", []float64{203.836077, -2.054286}}},
+		{swe.FixStar2UT, ",alVir", result{"Spica,alVir", []float64{203.836077, -2.054286}}},
+	}
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+
+	for _, c := range cases {
+		t.Run("", func(t *testing.T) {
+			name, xx, cfl, err := c.fn(c.star, 2451544.5, fl)
+			if err != nil {
+				t.Fatalf("err = %v, want: nil", err)
+			}
+
+			if name != c.want.name {
+				t.Errorf("name = %q, want: %q", name, c.want.name)
+			}
+
+			if !inDeltaSlice(xx[:2], c.want.xx, 1e-6) {
+				t.Errorf("xx[:2] = %v ± 1e-6, want: %v", xx[:2], c.want.xx)
+			}
+
+			if cfl != swego.FlagEphMoshier {
+				t.Errorf("cfl = %d, want: %d", cfl, swego.FlagEphMoshier)
+			}
+		})
+	}
+}
+
+func Test_wrapper_FixStar_error(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	_, _, cfl, err := swe.FixStar("Nonexistent", 2451544.5, fl)
+
+	const want = swego.Error("star Nonexistent not found")
+	if err != want {
+		t.Errorf("err = %v, want: %q", err, want)
+	}
+
+	if cfl != -1 {
+		t.Errorf("cfl = %d, want: -1", cfl)
+	}
+}
+
+func Test_wrapper_FixStarMag(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		fn   func(string) (string, float64, error)
+		star string
+		name string
+		mag  float64
+	}{
+		{swe.FixStarMag, "Aldebaran", "Aldebaran,alTau", 0.86},
+		{swe.FixStar2Mag, ",alVir", "Spica,alVir", 0.97},
+	}
+
+	for _, c := range cases {
+		t.Run("", func(t *testing.T) {
+			name, mag, err := c.fn(c.star)
+			if err != nil {
+				t.Fatalf("err = %v, want: nil", err)
+			}
+
+			if name != c.name {
+				t.Errorf("name = %q, want: %q", name, c.name)
+			}
+
+			if !inDelta(mag, c.mag, 1e-6) {
+				t.Errorf("mag = %f, want: %f", mag, c.mag)
+			}
+		})
+	}
+}
+
 func Test_wrapper_NodAps(t *testing.T) {
 	t.Parallel()
 
@@ -507,6 +660,33 @@ func Test_wrapper_JDUT1ToUTC(t *testing.T) {
 	}
 }
 
+func Test_wrapper_UTCTimeZone(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in   []float64
+		tz   float64
+		want []float64
+	}{
+		{[]float64{2000, 1, 1, 0, 30, 15}, 1, []float64{1999, 12, 31, 23, 30, 15}},
+		{[]float64{1999, 12, 31, 23, 30, 15}, -1, []float64{2000, 1, 1, 0, 30, 15}},
+		{[]float64{2017, 1, 1, 5, 29, 60.5}, 5.5, []float64{2016, 12, 31, 23, 59, 60.5}},
+	}
+
+	for _, c := range cases {
+		in := c.in
+		y, m, d, h, i, s, err := swe.UTCTimeZone(int(in[0]), int(in[1]), int(in[2]), int(in[3]), int(in[4]), in[5], c.tz)
+		if err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+
+		got := []float64{float64(y), float64(m), float64(d), float64(h), float64(i), s}
+		if !inDeltaSlice(got, c.want, 1e-6) {
+			t.Errorf("UTCTimeZone(%v, %f) = %v, want: %v", in, c.tz, got, c.want)
+		}
+	}
+}
+
 func Test_wrapper_HousesEx(t *testing.T) {
 	t.Parallel()
 
@@ -628,6 +808,97 @@ func Test_wrapper_HousesEx(t *testing.T) {
 	}
 }
 
+func Test_wrapper_HousesEx2(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.HousesExFlags{Flags: swego.FlagEphMoshier}
+	h, err := swe.HousesEx2(2451545, fl, 47.37, 8.55, swego.Placidus)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{h.Cusps[1], h.CuspSpeeds[1], h.Asc, h.MC, h.ARMC, h.MCSpeed}
+	want := []float64{36.798667, 621.491137, 36.798667, 287.538878, 289.007072, 336.854667}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("HousesEx2(Placidus) = %v, want: %v", got, want)
+	}
+
+	if h.Warning != "" {
+		t.Errorf("Warning = %q, want: \"\"", h.Warning)
+	}
+
+	// Placidus houses are not defined within the polar circles.
+	h, err = swe.HousesEx2(2451545, fl, 70, 8.55, swego.Placidus)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !inDelta(h.Cusps[1], 304.679517, 1e-6) || h.Warning == "" {
+		t.Errorf("HousesEx2(Placidus, polar) = %f, %q, want: Porphyry cusps with warning", h.Cusps[1], h.Warning)
+	}
+
+	h, err = swe.HousesEx2(2451545, fl, 47.37, 8.55, swego.Gauquelin)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if len(h.Cusps) != 37 || len(h.CuspSpeeds) != 37 {
+		t.Errorf("len(Cusps), len(CuspSpeeds) = %d, %d, want: 37", len(h.Cusps), len(h.CuspSpeeds))
+	}
+}
+
+func Test_wrapper_HousesARMCEx2(t *testing.T) {
+	t.Parallel()
+
+	h, err := swe.HousesARMCEx2(120, 47.37, 23.44, swego.Koch)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{h.Cusps[1], h.Cusps[2], h.CuspSpeeds[1], h.Asc, h.MC, h.ARMC}
+	want := []float64{202.175759, 230.096771, 265.726357, 202.175759, 117.910423, 120}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("HousesARMCEx2(Koch) = %v, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_GauquelinSector(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+	mars := swego.Body{Planet: swego.Mars}
+	cases := []struct {
+		body   swego.Body
+		method swego.GauquelinMethod
+		want   float64
+	}{
+		{mars, swego.GauqLat, 5.035971},
+		{mars, swego.GauqNoLat, 5.157106},
+		{mars, swego.GauqDiscCenter, 5.030066},
+		{mars, swego.GauqDiscCenterRefrac, 5.087183},
+		{mars, swego.GauqUpperLimb, 5.030139},
+		{mars, swego.GauqUpperLimbRefrac, 5.087253},
+		{swego.Body{Star: "Aldebaran"}, swego.GauqLat, 33.058050},
+	}
+
+	for _, c := range cases {
+		sect, err := swe.GauquelinSector(2451545, c.body, loc, c.method, 1013.25, 15, fl)
+		if err != nil {
+			t.Fatalf("GauquelinSector(%v, %d) err = %v, want: nil", c.body, c.method, err)
+		}
+
+		if !inDelta(sect, c.want, 1e-6) {
+			t.Errorf("GauquelinSector(%v, %d) = %f, want: %f", c.body, c.method, sect, c.want)
+		}
+	}
+
+	_, err := swe.GauquelinSector(2451545, mars, loc, 6, 0, 0, fl)
+	if _, ok := err.(swego.Error); !ok {
+		t.Errorf("err = %v, want: %T value", err, swego.Error(""))
+	}
+}
+
 func Test_wrapper_HousesArmc(t *testing.T) {
 	t.Parallel()
 
@@ -780,6 +1051,735 @@ func Test_wrapper_HouseName(t *testing.T) {
 	}
 }
 
+func Test_wrapper_SolEclipseWhenGlob(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+
+	cases := []struct {
+		typ      swego.EclipseType
+		backward bool
+		want     []float64 // max, begin, end, total begin, total end
+		total    bool
+	}{
+		{0, false, []float64{2451580.034250, 2451579.955703, 2451580.113074, 0, 0}, false},
+		{swego.EclTotal, true, []float64{2451401.960487, 2451401.851686, 2451402.069510, 2451401.895816, 2451402.025267}, true},
+	}
+
+	for _, c := range cases {
+		e, err := swe.SolEclipseWhenGlob(2451544.5, fl, c.typ, c.backward)
+		if err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+
+		got := []float64{e.Max, e.Begin, e.End, e.TotalBegin, e.TotalEnd}
+		if !inDeltaSlice(got, c.want, 1e-6) {
+			t.Errorf("times = %v ± 1e-6, want: %v", got, c.want)
+		}
+
+		if e.Total != c.total || e.Partial == c.total {
+			t.Errorf("Total = %t, Partial = %t, want: %t, %t", e.Total, e.Partial, c.total, !c.total)
+		}
+	}
+}
+
+func Test_wrapper_SolEclipseWhenGlob_error(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	_, err := swe.SolEclipseWhenGlob(2451544.5, fl, swego.EclCentral|swego.EclPartial, false)
+
+	const want = swego.Error("central partial eclipses do not exist")
+	if err != want {
+		t.Errorf("err = %v, want: %q", err, want)
+	}
+}
+
+func Test_wrapper_SolEclipseWhenLoc(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+	e, err := swe.SolEclipseWhenLoc(2451544.5, fl, loc, false)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !e.Partial || !e.Visible || e.FirstContactVisible || !e.FourthContactVisible {
+		t.Errorf("Type = %d, want: partial eclipse visible until fourth contact", e.Type)
+	}
+
+	got := []float64{e.Max, e.FirstContact, e.FourthContact, e.Sunrise}
+	want := []float64{2452790.651125, 2452790.604486, 2452790.681025, 2452790.651125}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("times = %v ± 1e-6, want: %v", got, want)
+	}
+
+	if !inDelta(e.Magnitude, 0.705467, 1e-6) {
+		t.Errorf("Magnitude = %f, want: 0.705467", e.Magnitude)
+	}
+
+	if e.Saros != 147 || e.SarosMember != 22 {
+		t.Errorf("Saros = %d/%d, want: 147/22", e.Saros, e.SarosMember)
+	}
+}
+
+func Test_wrapper_SolEclipseWhere(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	e, err := swe.SolEclipseWhere(2452790.651125, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !e.Partial || !e.NonCentral {
+		t.Errorf("Type = %d, want: non-central partial eclipse", e.Type)
+	}
+
+	got := []float64{e.CentralLine.Long, e.CentralLine.Lat}
+	want := []float64{0.900244, 54.301697}
+	if !inDeltaSlice(got, want, 1e-3) {
+		t.Errorf("CentralLine = %v ± 1e-3, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_SolEclipseHow(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+
+	e, err := swe.SolEclipseHow(2452790.651125, fl, loc)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !e.Partial || !e.Visible || !inDelta(e.Obscuration, 0.6166, 1e-4) {
+		t.Errorf("Type = %d, Obscuration = %f, want: visible partial eclipse, 0.6166", e.Type, e.Obscuration)
+	}
+
+	e, err = swe.SolEclipseHow(2451544.5, fl, loc)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if e.Type != 0 {
+		t.Errorf("Type = %d, want: 0", e.Type)
+	}
+}
+
+func Test_wrapper_LunOccultWhenGlob(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	o, err := swe.LunOccultWhenGlob(2451544.5, swego.Body{Planet: swego.Venus}, fl, 0, false)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !o.Total || !o.Central {
+		t.Errorf("Type = %d, want: central total occultation", o.Type)
+	}
+
+	got := []float64{o.Max, o.Begin, o.End}
+	want := []float64{2451607.544842, 2451607.456526, 2451607.633375}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("times = %v ± 1e-6, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_LunOccultWhenGlob_oneTry(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	o, err := swe.LunOccultWhenGlob(2451544.5, swego.Body{Star: "Spica"}, fl, swego.EclOneTry, true)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if o.Type != 0 {
+		t.Errorf("Type = %d, want: 0", o.Type)
+	}
+
+	if !inDelta(o.Max, 2451542.402466, 1e-6) {
+		t.Errorf("Max = %f, want: 2451542.402466", o.Max)
+	}
+}
+
+func Test_wrapper_LunOccultWhenGlob_error(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	_, err := swe.LunOccultWhenGlob(2451544.5, swego.Body{Star: "Polaris"}, fl, 0, false)
+
+	const want = swego.Error("occultation never occurs: star Polaris,alUMi has ecl. lat. 66.1")
+	if err != want {
+		t.Errorf("err = %v, want: %q", err, want)
+	}
+}
+
+func Test_wrapper_LunOccultWhenLoc(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+	o, err := swe.LunOccultWhenLoc(2451544.5, swego.Body{Planet: swego.Venus}, fl, loc, false)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !o.Total || !o.Visible || !o.BeginDaylight || !o.EndDaylight {
+		t.Errorf("Type = %d, want: visible total occultation during the day", o.Type)
+	}
+
+	got := []float64{o.Max, o.FirstContact, o.FourthContact}
+	want := []float64{2453146.993319, 2453146.964396, 2453147.023255}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("times = %v ± 1e-6, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_LunOccultWhere(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	o, err := swe.LunOccultWhere(2453146.993319, swego.Body{Planet: swego.Venus}, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !o.Total || !o.Central {
+		t.Errorf("Type = %d, want: central total occultation", o.Type)
+	}
+
+	got := []float64{o.CentralLine.Long, o.CentralLine.Lat}
+	want := []float64{9.321349, 46.229250}
+	if !inDeltaSlice(got, want, 1e-3) {
+		t.Errorf("CentralLine = %v ± 1e-3, want: %v", got, want)
+	}
+}
+
+func TestOccultationIter(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	it := swego.NewOccultationIter(swe, swego.Body{Star: "Aldebaran"}, fl, 2457023.5, 2457023.5+365)
+
+	var max []float64
+	for it.Next() {
+		max = append(max, it.Occultation().Max)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if len(max) != 13 {
+		t.Fatalf("len(max) = %d, want: 13", len(max))
+	}
+
+	if !inDelta(max[0], 2457052.221029, 1e-6) || !inDelta(max[12], 2457380.308556, 1e-6) {
+		t.Errorf("max[0], max[12] = %f, %f, want: 2457052.221029, 2457380.308556", max[0], max[12])
+	}
+}
+
+func Test_wrapper_LunEclipseWhen(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+
+	cases := []struct {
+		typ       swego.EclipseType
+		backward  bool
+		want      []float64 // max, penumbral begin, penumbral end
+		penumbral bool
+	}{
+		{0, false, []float64{2451564.696863, 2451564.586368, 2451564.807412}, false},
+		{swego.EclPenumbral, true, []float64{2451210.178783, 2451210.087915, 2451210.269716}, true},
+	}
+
+	for _, c := range cases {
+		e, err := swe.LunEclipseWhen(2451544.5, fl, c.typ, c.backward)
+		if err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+
+		got := []float64{e.Max, e.PenumbralBegin, e.PenumbralEnd}
+		if !inDeltaSlice(got, c.want, 1e-6) {
+			t.Errorf("times = %v ± 1e-6, want: %v", got, c.want)
+		}
+
+		if e.Penumbral != c.penumbral || e.Total == c.penumbral {
+			t.Errorf("Penumbral = %t, Total = %t, want: %t, %t", e.Penumbral, e.Total, c.penumbral, !c.penumbral)
+		}
+	}
+}
+
+func Test_wrapper_LunEclipseWhen_error(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	_, err := swe.LunEclipseWhen(2451544.5, fl, swego.EclAnnular, false)
+
+	const want = swego.Error("annular lunar eclipses don't exist")
+	if err != want {
+		t.Errorf("err = %v, want: %q", err, want)
+	}
+}
+
+func Test_wrapper_LunEclipseWhenLoc(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+	e, err := swe.LunEclipseWhenLoc(2451544.5, fl, loc, false)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !e.Total || !e.Visible || !e.TotalEndVisible || e.PenumbralEndVisible {
+		t.Errorf("Type = %d, want: total eclipse that sets before penumbral end", e.Type)
+	}
+
+	got := []float64{e.Max, e.TotalBegin, e.TotalEnd, e.PenumbralEnd, e.Moonset}
+	want := []float64{2451564.696863, 2451564.670105, 2451564.723628, 0, 2451564.797895}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("times = %v ± 1e-6, want: %v", got, want)
+	}
+
+	if !inDelta(e.UmbralMagnitude, 1.325262, 1e-6) {
+		t.Errorf("UmbralMagnitude = %f, want: 1.325262", e.UmbralMagnitude)
+	}
+
+	if e.Saros != 124 || e.SarosMember != 48 {
+		t.Errorf("Saros = %d/%d, want: 124/48", e.Saros, e.SarosMember)
+	}
+}
+
+func Test_wrapper_LunEclipseHow(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+	e, err := swe.LunEclipseHow(2451564.696863, fl, loc)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !e.Total {
+		t.Errorf("Type = %d, want: total eclipse", e.Type)
+	}
+
+	got := []float64{e.UmbralMagnitude, e.PenumbralMagnitude, e.MoonAzimuth, e.MoonAlt}
+	want := []float64{1.3253, 2.3062, 94.348, 22.358}
+	if !inDeltaSlice(got, want, 1e-3) {
+		t.Errorf("attributes = %v ± 1e-3, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_PhenoUT(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	cases := []struct {
+		pl   swego.Planet
+		want []float64
+	}{
+		{swego.Moon, []float64{122.671812, 0.230087, 57.196151, 0.494772, -8.524818, 0.908080}},
+		{swego.Venus, []float64{58.923892, 0.758088, 38.849418, 0.004075, -4.066127, 0}},
+		{swego.Jupiter, []float64{11.030498, 0.990763, 104.880421, 0.011588, -2.520604, 0}},
+	}
+
+	for _, c := range cases {
+		p, err := swe.PhenoUT(2451545, c.pl, fl)
+		if err != nil {
+			t.Fatalf("PhenoUT(%s) err = %v, want: nil", c.pl, err)
+		}
+
+		got := []float64{p.PhaseAngle, p.Phase, p.Elongation, p.DiscDiameter, p.Magnitude, p.HorizontalParallax}
+		if !inDeltaSlice(got, c.want, 1e-6) {
+			t.Errorf("PhenoUT(%s) = %v, want: %v", c.pl, got, c.want)
+		}
+	}
+}
+
+func Test_wrapper_Pheno_error(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	p, err := swe.Pheno(2451545, swego.Planet(9999), fl)
+	if _, ok := err.(swego.Error); !ok {
+		t.Errorf("err = %v, want: %T value", err, swego.Error(""))
+	}
+
+	if p != (swego.Phenomena{}) {
+		t.Errorf("p = %+v, want zero value", p)
+	}
+}
+
+func Test_wrapper_GetOrbitalElements(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	o, err := swe.GetOrbitalElements(2451545, swego.Mars, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{o.SemiMajorAxis, o.Eccentricity, o.Inclination, o.AscNode, o.SiderealPeriod, o.PerihelionPassage}
+	want := []float64{1.523676, 0.093313, 1.849888, 49.561885, 1.880856, 2451508.062008}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("GetOrbitalElements(Mars) = %v, want: %v", got, want)
+	}
+
+	_, err = swe.GetOrbitalElements(2451545, swego.Sun, fl)
+	if _, ok := err.(swego.Error); !ok {
+		t.Errorf("err = %v, want: %T value", err, swego.Error(""))
+	}
+}
+
+func Test_wrapper_OrbitMaxMinTrueDistance(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	dmax, dmin, dtrue, err := swe.OrbitMaxMinTrueDistance(2451545, swego.Mars, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{dmax, dmin, dtrue}
+	want := []float64{2.676001, 0.372825, 1.849612}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("OrbitMaxMinTrueDistance(Mars) = %v, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_SolCrossUT(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	jd, err := swe.SolCrossUT(0, 2451545, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !inDelta(jd, 2451623.816155, 1e-6) {
+		t.Errorf("SolCrossUT(0) = %f, want: 2451623.816155", jd)
+	}
+
+	_, err = swe.SolCrossUT(0, 0, fl)
+	if _, ok := err.(swego.Error); !ok {
+		t.Errorf("err = %v, want: %T value", err, swego.Error(""))
+	}
+}
+
+func Test_wrapper_MoonCrossNodeUT(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	jd, lng, lat, err := swe.MoonCrossNodeUT(2451545, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{jd, lng, lat}
+	want := []float64{2451551.756874, 303.651565, 0}
+	if !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("MoonCrossNodeUT() = %v, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_HelioCrossUT(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	jd, err := swe.HelioCrossUT(swego.Mars, 0, 2451545, fl, true)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !inDelta(jd, 2450858.936611, 1e-6) {
+		t.Errorf("HelioCrossUT(Mars, 0, backward) = %f, want: 2450858.936611", jd)
+	}
+
+	_, err = swe.HelioCrossUT(swego.Moon, 0, 2451545, fl, false)
+	if _, ok := err.(swego.Error); !ok {
+		t.Errorf("err = %v, want: %T value", err, swego.Error(""))
+	}
+}
+
+func Test_wrapper_CoTransSp(t *testing.T) {
+	t.Parallel()
+
+	xpo := []float64{123.4, -5.6, 1.2, 0.98, -0.01, 0.001}
+	xpn, err := swe.CoTransSp(xpo, -23.44)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if want := swego.CoTransSp(xpo, -23.44); !inDeltaSlice(xpn, want, 1e-9) {
+		t.Errorf("CoTransSp() = %v, want: %v", xpn, want)
+	}
+
+	xpn, err = swe.CoTrans(xpo, -23.44)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if want := swego.CoTrans(xpo, -23.44); !inDeltaSlice(xpn, want, 1e-9) {
+		t.Errorf("CoTrans() = %v, want: %v", xpn, want)
+	}
+}
+
+func Test_wrapper_RiseTrans(t *testing.T) {
+	t.Parallel()
+
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+	sun := swego.Body{Planet: swego.Sun}
+	fl := &swego.RiseTransFlags{Flags: swego.FlagEphMoshier, AtPress: 1013.25, AtTemp: 15}
+	flHor := &swego.RiseTransFlags{Flags: swego.FlagEphMoshier, AtPress: 1013.25, AtTemp: 15}
+	flHor.SetHorHgt(5)
+
+	cases := []struct {
+		body  swego.Body
+		event swego.RiseTransEvent
+		fl    *swego.RiseTransFlags
+		want  float64
+	}{
+		{sun, swego.CalcRise, fl, 2451544.800800},
+		{sun, swego.CalcSet, fl, 2451545.156360},
+		{sun, swego.CalcMTransit, fl, 2451544.978525},
+		{sun, swego.CalcITransit, fl, 2451545.478689},
+		{sun, swego.CalcRise | swego.BitCivilTwilight, fl, 2451544.776048},
+		{sun, swego.CalcRise | swego.BitAstroTwilight, fl, 2451544.723111},
+		{sun, swego.CalcRise | swego.BitHinduRising, fl, 2451544.804937},
+		{sun, swego.CalcRise, flHor, 2451544.828908},
+		{swego.Body{Star: "Sirius"}, swego.CalcRise, fl, 2451545.278172},
+	}
+
+	for _, c := range cases {
+		got, err := swe.RiseTrans(2451544.5, c.body, loc, c.event, c.fl)
+		if err != nil {
+			t.Fatalf("RiseTrans(%v, %d) err = %v, want: nil", c.body, c.event, err)
+		}
+
+		if !inDelta(got, c.want, 1e-6) {
+			t.Errorf("RiseTrans(%v, %d) = %f, want: %f", c.body, c.event, got, c.want)
+		}
+	}
+}
+
+func Test_wrapper_RiseTrans_circumpolar(t *testing.T) {
+	t.Parallel()
+
+	fl := &swego.RiseTransFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 15, Lat: 80}
+	_, err := swe.RiseTrans(2451544.5, swego.Body{Planet: swego.Sun}, loc, swego.CalcRise, fl)
+
+	var cerr *swego.CircumpolarError
+	if !errors.As(err, &cerr) {
+		t.Fatalf("err = %v, want: %T value", err, cerr)
+	}
+
+	if cerr.Body.Planet != swego.Sun || cerr.Event != swego.CalcRise {
+		t.Errorf("err = %+v, want: Sun, CalcRise", cerr)
+	}
+
+	_, err = swe.RiseTrans(2451544.5, swego.Body{Star: "Nonexistent"}, loc, swego.CalcRise, fl)
+	if _, ok := err.(swego.Error); !ok {
+		t.Errorf("err = %v, want: %T value", err, swego.Error(""))
+	}
+}
+
+func Test_wrapper_RiseTrans_lapseRate(t *testing.T) {
+	t.Parallel()
+
+	// the lapse rate changes the dip of the horizon at the altitude of loc
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37, Alt: 3000}
+	sun := swego.Body{Planet: swego.Sun}
+	fl := &swego.RiseTransFlags{Flags: swego.FlagEphMoshier, AtPress: 1013.25, AtTemp: 15}
+	fl.SetHorHgt(-1)
+
+	want, err := swe.RiseTrans(2451544.5, sun, loc, swego.CalcRise, fl)
+	if err != nil {
+		t.Fatalf("RiseTrans() err = %v, want: nil", err)
+	}
+
+	azAltFl := &swego.AzAltFlags{AtPress: 1013.25, AtTemp: 15}
+	azAltFl.SetLapseRate(0.1)
+	swe.AzAlt(2451545, loc, swego.Ecl2Hor, 280.37, 0, azAltFl)
+
+	if got, err := swe.RiseTrans(2451544.5, sun, loc, swego.CalcRise, fl); got != want || err != nil {
+		t.Errorf("RiseTrans() after AzAlt = %f, %v, want: %f, nil", got, err, want)
+	}
+}
+
+func Test_wrapper_AzAlt(t *testing.T) {
+	t.Parallel()
+
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.3667, Alt: 400}
+	fl := &swego.AzAltFlags{AtPress: 1013.25, AtTemp: 15}
+	az, trueAlt, appAlt, err := swe.AzAlt(2451545, loc, swego.Ecl2Hor, 280.37, 0, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{az, trueAlt, appAlt}
+	want := []float64{7.531807, 19.256825, 19.302384}
+	if !inDeltaSlice(got, want, 1e-4) {
+		t.Errorf("AzAlt(Ecl2Hor) = %v, want: %v", got, want)
+	}
+
+	lng, lat, err := swe.AzAltRev(2451545, loc, swego.Hor2Ecl, az, trueAlt, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if got, want := []float64{lng, lat}, []float64{280.37, 0}; !inDeltaSlice(got, want, 1e-6) {
+		t.Errorf("AzAltRev(Hor2Ecl) = %v, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_Refrac(t *testing.T) {
+	t.Parallel()
+
+	app, err := swe.Refrac(0, 1013.25, 10, swego.TrueToApp)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !inDelta(app, 0.484586, 1e-6) {
+		t.Errorf("Refrac(0, TrueToApp) = %f, want: 0.484586", app)
+	}
+
+	tru, err := swe.Refrac(app, 1013.25, 10, swego.AppToTrue)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !inDelta(tru, 0, 1e-2) {
+		t.Errorf("Refrac(%f, AppToTrue) = %f, want: 0", app, tru)
+	}
+}
+
+func Test_wrapper_RefracExtended(t *testing.T) {
+	t.Parallel()
+
+	alt, r, err := swe.RefracExtended(0, 400, 1013.25, 10, swego.DefaultLapseRate, swego.TrueToApp)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !inDelta(alt, 0.483132, 1e-6) {
+		t.Errorf("alt = %f, want: 0.483132", alt)
+	}
+
+	if !inDelta(r.AppAlt, alt, 1e-9) {
+		t.Errorf("AppAlt = %f, want: %f", r.AppAlt, alt)
+	}
+
+	if !inDelta(r.Dip, -0.552233, 1e-6) {
+		t.Errorf("Dip = %f, want: -0.552233", r.Dip)
+	}
+}
+
+func heliacalFlags() *swego.HeliacalFlags {
+	return &swego.HeliacalFlags{
+		Flags:      swego.FlagEphMoshier,
+		Atmosphere: swego.Atmosphere{Pressure: 1013.25, Temperature: 15, Humidity: 40, Extinction: 40},
+		Observer:   swego.Observer{Age: 36, SnellenRatio: 1},
+	}
+}
+
+func Test_wrapper_HeliacalUT(t *testing.T) {
+	t.Parallel()
+
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.3667, Alt: 400}
+	h, err := swe.HeliacalUT(2451545, loc, swego.Body{Planet: swego.Venus}, swego.MorningFirst, heliacalFlags())
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{h.Start, h.Optimum, h.End}
+	want := []float64{2452007.677868, 2452007.682184, 2452007.686825}
+	if !inDeltaSlice(got, want, 1e-5) {
+		t.Errorf("HeliacalUT(Venus) = %v, want: %v", got, want)
+	}
+
+	_, err = swe.HeliacalUT(2451545, loc, swego.Body{Planet: swego.Sun}, swego.HeliacalRising, heliacalFlags())
+	if _, ok := err.(swego.Error); !ok {
+		t.Errorf("err = %v, want: %T value", err, swego.Error(""))
+	}
+}
+
+func Test_wrapper_HeliacalPhenoUT(t *testing.T) {
+	t.Parallel()
+
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.3667, Alt: 400}
+	p, err := swe.HeliacalPhenoUT(2451545.2, loc, swego.Body{Planet: swego.Venus}, swego.MorningFirst, heliacalFlags())
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{p.ObjAlt, p.SunAlt, p.ObjMagnitude, p.Elongation, p.Illumination}
+	want := []float64{-34.390750, -10.147647, -4.065193, 38.810532, 75.870328}
+	if !inDeltaSlice(got, want, 1e-5) {
+		t.Errorf("HeliacalPhenoUT(Venus) = %v, want: %v", got, want)
+	}
+}
+
+func Test_wrapper_VisLimitMag(t *testing.T) {
+	t.Parallel()
+
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.3667, Alt: 400}
+	venus := swego.Body{Planet: swego.Venus}
+	v, err := swe.VisLimitMag(2451544.73, loc, venus, heliacalFlags())
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if v.Mode != swego.Scotopic {
+		t.Errorf("Mode = %d, want: %d", v.Mode, swego.Scotopic)
+	}
+
+	got := []float64{v.LimitingMag, v.ObjAlt, v.SunAlt, v.ObjMagnitude}
+	want := []float64{3.210933, 11.410121, -16.389199, -4.067268}
+	if !inDeltaSlice(got, want, 1e-5) {
+		t.Errorf("VisLimitMag(Venus) = %v, want: %v", got, want)
+	}
+
+	_, err = swe.VisLimitMag(2451545.2, loc, venus, heliacalFlags())
+	if err != swego.ErrBelowHorizon {
+		t.Errorf("err = %v, want: %v", err, swego.ErrBelowHorizon)
+	}
+}
+
+func Test_wrapper_HeliacalAngle(t *testing.T) {
+	t.Parallel()
+
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.3667, Alt: 400}
+	angle, arcVis, sunAlt, err := swe.HeliacalAngle(2451545.2, loc, -4, 120, 110, 0, -30, heliacalFlags())
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{angle, arcVis, sunAlt}
+	want := []float64{5.09375, 10.898438, -5.804688}
+	if !inDeltaSlice(got, want, 1e-5) {
+		t.Errorf("HeliacalAngle = %v, want: %v", got, want)
+	}
+
+	arcVis, err = swe.TopoArcusVisionis(2451545.2, loc, -4, 120, 5, 110, 0, -30, heliacalFlags())
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !inDelta(arcVis, 10.900154, 1e-5) {
+		t.Errorf("TopoArcusVisionis = %f, want: 10.900154", arcVis)
+	}
+}
+
 func Test_wrapper_DeltaTEx(t *testing.T) {
 	t.Parallel()
 
@@ -73,6 +73,10 @@ func checkLibrary() {
 	if resetDeltaT != C.swecgo_deltat_automatic() {
 		panic("swecgo: SE_DELTAT_AUTOMATIC mismatch")
 	}
+
+	if swego.DefaultLapseRate != C.SE_LAPSE_RATE {
+		panic("swecgo: SE_LAPSE_RATE mismatch")
+	}
 }
 
 // withError calls fn with a pre allocated error variable that can passed to a
@@ -168,6 +172,90 @@ func calcUT(ut float64, pl swego.Planet, fl int32) ([]float64, int, error) {
 	})
 }
 
+func calcPctr(et float64, pl, center swego.Planet, fl int32) ([]float64, int, error) {
+	return _calc(et, fl, func(jd C.double, fl C.int32, xx *C.double, err *C.char) C.int32 {
+		return C.swe_calc_pctr(jd, C.int32(pl), C.int32(center), fl, xx, err)
+	})
+}
+
+// starBuffer returns star as C string in a buffer of SE_MAX_STNAME bytes. The
+// library writes the resolved star name back into this buffer.
+func starBuffer(star string) (buf [C.SE_MAX_STNAME]C.char) {
+	for i := 0; i < len(star) && i < len(buf)-1; i++ {
+		buf[i] = C.char(star[i])
+	}
+
+	return
+}
+
+type _fixStarFunc func(star *C.char, jd C.double, fl C.int32, xx *C.double, err *C.char) C.int32
+
+func _fixStar(star string, jd float64, fl int32, fn _fixStarFunc) (name string, _ []float64, cfl int, err error) {
+	_star := starBuffer(star)
+	_jd := C.double(jd)
+	_fl := C.int32(fl)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var xx [6]float64
+	_xx := (*C.double)(unsafe.Pointer(&xx[0]))
+
+	err = withError(func(err *C.char) bool {
+		cfl = int(fn(&_star[0], _jd, _fl, _xx, err))
+		return cfl == C.ERR
+	})
+
+	return C.GoString(&_star[0]), xx[:], cfl, err
+}
+
+func fixStar(star string, et float64, fl int32) (string, []float64, int, error) {
+	return _fixStar(star, et, fl, func(star *C.char, jd C.double, fl C.int32, xx *C.double, err *C.char) C.int32 {
+		return C.swe_fixstar(star, jd, fl, xx, err)
+	})
+}
+
+func fixStarUT(star string, ut float64, fl int32) (string, []float64, int, error) {
+	return _fixStar(star, ut, fl, func(star *C.char, jd C.double, fl C.int32, xx *C.double, err *C.char) C.int32 {
+		return C.swe_fixstar_ut(star, jd, fl, xx, err)
+	})
+}
+
+func fixStar2(star string, et float64, fl int32) (string, []float64, int, error) {
+	return _fixStar(star, et, fl, func(star *C.char, jd C.double, fl C.int32, xx *C.double, err *C.char) C.int32 {
+		return C.swe_fixstar2(star, jd, fl, xx, err)
+	})
+}
+
+func fixStar2UT(star string, ut float64, fl int32) (string, []float64, int, error) {
+	return _fixStar(star, ut, fl, func(star *C.char, jd C.double, fl C.int32, xx *C.double, err *C.char) C.int32 {
+		return C.swe_fixstar2_ut(star, jd, fl, xx, err)
+	})
+}
+
+type _fixStarMagFunc func(star *C.char, mag *C.double, err *C.char) C.int32
+
+func _fixStarMag(star string, fn _fixStarMagFunc) (name string, mag float64, err error) {
+	_star := starBuffer(star)
+	var _mag C.double
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == fn(&_star[0], &_mag, err)
+	})
+
+	return C.GoString(&_star[0]), float64(_mag), err
+}
+
+func fixStarMag(star string) (string, float64, error) {
+	return _fixStarMag(star, func(star *C.char, mag *C.double, err *C.char) C.int32 {
+		return C.swe_fixstar_mag(star, mag, err)
+	})
+}
+
+func fixStar2Mag(star string) (string, float64, error) {
+	return _fixStarMag(star, func(star *C.char, mag *C.double, err *C.char) C.int32 {
+		return C.swe_fixstar2_mag(star, mag, err)
+	})
+}
+
 type _nodApsFunc func(jd C.double, pl, fl, m C.int32, nasc, ndsc, peri, aphe *C.double, err *C.char) C.int32
 
 func _nodAps(jd float64, pl swego.Planet, fl int32, m swego.NodApsMethod, fn _nodApsFunc) (_, _, _, _ []float64, err error) {
@@ -206,6 +294,123 @@ func nodApsUT(ut float64, pl swego.Planet, fl int32, m swego.NodApsMethod) (nasc
 	})
 }
 
+func getOrbitalElements(et float64, pl swego.Planet, fl int32) (_ []float64, err error) {
+	_et := C.double(et)
+	_pl := C.int32(pl)
+	_fl := C.int32(fl)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var dret [50]float64
+	_dret := (*C.double)(unsafe.Pointer(&dret[0]))
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == C.swe_get_orbital_elements(_et, _pl, _fl, _dret, err)
+	})
+
+	return dret[:], err
+}
+
+func orbitMaxMinTrueDistance(et float64, pl swego.Planet, fl int32) (dmax, dmin, dtrue float64, err error) {
+	var _dmax, _dmin, _dtrue C.double
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == C.swe_orbit_max_min_true_distance(C.double(et), C.int32(pl), C.int32(fl), &_dmax, &_dmin, &_dtrue, err)
+	})
+
+	return float64(_dmax), float64(_dmin), float64(_dtrue), err
+}
+
+type _crossFunc func(x2cross, jd C.double, fl C.int32, err *C.char) C.double
+
+// The crossing functions indicate an error by returning a Julian Date before
+// the start of the search.
+func _cross(x2cross, jd float64, fl int32, fn _crossFunc) (t float64, err error) {
+	err = withError(func(err *C.char) bool {
+		t = float64(fn(C.double(x2cross), C.double(jd), C.int32(fl), err))
+		return t < jd
+	})
+
+	return t, err
+}
+
+func solCross(x2cross, et float64, fl int32) (float64, error) {
+	return _cross(x2cross, et, fl, func(x2cross, jd C.double, fl C.int32, err *C.char) C.double {
+		return C.swe_solcross(x2cross, jd, fl, err)
+	})
+}
+
+func solCrossUT(x2cross, ut float64, fl int32) (float64, error) {
+	return _cross(x2cross, ut, fl, func(x2cross, jd C.double, fl C.int32, err *C.char) C.double {
+		return C.swe_solcross_ut(x2cross, jd, fl, err)
+	})
+}
+
+func moonCross(x2cross, et float64, fl int32) (float64, error) {
+	return _cross(x2cross, et, fl, func(x2cross, jd C.double, fl C.int32, err *C.char) C.double {
+		return C.swe_mooncross(x2cross, jd, fl, err)
+	})
+}
+
+func moonCrossUT(x2cross, ut float64, fl int32) (float64, error) {
+	return _cross(x2cross, ut, fl, func(x2cross, jd C.double, fl C.int32, err *C.char) C.double {
+		return C.swe_mooncross_ut(x2cross, jd, fl, err)
+	})
+}
+
+type _moonCrossNodeFunc func(jd C.double, fl C.int32, lng, lat *C.double, err *C.char) C.double
+
+func _moonCrossNode(jd float64, fl int32, fn _moonCrossNodeFunc) (t, lng, lat float64, err error) {
+	var _lng, _lat C.double
+
+	err = withError(func(err *C.char) bool {
+		t = float64(fn(C.double(jd), C.int32(fl), &_lng, &_lat, err))
+		return t < jd
+	})
+
+	return t, float64(_lng), float64(_lat), err
+}
+
+func moonCrossNode(et float64, fl int32) (t, lng, lat float64, err error) {
+	return _moonCrossNode(et, fl, func(jd C.double, fl C.int32, lng, lat *C.double, err *C.char) C.double {
+		return C.swe_mooncross_node(jd, fl, lng, lat, err)
+	})
+}
+
+func moonCrossNodeUT(ut float64, fl int32) (t, lng, lat float64, err error) {
+	return _moonCrossNode(ut, fl, func(jd C.double, fl C.int32, lng, lat *C.double, err *C.char) C.double {
+		return C.swe_mooncross_node_ut(jd, fl, lng, lat, err)
+	})
+}
+
+type _helioCrossFunc func(pl C.int32, x2cross, jd C.double, fl, dir C.int32, t *C.double, err *C.char) C.int32
+
+func _helioCross(pl swego.Planet, x2cross, jd float64, fl int32, backward bool, fn _helioCrossFunc) (t float64, err error) {
+	_dir := C.int32(1)
+	if backward {
+		_dir = -1
+	}
+
+	var _t C.double
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == fn(C.int32(pl), C.double(x2cross), C.double(jd), C.int32(fl), _dir, &_t, err)
+	})
+
+	return float64(_t), err
+}
+
+func helioCross(pl swego.Planet, x2cross, et float64, fl int32, backward bool) (float64, error) {
+	return _helioCross(pl, x2cross, et, fl, backward, func(pl C.int32, x2cross, jd C.double, fl, dir C.int32, t *C.double, err *C.char) C.int32 {
+		return C.swe_helio_cross(pl, x2cross, jd, fl, dir, t, err)
+	})
+}
+
+func helioCrossUT(pl swego.Planet, x2cross, ut float64, fl int32, backward bool) (float64, error) {
+	return _helioCross(pl, x2cross, ut, fl, backward, func(pl C.int32, x2cross, jd C.double, fl, dir C.int32, t *C.double, err *C.char) C.int32 {
+		return C.swe_helio_cross_ut(pl, x2cross, jd, fl, dir, t, err)
+	})
+}
+
 type _getAyanamsaExFunc func(jd C.double, fl C.int32, aya *C.double, err *C.char) C.int32
 
 func _getAyanamsaEx(jd float64, fl int32, fn _getAyanamsaExFunc) (aya float64, err error) {
@@ -310,6 +515,28 @@ func jdUT1ToUTC(ut float64, gf int) (y, m, d, h, i int, s float64) {
 	})
 }
 
+func utcTimeZone(y, m, d, h, i int, s, tz float64) (oy, om, od, oh, oi int, os float64) {
+	_y := C.int32(y)
+	_m := C.int32(m)
+	_d := C.int32(d)
+	_h := C.int32(h)
+	_i := C.int32(i)
+	_s := C.double(s)
+	_tz := C.double(tz)
+	var _oy, _om, _od, _oh, _oi C.int32
+	var _os C.double
+
+	C.swe_utc_time_zone(_y, _m, _d, _h, _i, _s, _tz, &_oy, &_om, &_od, &_oh, &_oi, &_os)
+
+	oy = int(_oy)
+	om = int(_om)
+	od = int(_od)
+	oh = int(_oh)
+	oi = int(_oi)
+	os = float64(_os)
+	return
+}
+
 type _housesFunc func(lat C.double, hsys C.int, cusps, ascmc *C.double) C.int
 
 func _houses(lat float64, hsys swego.HSys, fn _housesFunc) (_, _ []float64, err error) {
@@ -359,6 +586,51 @@ func housesARMC(armc, lat, eps float64, hsys swego.HSys) ([]float64, []float64,
 	})
 }
 
+type _houses2Func func(lat C.double, hsys C.int, cusps, ascmc, cuspSpeeds, ascmcSpeeds *C.double, err *C.char) C.int
+
+func _houses2(lat float64, hsys swego.HSys, fn _houses2Func) (h swego.Houses, err error) {
+	_lat := C.double(lat)
+	_hsys := C.int(hsys)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var cusps, cuspSpeeds [37]float64
+	var ascmc, ascmcSpeeds [10]float64
+	_cusps := (*C.double)(unsafe.Pointer(&cusps[0]))
+	_ascmc := (*C.double)(unsafe.Pointer(&ascmc[0]))
+	_cuspSpeeds := (*C.double)(unsafe.Pointer(&cuspSpeeds[0]))
+	_ascmcSpeeds := (*C.double)(unsafe.Pointer(&ascmcSpeeds[0]))
+
+	// A message is also returned along with valid houses if the library falls
+	// back to Porphyry houses, it is only an error without message.
+	var _err [C.AS_MAXCH]C.char
+	if C.ERR == fn(_lat, _hsys, _cusps, _ascmc, _cuspSpeeds, _ascmcSpeeds, &_err[0]) && _err[0] == 0 {
+		err = swego.Error("swe_houses() error")
+	}
+
+	n := 13
+	if hsys == 'G' || hsys == 'g' {
+		n = 37
+	}
+
+	h.Cusps = cusps[:n:n]
+	h.CuspSpeeds = cuspSpeeds[:n:n]
+	h.SetAscMC(ascmc[:], ascmcSpeeds[:])
+	h.Warning = C.GoString(&_err[0])
+	return h, err
+}
+
+func housesEx2(ut float64, fl int32, lat, lng float64, hsys swego.HSys) (swego.Houses, error) {
+	return _houses2(lat, hsys, func(lat C.double, hsys C.int, cusps, ascmc, cuspSpeeds, ascmcSpeeds *C.double, err *C.char) C.int {
+		return C.swe_houses_ex2(C.double(ut), C.int32(fl), lat, C.double(lng), hsys, cusps, ascmc, cuspSpeeds, ascmcSpeeds, err)
+	})
+}
+
+func housesARMCEx2(armc, lat, eps float64, hsys swego.HSys) (swego.Houses, error) {
+	return _houses2(lat, hsys, func(lat C.double, hsys C.int, cusps, ascmc, cuspSpeeds, ascmcSpeeds *C.double, err *C.char) C.int {
+		return C.swe_houses_armc_ex2(C.double(armc), lat, C.double(eps), hsys, cusps, ascmc, cuspSpeeds, ascmcSpeeds, err)
+	})
+}
+
 func housePos(armc, geolat, eps float64, hsys swego.HSys, pllng, pllat float64) (pos float64, err error) {
 	_armc := C.double(armc)
 	_lat := C.double(geolat)
@@ -374,10 +646,422 @@ func housePos(armc, geolat, eps float64, hsys swego.HSys, pllng, pllat float64)
 	return
 }
 
+func gauquelinSector(ut float64, body swego.Body, fl int32, method swego.GauquelinMethod, loc swego.GeoLoc, atpress, attemp float64) (sect float64, err error) {
+	_star := starBuffer(body.Star)
+	_geopos := geoPos(loc)
+	var _sect C.double
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == C.swe_gauquelin_sector(C.double(ut), C.int32(body.Planet), &_star[0], C.int32(fl), C.int32(method), &_geopos[0], C.double(atpress), C.double(attemp), &_sect, err)
+	})
+
+	return float64(_sect), err
+}
+
 func houseName(hsys swego.HSys) string {
 	return C.GoString(C.swe_house_name(C.int(hsys)))
 }
 
+// geoPos returns geographic location loc as geopos array.
+func geoPos(loc swego.GeoLoc) [3]C.double {
+	return [3]C.double{C.double(loc.Long), C.double(loc.Lat), C.double(loc.Alt)}
+}
+
+func backwardFlag(backward bool) C.int32 {
+	if backward {
+		return 1
+	}
+
+	return 0
+}
+
+func lunOccultWhenLoc(ut float64, body swego.Body, fl int32, loc swego.GeoLoc, backward bool) (rv int32, _, _ []float64, err error) {
+	_ut := C.double(ut)
+	_pl := C.int32(body.Planet)
+	_star := starBuffer(body.Star)
+	_fl := C.int32(fl)
+	_geopos := geoPos(loc)
+	_backward := backwardFlag(backward)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var tret [10]float64
+	var attr [20]float64
+	_tret := (*C.double)(unsafe.Pointer(&tret[0]))
+	_attr := (*C.double)(unsafe.Pointer(&attr[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_lun_occult_when_loc(_ut, _pl, &_star[0], _fl, &_geopos[0], _tret, _attr, _backward, err))
+		return rv == C.ERR
+	})
+
+	return rv, tret[:], attr[:], err
+}
+
+func lunOccultWhenGlob(ut float64, body swego.Body, fl int32, typ swego.EclipseType, backward bool) (rv int32, _ []float64, err error) {
+	_ut := C.double(ut)
+	_pl := C.int32(body.Planet)
+	_star := starBuffer(body.Star)
+	_fl := C.int32(fl)
+
+	// SE_ECL_ONE_TRY is passed along with the backward flag.
+	_typ := C.int32(typ &^ swego.EclOneTry)
+	_backward := backwardFlag(backward) | C.int32(typ&swego.EclOneTry)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var tret [10]float64
+	_tret := (*C.double)(unsafe.Pointer(&tret[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_lun_occult_when_glob(_ut, _pl, &_star[0], _fl, _typ, _tret, _backward, err))
+		return rv == C.ERR
+	})
+
+	return rv, tret[:], err
+}
+
+func solEclipseWhenGlob(ut float64, fl int32, typ swego.EclipseType, backward bool) (rv int32, _ []float64, err error) {
+	_ut := C.double(ut)
+	_fl := C.int32(fl)
+	_typ := C.int32(typ)
+	_backward := backwardFlag(backward)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var tret [10]float64
+	_tret := (*C.double)(unsafe.Pointer(&tret[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_sol_eclipse_when_glob(_ut, _fl, _typ, _tret, _backward, err))
+		return rv == C.ERR
+	})
+
+	return rv, tret[:], err
+}
+
+func solEclipseWhenLoc(ut float64, fl int32, loc swego.GeoLoc, backward bool) (rv int32, _, _ []float64, err error) {
+	_ut := C.double(ut)
+	_fl := C.int32(fl)
+	_geopos := geoPos(loc)
+	_backward := backwardFlag(backward)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var tret [10]float64
+	var attr [20]float64
+	_tret := (*C.double)(unsafe.Pointer(&tret[0]))
+	_attr := (*C.double)(unsafe.Pointer(&attr[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_sol_eclipse_when_loc(_ut, _fl, &_geopos[0], _tret, _attr, _backward, err))
+		return rv == C.ERR
+	})
+
+	return rv, tret[:], attr[:], err
+}
+
+func solEclipseWhere(ut float64, fl int32) (rv int32, _, _ []float64, err error) {
+	_ut := C.double(ut)
+	_fl := C.int32(fl)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var geopos [10]float64
+	var attr [20]float64
+	_geopos := (*C.double)(unsafe.Pointer(&geopos[0]))
+	_attr := (*C.double)(unsafe.Pointer(&attr[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_sol_eclipse_where(_ut, _fl, _geopos, _attr, err))
+		return rv == C.ERR
+	})
+
+	return rv, geopos[:], attr[:], err
+}
+
+func lunOccultWhere(ut float64, body swego.Body, fl int32) (rv int32, _, _ []float64, err error) {
+	_ut := C.double(ut)
+	_pl := C.int32(body.Planet)
+	_star := starBuffer(body.Star)
+	_fl := C.int32(fl)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var geopos [10]float64
+	var attr [20]float64
+	_geopos := (*C.double)(unsafe.Pointer(&geopos[0]))
+	_attr := (*C.double)(unsafe.Pointer(&attr[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_lun_occult_where(_ut, _pl, &_star[0], _fl, _geopos, _attr, err))
+		return rv == C.ERR
+	})
+
+	return rv, geopos[:], attr[:], err
+}
+
+func solEclipseHow(ut float64, fl int32, loc swego.GeoLoc) (rv int32, _ []float64, err error) {
+	_ut := C.double(ut)
+	_fl := C.int32(fl)
+	_geopos := geoPos(loc)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var attr [20]float64
+	_attr := (*C.double)(unsafe.Pointer(&attr[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_sol_eclipse_how(_ut, _fl, &_geopos[0], _attr, err))
+		return rv == C.ERR
+	})
+
+	return rv, attr[:], err
+}
+
+func lunEclipseHow(ut float64, fl int32, loc swego.GeoLoc) (rv int32, _ []float64, err error) {
+	_ut := C.double(ut)
+	_fl := C.int32(fl)
+	_geopos := geoPos(loc)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var attr [20]float64
+	_attr := (*C.double)(unsafe.Pointer(&attr[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_lun_eclipse_how(_ut, _fl, &_geopos[0], _attr, err))
+		return rv == C.ERR
+	})
+
+	return rv, attr[:], err
+}
+
+func lunEclipseWhen(ut float64, fl int32, typ swego.EclipseType, backward bool) (rv int32, _ []float64, err error) {
+	_ut := C.double(ut)
+	_fl := C.int32(fl)
+	_typ := C.int32(typ)
+	_backward := backwardFlag(backward)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var tret [10]float64
+	_tret := (*C.double)(unsafe.Pointer(&tret[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_lun_eclipse_when(_ut, _fl, _typ, _tret, _backward, err))
+		return rv == C.ERR
+	})
+
+	return rv, tret[:], err
+}
+
+func lunEclipseWhenLoc(ut float64, fl int32, loc swego.GeoLoc, backward bool) (rv int32, _, _ []float64, err error) {
+	_ut := C.double(ut)
+	_fl := C.int32(fl)
+	_geopos := geoPos(loc)
+	_backward := backwardFlag(backward)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var tret [10]float64
+	var attr [20]float64
+	_tret := (*C.double)(unsafe.Pointer(&tret[0]))
+	_attr := (*C.double)(unsafe.Pointer(&attr[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_lun_eclipse_when_loc(_ut, _fl, &_geopos[0], _tret, _attr, _backward, err))
+		return rv == C.ERR
+	})
+
+	return rv, tret[:], attr[:], err
+}
+
+type _phenoFunc func(jd C.double, pl C.int32, fl C.int32, attr *C.double, err *C.char) C.int32
+
+func _pheno(jd float64, pl swego.Planet, fl int32, fn _phenoFunc) (_ []float64, err error) {
+	_jd := C.double(jd)
+	_pl := C.int32(pl)
+	_fl := C.int32(fl)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var attr [20]float64
+	_attr := (*C.double)(unsafe.Pointer(&attr[0]))
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == fn(_jd, _pl, _fl, _attr, err)
+	})
+
+	return attr[:], err
+}
+
+func pheno(et float64, pl swego.Planet, fl int32) ([]float64, error) {
+	return _pheno(et, pl, fl, func(jd C.double, pl C.int32, fl C.int32, attr *C.double, err *C.char) C.int32 {
+		return C.swe_pheno(jd, pl, fl, attr, err)
+	})
+}
+
+func phenoUT(ut float64, pl swego.Planet, fl int32) ([]float64, error) {
+	return _pheno(ut, pl, fl, func(jd C.double, pl C.int32, fl C.int32, attr *C.double, err *C.char) C.int32 {
+		return C.swe_pheno_ut(jd, pl, fl, attr, err)
+	})
+}
+
+// riseTransNotFound is the return value of swe_rise_trans if no rise or set
+// is found.
+const riseTransNotFound = -2
+
+func riseTrans(ut float64, body swego.Body, fl int32, event swego.RiseTransEvent, loc swego.GeoLoc, atpress, attemp float64, horhgt *float64) (rv int32, t float64, err error) {
+	_ut := C.double(ut)
+	_pl := C.int32(body.Planet)
+	_star := starBuffer(body.Star)
+	_fl := C.int32(fl)
+	_rsmi := C.int32(event)
+	_geopos := geoPos(loc)
+	_atpress := C.double(atpress)
+	_attemp := C.double(attemp)
+
+	var tret [10]C.double
+
+	err = withError(func(err *C.char) bool {
+		if horhgt == nil {
+			rv = int32(C.swe_rise_trans(_ut, _pl, &_star[0], _fl, _rsmi, &_geopos[0], _atpress, _attemp, &tret[0], err))
+		} else {
+			_horhgt := C.double(*horhgt)
+			rv = int32(C.swe_rise_trans_true_hor(_ut, _pl, &_star[0], _fl, _rsmi, &_geopos[0], _atpress, _attemp, _horhgt, &tret[0], err))
+		}
+
+		return rv == C.ERR
+	})
+
+	return rv, float64(tret[0]), err
+}
+
+func azAlt(ut float64, mode swego.AzAltMode, loc swego.GeoLoc, atpress, attemp, lng, lat float64) (az, trueAlt, appAlt float64) {
+	_geopos := geoPos(loc)
+	xin := [3]C.double{C.double(lng), C.double(lat), 1}
+	var xaz [3]C.double
+
+	C.swe_azalt(C.double(ut), C.int32(mode), &_geopos[0], C.double(atpress), C.double(attemp), &xin[0], &xaz[0])
+	return float64(xaz[0]), float64(xaz[1]), float64(xaz[2])
+}
+
+func azAltRev(ut float64, mode swego.AzAltMode, loc swego.GeoLoc, az, alt float64) (lng, lat float64) {
+	_geopos := geoPos(loc)
+	xin := [2]C.double{C.double(az), C.double(alt)}
+	var xout [3]C.double
+
+	C.swe_azalt_rev(C.double(ut), C.int32(mode), &_geopos[0], &xin[0], &xout[0])
+	return float64(xout[0]), float64(xout[1])
+}
+
+func refrac(alt, atpress, attemp float64, mode swego.RefracMode) float64 {
+	return float64(C.swe_refrac(C.double(alt), C.double(atpress), C.double(attemp), C.int32(mode)))
+}
+
+func refracExtended(alt, geoalt, atpress, attemp, lapseRate float64, mode swego.RefracMode) (float64, []float64) {
+	// See _calc for the cast of a float64 array to a C.double array.
+	var dret [4]float64
+	_dret := (*C.double)(unsafe.Pointer(&dret[0]))
+
+	rv := C.swe_refrac_extended(C.double(alt), C.double(geoalt), C.double(atpress), C.double(attemp),
+		C.double(lapseRate), C.int32(mode), _dret)
+	return float64(rv), dret[:]
+}
+
+func setLapseRate(lr float64) {
+	C.swe_set_lapse_rate(C.double(lr))
+}
+
+func atmosphere(atm swego.Atmosphere) [4]C.double {
+	return [4]C.double{C.double(atm.Pressure), C.double(atm.Temperature), C.double(atm.Humidity), C.double(atm.Extinction)}
+}
+
+func observer(obs swego.Observer) [6]C.double {
+	var binocular C.double
+	if obs.Binocular {
+		binocular = 1
+	}
+
+	return [6]C.double{C.double(obs.Age), C.double(obs.SnellenRatio), binocular,
+		C.double(obs.Magnification), C.double(obs.Aperture), C.double(obs.Transmission)}
+}
+
+type _heliacalFunc func(jd C.double, geopos, datm, dobs *C.double, name *C.char, event C.int32, fl C.int32, dret *C.double, err *C.char) C.int32
+
+func _heliacal(ut float64, loc swego.GeoLoc, atm swego.Atmosphere, obs swego.Observer, body swego.Body, event swego.HeliacalEvent, fl int32, fn _heliacalFunc) (_ []float64, err error) {
+	_ut := C.double(ut)
+	_geopos := geoPos(loc)
+	_datm := atmosphere(atm)
+	_dobs := observer(obs)
+	_name := starBuffer(body.HeliacalName())
+	_event := C.int32(event)
+	_fl := C.int32(fl)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var dret [50]float64
+	_dret := (*C.double)(unsafe.Pointer(&dret[0]))
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == fn(_ut, &_geopos[0], &_datm[0], &_dobs[0], &_name[0], _event, _fl, _dret, err)
+	})
+
+	return dret[:], err
+}
+
+func heliacalUT(ut float64, loc swego.GeoLoc, atm swego.Atmosphere, obs swego.Observer, body swego.Body, event swego.HeliacalEvent, fl int32) ([]float64, error) {
+	return _heliacal(ut, loc, atm, obs, body, event, fl, func(jd C.double, geopos, datm, dobs *C.double, name *C.char, event C.int32, fl C.int32, dret *C.double, err *C.char) C.int32 {
+		return C.swe_heliacal_ut(jd, geopos, datm, dobs, name, event, fl, dret, err)
+	})
+}
+
+func heliacalPhenoUT(ut float64, loc swego.GeoLoc, atm swego.Atmosphere, obs swego.Observer, body swego.Body, event swego.HeliacalEvent, fl int32) ([]float64, error) {
+	return _heliacal(ut, loc, atm, obs, body, event, fl, func(jd C.double, geopos, datm, dobs *C.double, name *C.char, event C.int32, fl C.int32, darr *C.double, err *C.char) C.int32 {
+		return C.swe_heliacal_pheno_ut(jd, geopos, datm, dobs, name, event, fl, darr, err)
+	})
+}
+
+func visLimitMag(ut float64, loc swego.GeoLoc, atm swego.Atmosphere, obs swego.Observer, body swego.Body, fl int32) (rv int32, _ []float64, err error) {
+	_ut := C.double(ut)
+	_geopos := geoPos(loc)
+	_datm := atmosphere(atm)
+	_dobs := observer(obs)
+	_name := starBuffer(body.HeliacalName())
+	_fl := C.int32(fl)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var dret [10]float64
+	_dret := (*C.double)(unsafe.Pointer(&dret[0]))
+
+	err = withError(func(err *C.char) bool {
+		rv = int32(C.swe_vis_limit_mag(_ut, &_geopos[0], &_datm[0], &_dobs[0], &_name[0], _fl, _dret, err))
+		return rv == C.ERR
+	})
+
+	return rv, dret[:], err
+}
+
+func heliacalAngle(ut float64, loc swego.GeoLoc, atm swego.Atmosphere, obs swego.Observer, fl int32, mag, objAz, sunAz, moonAz, moonAlt float64) (_ []float64, err error) {
+	_geopos := geoPos(loc)
+	_datm := atmosphere(atm)
+	_dobs := observer(obs)
+
+	// See _calc for the cast of a float64 array to a C.double array.
+	var dret [3]float64
+	_dret := (*C.double)(unsafe.Pointer(&dret[0]))
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == C.swe_heliacal_angle(C.double(ut), &_geopos[0], &_datm[0], &_dobs[0], C.int32(fl),
+			C.double(mag), C.double(objAz), C.double(sunAz), C.double(moonAz), C.double(moonAlt), _dret, err)
+	})
+
+	return dret[:], err
+}
+
+func topoArcusVisionis(ut float64, loc swego.GeoLoc, atm swego.Atmosphere, obs swego.Observer, fl int32, mag, objAz, objAlt, sunAz, moonAz, moonAlt float64) (arcVis float64, err error) {
+	_geopos := geoPos(loc)
+	_datm := atmosphere(atm)
+	_dobs := observer(obs)
+	var _arcVis C.double
+
+	err = withError(func(err *C.char) bool {
+		return C.ERR == C.swe_topo_arcus_visionis(C.double(ut), &_geopos[0], &_datm[0], &_dobs[0], C.int32(fl),
+			C.double(mag), C.double(objAz), C.double(objAlt), C.double(sunAz), C.double(moonAz), C.double(moonAlt), &_arcVis, err)
+	})
+
+	return float64(_arcVis), err
+}
+
 func deltaTEx(jd float64, eph int32) (deltaT float64, err error) {
 	err = withError(func(err *C.char) bool {
 		deltaT = float64(C.swe_deltat_ex(C.double(jd), C.int32(eph), err))
@@ -441,6 +1125,28 @@ func sidTime(ut float64) float64 {
 }
 
 // Returns (ideg, imin, isec, dsecfr, isgn)
+func coTrans(xpo []float64, eps float64) []float64 {
+	// See _calc for the cast of a float64 array to a C.double array.
+	var in, out [3]float64
+	copy(in[:], xpo)
+	_in := (*C.double)(unsafe.Pointer(&in[0]))
+	_out := (*C.double)(unsafe.Pointer(&out[0]))
+
+	C.swe_cotrans(_in, _out, C.double(eps))
+	return out[:]
+}
+
+func coTransSp(xpo []float64, eps float64) []float64 {
+	// See _calc for the cast of a float64 array to a C.double array.
+	var in, out [6]float64
+	copy(in[:], xpo)
+	_in := (*C.double)(unsafe.Pointer(&in[0]))
+	_out := (*C.double)(unsafe.Pointer(&out[0]))
+
+	C.swe_cotrans_sp(_in, _out, C.double(eps))
+	return out[:]
+}
+
 func splitDeg(ddeg float64, roundflag int) (int32, int32, int32, float64, int32) {
 	var ideg C.int32
 	var imin C.int32
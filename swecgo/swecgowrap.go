@@ -4,6 +4,8 @@
 package swecgo
 
 import (
+	"context"
+
 	"github.com/howesteve/swego"
 )
 
@@ -102,6 +104,100 @@ func (w *wrapper) CalcUT(ut float64, pl swego.Planet, fl *swego.CalcFlags) ([]fl
 	return xx, cfl, err
 }
 
+func (w *wrapper) CalcBatch(requests []swego.CalcRequest) []swego.CalcResult {
+	results := make([]swego.CalcResult, len(requests))
+	w.acquire()
+	calcBatch(context.Background(), requests, results)
+	w.release()
+	return results
+}
+
+// calcBatch calculates requests in order and stores the results in results.
+// The wrapper must be acquired. If ctx is done, the remaining requests are
+// not calculated and their results contain the context error.
+func calcBatch(ctx context.Context, requests []swego.CalcRequest, results []swego.CalcResult) {
+	var fl *swego.CalcFlags
+	var flags int32
+	for i, r := range requests {
+		if err := ctx.Err(); err != nil {
+			setBatchError(results[i:], err)
+			return
+		}
+
+		if i == 0 || r.Flags != fl {
+			fl = r.Flags
+			flags = setCalcFlagsState(fl)
+		}
+
+		res := &results[i]
+		if r.UT {
+			res.XX, res.Flags, res.Err = calcUT(r.JD, r.Planet, flags)
+		} else {
+			res.XX, res.Flags, res.Err = calc(r.JD, r.Planet, flags)
+		}
+	}
+}
+
+func setBatchError(results []swego.CalcResult, err error) {
+	for i := range results {
+		results[i].Err = err
+	}
+}
+
+func (w *wrapper) CalcPctr(et float64, pl, center swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	xx, cfl, err := calcPctr(et, pl, center, flags)
+	w.release()
+	return xx, cfl, err
+}
+
+func (w *wrapper) FixStar(star string, et float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	name, xx, cfl, err := fixStar(star, et, flags)
+	w.release()
+	return name, xx, cfl, err
+}
+
+func (w *wrapper) FixStarUT(star string, ut float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	name, xx, cfl, err := fixStarUT(star, ut, flags)
+	w.release()
+	return name, xx, cfl, err
+}
+
+func (w *wrapper) FixStarMag(star string) (string, float64, error) {
+	w.acquire()
+	name, mag, err := fixStarMag(star)
+	w.release()
+	return name, mag, err
+}
+
+func (w *wrapper) FixStar2(star string, et float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	name, xx, cfl, err := fixStar2(star, et, flags)
+	w.release()
+	return name, xx, cfl, err
+}
+
+func (w *wrapper) FixStar2UT(star string, ut float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	name, xx, cfl, err := fixStar2UT(star, ut, flags)
+	w.release()
+	return name, xx, cfl, err
+}
+
+func (w *wrapper) FixStar2Mag(star string) (string, float64, error) {
+	w.acquire()
+	name, mag, err := fixStar2Mag(star)
+	w.release()
+	return name, mag, err
+}
+
 func (w *wrapper) NodAps(et float64, pl swego.Planet, fl *swego.CalcFlags, m swego.NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error) {
 	w.acquire()
 	flags := setCalcFlagsState(fl)
@@ -118,6 +214,92 @@ func (w *wrapper) NodApsUT(ut float64, pl swego.Planet, fl *swego.CalcFlags, m s
 	return
 }
 
+func (w *wrapper) GetOrbitalElements(et float64, pl swego.Planet, fl *swego.CalcFlags) (o swego.OrbitalElements, err error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	dret, err := getOrbitalElements(et, pl, flags)
+	w.release()
+
+	if err != nil {
+		return o, err
+	}
+
+	o.SetElements(dret)
+	return o, nil
+}
+
+func (w *wrapper) OrbitMaxMinTrueDistance(et float64, pl swego.Planet, fl *swego.CalcFlags) (dmax, dmin, dtrue float64, err error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	dmax, dmin, dtrue, err = orbitMaxMinTrueDistance(et, pl, flags)
+	w.release()
+	return
+}
+
+func (w *wrapper) SolCross(x2cross, et float64, fl *swego.CalcFlags) (float64, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	t, err := solCross(x2cross, et, flags)
+	w.release()
+	return t, err
+}
+
+func (w *wrapper) SolCrossUT(x2cross, ut float64, fl *swego.CalcFlags) (float64, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	t, err := solCrossUT(x2cross, ut, flags)
+	w.release()
+	return t, err
+}
+
+func (w *wrapper) MoonCross(x2cross, et float64, fl *swego.CalcFlags) (float64, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	t, err := moonCross(x2cross, et, flags)
+	w.release()
+	return t, err
+}
+
+func (w *wrapper) MoonCrossUT(x2cross, ut float64, fl *swego.CalcFlags) (float64, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	t, err := moonCrossUT(x2cross, ut, flags)
+	w.release()
+	return t, err
+}
+
+func (w *wrapper) MoonCrossNode(et float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	t, lng, lat, err = moonCrossNode(et, flags)
+	w.release()
+	return
+}
+
+func (w *wrapper) MoonCrossNodeUT(ut float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	t, lng, lat, err = moonCrossNodeUT(ut, flags)
+	w.release()
+	return
+}
+
+func (w *wrapper) HelioCross(pl swego.Planet, x2cross, et float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	t, err := helioCross(pl, x2cross, et, flags, backward)
+	w.release()
+	return t, err
+}
+
+func (w *wrapper) HelioCrossUT(pl swego.Planet, x2cross, ut float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	t, err := helioCrossUT(pl, x2cross, ut, flags, backward)
+	w.release()
+	return t, err
+}
+
 func (w *wrapper) GetAyanamsaEx(et float64, fl *swego.AyanamsaExFlags) (float64, error) {
 	w.acquire()
 	setSidMode(fl.SidMode.Mode, fl.SidMode.T0, fl.SidMode.AyanT0)
@@ -175,25 +357,41 @@ func (w *wrapper) JdUT1ToUTC(ut1 float64, fl *swego.DateConvertFlags) (y, m, d,
 	return y, m, d, h, i, s, nil
 }
 
-func (w *wrapper) HousesEx(ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) ([]float64, []float64, error) {
-	w.acquire()
-	var flags int32
-	if fl != nil {
-		flags = fl.Flags
-		if (flags & flgSidereal) == flgSidereal {
-			setSidMode(fl.SidMode.Mode, fl.SidMode.T0, fl.SidMode.AyanT0)
-		}
+func (w *wrapper) UTCTimeZone(y, m, d, h, i int, s, tz float64) (int, int, int, int, int, float64, error) {
+	y, m, d, h, i, s = utcTimeZone(y, m, d, h, i, s, tz)
+	return y, m, d, h, i, s, nil
+}
 
-		setDeltaT(fl.DeltaT)
-	} else {
+func setHousesExFlagsState(fl *swego.HousesExFlags) int32 {
+	if fl == nil {
 		setDeltaT(nil)
+		return 0
+	}
+
+	if (fl.Flags & flgSidereal) == flgSidereal {
+		setSidMode(fl.SidMode.Mode, fl.SidMode.T0, fl.SidMode.AyanT0)
 	}
 
+	setDeltaT(fl.DeltaT)
+	return fl.Flags
+}
+
+func (w *wrapper) HousesEx(ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) ([]float64, []float64, error) {
+	w.acquire()
+	flags := setHousesExFlagsState(fl)
 	cusps, ascmc, err := housesEx(ut, flags, geolat, geolon, hsys)
 	w.release()
 	return cusps, ascmc, err
 }
 
+func (w *wrapper) HousesEx2(ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) (swego.Houses, error) {
+	w.acquire()
+	flags := setHousesExFlagsState(fl)
+	h, err := housesEx2(ut, flags, geolat, geolon, hsys)
+	w.release()
+	return h, err
+}
+
 func (w *wrapper) HousesARMC(armc, geolat, eps float64, hsys swego.HSys) ([]float64, []float64, error) {
 	w.acquire()
 	cusps, ascmc, err := housesARMC(armc, geolat, eps, hsys)
@@ -201,6 +399,13 @@ func (w *wrapper) HousesARMC(armc, geolat, eps float64, hsys swego.HSys) ([]floa
 	return cusps, ascmc, err
 }
 
+func (w *wrapper) HousesARMCEx2(armc, geolat, eps float64, hsys swego.HSys) (swego.Houses, error) {
+	w.acquire()
+	h, err := housesARMCEx2(armc, geolat, eps, hsys)
+	w.release()
+	return h, err
+}
+
 func (w *wrapper) HousePos(armc, geolat, eps float64, hsys swego.HSys, pllng, pllat float64) (float64, error) {
 	w.acquire()
 	pos, err := housePos(armc, geolat, eps, hsys, pllng, pllat)
@@ -208,6 +413,15 @@ func (w *wrapper) HousePos(armc, geolat, eps float64, hsys swego.HSys, pllng, pl
 	return pos, err
 }
 
+func (w *wrapper) GauquelinSector(ut float64, body swego.Body, loc swego.GeoLoc, method swego.GauquelinMethod, atpress, attemp float64, fl *swego.CalcFlags) (float64, error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	setLapseRate(swego.DefaultLapseRate)
+	sect, err := gauquelinSector(ut, body, flags, method, loc, atpress, attemp)
+	w.release()
+	return sect, err
+}
+
 func (w *wrapper) HouseName(hsys swego.HSys) (string, error) {
 	w.acquire()
 	name := houseName(hsys)
@@ -215,6 +429,384 @@ func (w *wrapper) HouseName(hsys swego.HSys) (string, error) {
 	return name, nil
 }
 
+func setEclipseFlagsState(fl *swego.EclipseFlags) int32 {
+	if fl == nil {
+		setDeltaT(nil)
+		return 0
+	}
+
+	if (fl.Flags&swego.FlagEphJPL) > 0 && fl.JPLFile != "" {
+		setJPLFile(fl.JPLFile)
+	}
+
+	setDeltaT(fl.DeltaT)
+	return fl.Flags
+}
+
+func (w *wrapper) SolEclipseWhenGlob(ut float64, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (e swego.SolarEclipse, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, tret, err := solEclipseWhenGlob(ut, flags, typ, backward)
+	w.release()
+
+	if err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetGlobalTimes(tret)
+	return e, nil
+}
+
+func (w *wrapper) SolEclipseWhenLoc(ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (e swego.SolarEclipse, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, tret, attr, err := solEclipseWhenLoc(ut, flags, loc, backward)
+	w.release()
+
+	if err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetLocalTimes(tret)
+	e.SetAttributes(attr)
+	return e, nil
+}
+
+func (w *wrapper) SolEclipseWhere(ut float64, fl *swego.EclipseFlags) (e swego.SolarEclipse, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, geopos, attr, err := solEclipseWhere(ut, flags)
+	w.release()
+
+	if err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetAttributes(attr)
+	e.CentralLine = swego.GeoLoc{Long: geopos[0], Lat: geopos[1]}
+	return e, nil
+}
+
+func (w *wrapper) SolEclipseHow(ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc) (e swego.SolarEclipse, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, attr, err := solEclipseHow(ut, flags, loc)
+	w.release()
+
+	if err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetAttributes(attr)
+	return e, nil
+}
+
+func (w *wrapper) LunOccultWhenGlob(ut float64, body swego.Body, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (o swego.Occultation, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, tret, err := lunOccultWhenGlob(ut, body, flags, typ, backward)
+	w.release()
+
+	if err != nil {
+		return o, err
+	}
+
+	o.SetType(swego.EclipseType(rv))
+	o.SetGlobalTimes(tret)
+	return o, nil
+}
+
+func (w *wrapper) LunOccultWhenLoc(ut float64, body swego.Body, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (o swego.Occultation, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, tret, attr, err := lunOccultWhenLoc(ut, body, flags, loc, backward)
+	w.release()
+
+	if err != nil {
+		return o, err
+	}
+
+	o.SetType(swego.EclipseType(rv))
+	o.SetLocalTimes(tret)
+	o.SetAttributes(attr)
+	return o, nil
+}
+
+func (w *wrapper) LunOccultWhere(ut float64, body swego.Body, fl *swego.EclipseFlags) (o swego.Occultation, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, geopos, attr, err := lunOccultWhere(ut, body, flags)
+	w.release()
+
+	if err != nil {
+		return o, err
+	}
+
+	o.SetType(swego.EclipseType(rv))
+	o.SetAttributes(attr)
+	o.CentralLine = swego.GeoLoc{Long: geopos[0], Lat: geopos[1]}
+	return o, nil
+}
+
+func (w *wrapper) LunEclipseWhen(ut float64, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (e swego.LunarEclipse, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, tret, err := lunEclipseWhen(ut, flags, typ, backward)
+	w.release()
+
+	if err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetTimes(tret)
+	return e, nil
+}
+
+func (w *wrapper) LunEclipseWhenLoc(ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (e swego.LunarEclipse, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, tret, attr, err := lunEclipseWhenLoc(ut, flags, loc, backward)
+	w.release()
+
+	if err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetTimes(tret)
+	e.SetAttributes(attr)
+	return e, nil
+}
+
+func (w *wrapper) LunEclipseHow(ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc) (e swego.LunarEclipse, err error) {
+	w.acquire()
+	flags := setEclipseFlagsState(fl)
+	rv, attr, err := lunEclipseHow(ut, flags, loc)
+	w.release()
+
+	if err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetAttributes(attr)
+	return e, nil
+}
+
+func (w *wrapper) Pheno(et float64, pl swego.Planet, fl *swego.CalcFlags) (p swego.Phenomena, err error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	attr, err := pheno(et, pl, flags)
+	w.release()
+
+	if err != nil {
+		return p, err
+	}
+
+	p.SetAttributes(attr)
+	return p, nil
+}
+
+func (w *wrapper) PhenoUT(ut float64, pl swego.Planet, fl *swego.CalcFlags) (p swego.Phenomena, err error) {
+	w.acquire()
+	flags := setCalcFlagsState(fl)
+	attr, err := phenoUT(ut, pl, flags)
+	w.release()
+
+	if err != nil {
+		return p, err
+	}
+
+	p.SetAttributes(attr)
+	return p, nil
+}
+
+func (w *wrapper) RiseTrans(ut float64, body swego.Body, loc swego.GeoLoc, event swego.RiseTransEvent, fl *swego.RiseTransFlags) (float64, error) {
+	if fl == nil {
+		fl = new(swego.RiseTransFlags)
+	}
+
+	w.acquire()
+	if (fl.Flags&swego.FlagEphJPL) > 0 && fl.JPLFile != "" {
+		setJPLFile(fl.JPLFile)
+	}
+
+	setLapseRate(swego.DefaultLapseRate)
+	setDeltaT(fl.DeltaT)
+	rv, t, err := riseTrans(ut, body, fl.Flags, event, loc, fl.AtPress, fl.AtTemp, fl.HorHgt)
+	w.release()
+
+	if rv == riseTransNotFound {
+		return 0, &swego.CircumpolarError{Body: body, Event: event}
+	}
+
+	return t, err
+}
+
+// setAzAltFlagsState sets the lapse rate of fl. The rise and set, Gauquelin
+// sector and heliacal functions read the same lapse rate, they reset it.
+func setAzAltFlagsState(fl *swego.AzAltFlags) {
+	lr := swego.DefaultLapseRate
+	if fl.LapseRate != nil {
+		lr = *fl.LapseRate
+	}
+
+	setLapseRate(lr)
+	setDeltaT(fl.DeltaT)
+}
+
+func (w *wrapper) AzAlt(ut float64, loc swego.GeoLoc, mode swego.AzAltMode, lng, lat float64, fl *swego.AzAltFlags) (az, trueAlt, appAlt float64, err error) {
+	if fl == nil {
+		fl = new(swego.AzAltFlags)
+	}
+
+	w.acquire()
+	setAzAltFlagsState(fl)
+	az, trueAlt, appAlt = azAlt(ut, mode, loc, fl.AtPress, fl.AtTemp, lng, lat)
+	w.release()
+	return az, trueAlt, appAlt, nil
+}
+
+func (w *wrapper) AzAltRev(ut float64, loc swego.GeoLoc, mode swego.AzAltMode, az, alt float64, fl *swego.AzAltFlags) (lng, lat float64, err error) {
+	if fl == nil {
+		fl = new(swego.AzAltFlags)
+	}
+
+	w.acquire()
+	setAzAltFlagsState(fl)
+	lng, lat = azAltRev(ut, mode, loc, az, alt)
+	w.release()
+	return lng, lat, nil
+}
+
+func (w *wrapper) Refrac(alt, atpress, attemp float64, mode swego.RefracMode) (float64, error) {
+	w.acquire()
+	f := refrac(alt, atpress, attemp, mode)
+	w.release()
+	return f, nil
+}
+
+func (w *wrapper) RefracExtended(alt, geoalt, atpress, attemp, lapseRate float64, mode swego.RefracMode) (float64, swego.Refraction, error) {
+	w.acquire()
+	f, dret := refracExtended(alt, geoalt, atpress, attemp, lapseRate, mode)
+	w.release()
+
+	r := swego.Refraction{
+		TrueAlt:    dret[0],
+		AppAlt:     dret[1],
+		Refraction: dret[2],
+		Dip:        dret[3],
+	}
+
+	return f, r, nil
+}
+
+func setHeliacalFlagsState(fl *swego.HeliacalFlags) {
+	if (fl.Flags&swego.FlagEphJPL) > 0 && fl.JPLFile != "" {
+		setJPLFile(fl.JPLFile)
+	}
+
+	setLapseRate(swego.DefaultLapseRate)
+	setDeltaT(fl.DeltaT)
+}
+
+func (w *wrapper) HeliacalUT(ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) (h swego.HeliacalTimes, err error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	w.acquire()
+	setHeliacalFlagsState(fl)
+	dret, err := heliacalUT(ut, loc, fl.Atmosphere, fl.Observer, body, event, fl.Flags)
+	w.release()
+
+	if err != nil {
+		return h, err
+	}
+
+	h.SetTimes(dret)
+	return h, nil
+}
+
+func (w *wrapper) HeliacalPhenoUT(ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) (p swego.HeliacalPheno, err error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	w.acquire()
+	setHeliacalFlagsState(fl)
+	darr, err := heliacalPhenoUT(ut, loc, fl.Atmosphere, fl.Observer, body, event, fl.Flags)
+	w.release()
+
+	if err != nil {
+		return p, err
+	}
+
+	p.SetAttributes(darr)
+	return p, nil
+}
+
+// visLimitBelowHorizon is the return value of swe_vis_limit_mag if the object
+// is below the horizon.
+const visLimitBelowHorizon = -2
+
+func (w *wrapper) VisLimitMag(ut float64, loc swego.GeoLoc, body swego.Body, fl *swego.HeliacalFlags) (v swego.VisLimit, err error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	w.acquire()
+	setHeliacalFlagsState(fl)
+	rv, dret, err := visLimitMag(ut, loc, fl.Atmosphere, fl.Observer, body, fl.Flags)
+	w.release()
+
+	if err != nil {
+		return v, err
+	}
+
+	if rv == visLimitBelowHorizon {
+		return v, swego.ErrBelowHorizon
+	}
+
+	v.Mode = swego.VisionMode(rv)
+	v.SetAttributes(dret)
+	return v, nil
+}
+
+func (w *wrapper) HeliacalAngle(ut float64, loc swego.GeoLoc, mag, objAz, sunAz, moonAz, moonAlt float64, fl *swego.HeliacalFlags) (angle, arcVis, sunAlt float64, err error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	w.acquire()
+	setHeliacalFlagsState(fl)
+	dret, err := heliacalAngle(ut, loc, fl.Atmosphere, fl.Observer, fl.Flags, mag, objAz, sunAz, moonAz, moonAlt)
+	w.release()
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return dret[0], dret[1], dret[2], nil
+}
+
+func (w *wrapper) TopoArcusVisionis(ut float64, loc swego.GeoLoc, mag, objAz, objAlt, sunAz, moonAz, moonAlt float64, fl *swego.HeliacalFlags) (float64, error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	w.acquire()
+	setHeliacalFlagsState(fl)
+	arcVis, err := topoArcusVisionis(ut, loc, fl.Atmosphere, fl.Observer, fl.Flags, mag, objAz, objAlt, sunAz, moonAz, moonAlt)
+	w.release()
+	return arcVis, err
+}
+
 func (w *wrapper) DeltaTEx(jd float64, eph swego.Ephemeris) (float64, error) {
 	w.acquire()
 	dt, err := deltaTEx(jd, int32(eph))
@@ -278,6 +870,14 @@ func (w *wrapper) SidTime(ut float64, fl *swego.SidTimeFlags) (float64, error) {
 	return f, nil
 }
 
+func (w *wrapper) CoTrans(xpo []float64, eps float64) ([]float64, error) {
+	return coTrans(xpo, eps), nil
+}
+
+func (w *wrapper) CoTransSp(xpo []float64, eps float64) ([]float64, error) {
+	return coTransSp(xpo, eps), nil
+}
+
 func (w *wrapper) SplitDeg(ddeg float64, roundflag int) (ideg int32, imin int32, isec int32, dsecfr float64, isgn int32) {
 	return splitDeg(ddeg, roundflag)
 }
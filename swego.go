@@ -152,6 +152,40 @@ type Interface interface {
 	// Julian Date (in Universal Time) ut with calculation flags fl. Within the C
 	// library swe_deltat is called to convert Universal Time to Ephemeris Time.
 	CalcUT(ut float64, pl Planet, fl *CalcFlags) (xx []float64, cfl int, err error)
+	// CalcPctr computes the position and optionally the speed of planet pl as
+	// seen from planet center at Julian Date (in Ephemeris Time) et with
+	// calculation flags fl. The Moshier ephemeris is not supported.
+	CalcPctr(et float64, pl, center Planet, fl *CalcFlags) (xx []float64, cfl int, err error)
+	// CalcBatch computes the positions of all requests like Calc and CalcUT
+	// and returns the results in the same order. Subsequent requests that
+	// share the same CalcFlags pointer set the library state only once. The
+	// flags must not be modified during the call.
+	CalcBatch(requests []CalcRequest) []CalcResult
+
+	// FixStar computes the position of fixed star star at Julian Date (in
+	// Ephemeris Time) et with calculation flags fl. The star is searched by
+	// traditional name or by Bayer/Flamsteed designation prefixed with a comma,
+	// e.g. ",alTau". The canonical name found in the star catalog is returned as
+	// name, formatted as "traditional name,nomenclature name".
+	FixStar(star string, et float64, fl *CalcFlags) (name string, xx []float64, cfl int, err error)
+	// FixStarUT computes the position of fixed star star at Julian Date (in
+	// Universal Time) ut with calculation flags fl. See FixStar for the format
+	// of star and name.
+	FixStarUT(star string, ut float64, fl *CalcFlags) (name string, xx []float64, cfl int, err error)
+	// FixStarMag returns the visual magnitude of fixed star star. See FixStar
+	// for the format of star and name.
+	FixStarMag(star string) (name string, mag float64, err error)
+	// FixStar2 is equal to FixStar, but faster if many stars are calculated. The
+	// whole star catalog is loaded into memory by the first call.
+	FixStar2(star string, et float64, fl *CalcFlags) (name string, xx []float64, cfl int, err error)
+	// FixStar2UT is equal to FixStarUT, but faster if many stars are
+	// calculated. The whole star catalog is loaded into memory by the first
+	// call.
+	FixStar2UT(star string, ut float64, fl *CalcFlags) (name string, xx []float64, cfl int, err error)
+	// FixStar2Mag is equal to FixStarMag, but faster if many stars are
+	// requested. The whole star catalog is loaded into memory by the first
+	// call.
+	FixStar2Mag(star string) (name string, mag float64, err error)
 
 	// NodAps computes the positions of planetary nodes and apsides (perihelia,
 	// aphelia, second focal points of the orbital ellipses) for planet pl at
@@ -165,6 +199,54 @@ type Interface interface {
 	// Ephemeris Time.
 	NodApsUT(ut float64, pl Planet, fl *CalcFlags, m NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error)
 
+	// GetOrbitalElements computes the osculating orbital elements of planet pl
+	// at Julian Date (in Ephemeris Time) et with calculation flags fl. The
+	// elements are heliocentric, except for the moon, or barycentric if
+	// FlagBary is set.
+	GetOrbitalElements(et float64, pl Planet, fl *CalcFlags) (OrbitalElements, error)
+	// OrbitMaxMinTrueDistance returns the maximum, minimum and true distance
+	// of planet pl from the earth at Julian Date (in Ephemeris Time) et with
+	// calculation flags fl. The distances are heliocentric if FlagHelio is
+	// set and barycentric if FlagBary is set.
+	OrbitMaxMinTrueDistance(et float64, pl Planet, fl *CalcFlags) (dmax, dmin, dtrue float64, err error)
+
+	// SolCross returns the Julian Date (in Ephemeris Time) of the next crossing
+	// of the sun over longitude x2cross after Julian Date (in Ephemeris Time)
+	// et. Calculation flags fl select the coordinate system, for example the
+	// sidereal zodiac or heliocentric positions of the earth.
+	SolCross(x2cross, et float64, fl *CalcFlags) (float64, error)
+	// SolCrossUT returns the Julian Date (in Universal Time) of the next
+	// crossing of the sun over longitude x2cross after Julian Date (in
+	// Universal Time) ut.
+	SolCrossUT(x2cross, ut float64, fl *CalcFlags) (float64, error)
+	// MoonCross returns the Julian Date (in Ephemeris Time) of the next
+	// crossing of the moon over longitude x2cross after Julian Date (in
+	// Ephemeris Time) et.
+	MoonCross(x2cross, et float64, fl *CalcFlags) (float64, error)
+	// MoonCrossUT returns the Julian Date (in Universal Time) of the next
+	// crossing of the moon over longitude x2cross after Julian Date (in
+	// Universal Time) ut.
+	MoonCrossUT(x2cross, ut float64, fl *CalcFlags) (float64, error)
+	// MoonCrossNode returns the Julian Date (in Ephemeris Time) of the next
+	// crossing of the moon over its node after Julian Date (in Ephemeris
+	// Time) et and the longitude and latitude of the moon at that time.
+	MoonCrossNode(et float64, fl *CalcFlags) (t, lng, lat float64, err error)
+	// MoonCrossNodeUT returns the Julian Date (in Universal Time) of the next
+	// crossing of the moon over its node after Julian Date (in Universal Time)
+	// ut and the longitude and latitude of the moon at that time.
+	MoonCrossNodeUT(ut float64, fl *CalcFlags) (t, lng, lat float64, err error)
+	// HelioCross returns the Julian Date (in Ephemeris Time) of the next
+	// crossing of planet pl over heliocentric longitude x2cross after Julian
+	// Date (in Ephemeris Time) et. The previous crossing is returned if
+	// backward is true. It is not possible for the sun, moon, lunar nodes
+	// and apsides.
+	HelioCross(pl Planet, x2cross, et float64, fl *CalcFlags, backward bool) (float64, error)
+	// HelioCrossUT returns the Julian Date (in Universal Time) of the next
+	// crossing of planet pl over heliocentric longitude x2cross after Julian
+	// Date (in Universal Time) ut. The previous crossing is returned if
+	// backward is true.
+	HelioCrossUT(pl Planet, x2cross, ut float64, fl *CalcFlags, backward bool) (float64, error)
+
 	// GetAyanamsaEx returns the ayanamsa for Julian Date (in Ephemeris Time) et.
 	// It is equal to GetAyanamsa but uses the ΔT consistent with the ephemeris
 	// passed in fl.Flags.
@@ -191,6 +273,11 @@ type Interface interface {
 	// JdETToUTC returns the corresponding calendar date for the given Julian
 	// Date in Universal Time and accounts for leap seconds in the conversion.
 	JdUT1ToUTC(ut1 float64, fl *DateConvertFlags) (y, m, d, h, i int, s float64, err error)
+	// UTCTimeZone converts the given date in local time to UTC using the time
+	// zone offset tz in hours, positive east of Greenwich. Pass -tz to convert
+	// from UTC to local time instead. The date is always Gregorian, a leap
+	// second (s >= 60) is preserved.
+	UTCTimeZone(y, m, d, h, i int, s, tz float64) (int, int, int, int, int, float64, error)
 
 	// HousesEx returns the house cusps and related positions for the given
 	// geographic location using the given house system and the provided flags
@@ -202,6 +289,14 @@ type Interface interface {
 	// ARMC (also known as RAMC). The return values may contain data in case of
 	// an error. ARMC, geolat, geolon and eps are in degrees.
 	HousesARMC(armc, geolat, eps float64, hsys HSys) ([]float64, []float64, error)
+	// HousesEx2 is like HousesEx, but also returns the speeds of the house
+	// cusps and related positions. If the library falls back to another house
+	// system, e.g. Porphyry within the polar circles, the houses are returned
+	// with the reason in the Warning field.
+	HousesEx2(ut float64, fl *HousesExFlags, geolat, geolon float64, hsys HSys) (Houses, error)
+	// HousesARMCEx2 is like HousesARMC, but also returns the speeds of the
+	// house cusps and related positions, see HousesEx2.
+	HousesARMCEx2(armc, geolat, eps float64, hsys HSys) (Houses, error)
 	// HousePos returns the house position for the ecliptic longitude and
 	// latitude of a planet for a given ARMC (also known as RAMC) and geocentric
 	// latitude using the given house system. ARMC, geolat, eps, pllng and pllat
@@ -209,9 +304,127 @@ type Interface interface {
 	// Before calling HousePos either Houses, HousesEx or HousesARMC should be
 	// called first.
 	HousePos(armc, geolat, eps float64, hsys HSys, pllng, pllat float64) (float64, error)
+	// GauquelinSector returns the Gauquelin sector position of body at
+	// geographic location loc at Julian Date (in Universal Time) ut. The
+	// position is a number between 1 and 37, sectors are numbered clockwise
+	// starting at the rising point. The atmospheric pressure atpress in mbar
+	// (hPa) and temperature attemp in °C are only used by the methods with
+	// refraction. Calculation flags fl select the ephemeris and, for the
+	// methods GauqLat and GauqNoLat, topocentric positions.
+	GauquelinSector(ut float64, body Body, loc GeoLoc, method GauquelinMethod, atpress, attemp float64, fl *CalcFlags) (float64, error)
 	// HouseName returns the name of the house system.
 	HouseName(hsys HSys) (string, error)
 
+	// SolEclipseWhenGlob searches the next solar eclipse anywhere on earth
+	// after Julian Date (in Universal Time) ut, or before ut if backward is
+	// true. Only eclipses of type typ are searched, typ 0 searches eclipses of
+	// any type. The global contact times of the eclipse are returned.
+	SolEclipseWhenGlob(ut float64, fl *EclipseFlags, typ EclipseType, backward bool) (SolarEclipse, error)
+	// SolEclipseWhenLoc searches the next solar eclipse visible at geographic
+	// location loc after Julian Date (in Universal Time) ut, or before ut if
+	// backward is true. The local contact times, visibility and attributes of
+	// the eclipse are returned.
+	SolEclipseWhenLoc(ut float64, fl *EclipseFlags, loc GeoLoc, backward bool) (SolarEclipse, error)
+	// SolEclipseWhere computes the geographic location of the central line and
+	// the attributes of a solar eclipse at Julian Date (in Universal Time) ut.
+	// For non-central eclipses the location of maximum eclipse is returned.
+	SolEclipseWhere(ut float64, fl *EclipseFlags) (SolarEclipse, error)
+	// SolEclipseHow computes the attributes of a solar eclipse at Julian Date
+	// (in Universal Time) ut for geographic location loc. The returned type is
+	// 0 if there is no eclipse at ut and loc.
+	SolEclipseHow(ut float64, fl *EclipseFlags, loc GeoLoc) (SolarEclipse, error)
+
+	// LunOccultWhenGlob searches the next occultation of body by the moon
+	// anywhere on earth after Julian Date (in Universal Time) ut, or before ut
+	// if backward is true. Only occultations of type typ are searched, typ 0
+	// searches occultations of any type. If typ contains EclOneTry only the
+	// next conjunction of the moon with body is tested; if there is no
+	// occultation the returned type is 0 and Max is a suitable start time for
+	// the next search.
+	LunOccultWhenGlob(ut float64, body Body, fl *EclipseFlags, typ EclipseType, backward bool) (Occultation, error)
+	// LunOccultWhenLoc searches the next occultation of body by the moon
+	// visible at geographic location loc after Julian Date (in Universal Time)
+	// ut, or before ut if backward is true. The local contact times,
+	// visibility, daylight bits and attributes are returned.
+	LunOccultWhenLoc(ut float64, body Body, fl *EclipseFlags, loc GeoLoc, backward bool) (Occultation, error)
+	// LunOccultWhere computes the geographic location of the central path and
+	// the attributes of an occultation of body by the moon at Julian Date (in
+	// Universal Time) ut.
+	LunOccultWhere(ut float64, body Body, fl *EclipseFlags) (Occultation, error)
+
+	// LunEclipseWhen searches the next lunar eclipse after Julian Date (in
+	// Universal Time) ut, or before ut if backward is true. Only eclipses of
+	// type typ are searched, typ 0 searches eclipses of any type.
+	LunEclipseWhen(ut float64, fl *EclipseFlags, typ EclipseType, backward bool) (LunarEclipse, error)
+	// LunEclipseWhenLoc searches the next lunar eclipse visible at geographic
+	// location loc after Julian Date (in Universal Time) ut, or before ut if
+	// backward is true. The contact times are clipped by moonrise and moonset,
+	// the visibility and attributes of the eclipse are returned.
+	LunEclipseWhenLoc(ut float64, fl *EclipseFlags, loc GeoLoc, backward bool) (LunarEclipse, error)
+	// LunEclipseHow computes the attributes of a lunar eclipse at Julian Date
+	// (in Universal Time) ut for geographic location loc. The returned type is
+	// 0 if there is no eclipse at ut.
+	LunEclipseHow(ut float64, fl *EclipseFlags, loc GeoLoc) (LunarEclipse, error)
+
+	// Pheno computes the phase angle, phase, elongation, apparent diameter and
+	// apparent magnitude of planet pl at Julian Date (in Ephemeris Time) et
+	// with calculation flags fl.
+	Pheno(et float64, pl Planet, fl *CalcFlags) (Phenomena, error)
+	// PhenoUT is like Pheno, but takes a Julian Date (in Universal Time) ut.
+	// Within the C library swe_deltat is called to convert Universal Time to
+	// Ephemeris Time.
+	PhenoUT(ut float64, pl Planet, fl *CalcFlags) (Phenomena, error)
+
+	// RiseTrans returns the Julian Date (in Universal Time) of the next rise,
+	// set or meridian transit of body at geographic location loc after Julian
+	// Date (in Universal Time) ut. The event is one of CalcRise, CalcSet,
+	// CalcMTransit and CalcITransit, rise and set may be or'ed with the Bit*
+	// constants. If fl.HorHgt is set swe_rise_trans_true_hor is used. A
+	// *CircumpolarError is returned if the body does not rise or set.
+	RiseTrans(ut float64, body Body, loc GeoLoc, event RiseTransEvent, fl *RiseTransFlags) (float64, error)
+
+	// AzAlt converts the ecliptic (mode Ecl2Hor) or equatorial (mode Equ2Hor)
+	// coordinates lng and lat to horizontal coordinates for Julian Date (in
+	// Universal Time) ut at geographic location loc. The azimuth is measured
+	// from the south point to the west. The apparent altitude is computed with
+	// the atmospheric conditions and lapse rate of fl.
+	AzAlt(ut float64, loc GeoLoc, mode AzAltMode, lng, lat float64, fl *AzAltFlags) (az, trueAlt, appAlt float64, err error)
+	// AzAltRev converts the azimuth az and true altitude alt for Julian Date
+	// (in Universal Time) ut at geographic location loc to ecliptic (mode
+	// Hor2Ecl) or equatorial (mode Hor2Equ) coordinates. The atmospheric
+	// conditions of fl are not used.
+	AzAltRev(ut float64, loc GeoLoc, mode AzAltMode, az, alt float64, fl *AzAltFlags) (lng, lat float64, err error)
+	// Refrac converts the true altitude alt to apparent altitude (mode
+	// TrueToApp) or vice versa (mode AppToTrue). The atmospheric pressure is
+	// in mbar (hPa), the temperature in °C.
+	Refrac(alt, atpress, attemp float64, mode RefracMode) (float64, error)
+	// RefracExtended is like Refrac, but it takes the altitude of the observer
+	// geoalt in meters and the lapse rate in °K/m into account and returns
+	// the dip of the horizon along with the converted altitude.
+	RefracExtended(alt, geoalt, atpress, attemp, lapseRate float64, mode RefracMode) (float64, Refraction, error)
+
+	// HeliacalUT searches the next heliacal event of body at geographic
+	// location loc after Julian Date (in Universal Time) ut. The atmospheric
+	// conditions and the observer are taken from fl.
+	HeliacalUT(ut float64, loc GeoLoc, body Body, event HeliacalEvent, fl *HeliacalFlags) (HeliacalTimes, error)
+	// HeliacalPhenoUT returns the details of a heliacal event of body at
+	// geographic location loc at Julian Date (in Universal Time) ut.
+	HeliacalPhenoUT(ut float64, loc GeoLoc, body Body, event HeliacalEvent, fl *HeliacalFlags) (HeliacalPheno, error)
+	// VisLimitMag returns the limiting visual magnitude for the observation of
+	// body at geographic location loc at Julian Date (in Universal Time) ut.
+	// ErrBelowHorizon is returned if body is below the horizon.
+	VisLimitMag(ut float64, loc GeoLoc, body Body, fl *HeliacalFlags) (VisLimit, error)
+	// HeliacalAngle returns the heliacal angle and the topocentric arcus
+	// visionis for the observation of an object with magnitude mag at
+	// geographic location loc at Julian Date (in Universal Time) ut. sunAlt is
+	// the altitude of the sun, the difference between the other two values.
+	// All angles are in degrees.
+	HeliacalAngle(ut float64, loc GeoLoc, mag, objAz, sunAz, moonAz, moonAlt float64, fl *HeliacalFlags) (angle, arcVis, sunAlt float64, err error)
+	// TopoArcusVisionis returns the topocentric arcus visionis for the
+	// observation of an object with magnitude mag at geographic location loc
+	// at Julian Date (in Universal Time) ut. All angles are in degrees.
+	TopoArcusVisionis(ut float64, loc GeoLoc, mag, objAz, objAlt, sunAz, moonAz, moonAlt float64, fl *HeliacalFlags) (float64, error)
+
 	// DeltaTEx returns the ΔT for the Julian Date jd.
 	DeltaTEx(jd float64, eph Ephemeris) (float64, error)
 
@@ -232,6 +445,14 @@ type Interface interface {
 	// medidian, measured in hours.
 	SidTime(ut float64, fl *SidTimeFlags) (float64, error)
 
+	// CoTrans converts the polar coordinates xpo (longitude, latitude,
+	// distance) between the ecliptic and equatorial coordinate systems, see
+	// the package level CoTrans for the meaning of eps.
+	CoTrans(xpo []float64, eps float64) ([]float64, error)
+	// CoTransSp is like CoTrans, but xpo contains speeds in addition to the
+	// position.
+	CoTransSp(xpo []float64, eps float64) ([]float64, error)
+
 	// SplitDeg takes a decimal degree number as input and provides sign or
 	// nakshatra, degree, minutes, seconds and fraction of second.
 	// Internally it calls swe_split_deg() and it's reference should be used.
@@ -0,0 +1,329 @@
+package swegrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/howesteve/swego"
+	pb "github.com/howesteve/swego/swegrpc/swegopb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Client calls the Ephemeris gRPC service. Its methods have the signatures of
+// the corresponding methods of swego.ContextInterface. Errors reported by the
+// library on the server are returned as swego.Error values, other errors are
+// gRPC status errors.
+type Client struct {
+	c pb.EphemerisClient
+}
+
+// NewClient returns a Client that calls the service via connection cc.
+func NewClient(cc grpc.ClientConnInterface) *Client {
+	return &Client{c: pb.NewEphemerisClient(cc)}
+}
+
+// clientError converts a status error with a LibraryError detail back to a
+// swego.Error.
+func clientError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	for _, d := range st.Details() {
+		if libErr, ok := d.(*pb.LibraryError); ok {
+			return swego.Error(libErr.Msg)
+		}
+	}
+
+	return err
+}
+
+// Version is equal to Version of swego.ContextInterface.
+func (c *Client) Version(ctx context.Context) (string, error) {
+	resp, err := c.c.Version(ctx, &pb.VersionRequest{})
+	if err != nil {
+		return "", clientError(err)
+	}
+
+	return resp.Version, nil
+}
+
+// PlanetName is equal to PlanetName of swego.ContextInterface.
+func (c *Client) PlanetName(ctx context.Context, pl swego.Planet) (string, error) {
+	resp, err := c.c.PlanetName(ctx, &pb.PlanetNameRequest{Planet: int32(pl)})
+	if err != nil {
+		return "", clientError(err)
+	}
+
+	return resp.Name, nil
+}
+
+// Calc is equal to Calc of swego.ContextInterface.
+func (c *Client) Calc(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	resp, err := c.c.Calc(ctx, &pb.CalcRequest{Jd: et, Planet: int32(pl), Flags: calcFlagsToPB(fl)})
+	if err != nil {
+		return nil, -1, clientError(err)
+	}
+
+	return resp.Xx, int(resp.Flags), nil
+}
+
+// CalcUT is equal to CalcUT of swego.ContextInterface.
+func (c *Client) CalcUT(ctx context.Context, ut float64, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	resp, err := c.c.CalcUT(ctx, &pb.CalcRequest{Jd: ut, Planet: int32(pl), Flags: calcFlagsToPB(fl)})
+	if err != nil {
+		return nil, -1, clientError(err)
+	}
+
+	return resp.Xx, int(resp.Flags), nil
+}
+
+// CalcPctr is equal to CalcPctr of swego.ContextInterface.
+func (c *Client) CalcPctr(ctx context.Context, et float64, pl, center swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	resp, err := c.c.CalcPctr(ctx, &pb.CalcPctrRequest{Et: et, Planet: int32(pl), Center: int32(center), Flags: calcFlagsToPB(fl)})
+	if err != nil {
+		return nil, -1, clientError(err)
+	}
+
+	return resp.Xx, int(resp.Flags), nil
+}
+
+// CalcBatch is equal to CalcBatch of swego.ContextInterface. If the call
+// fails, the error is set in all results.
+func (c *Client) CalcBatch(ctx context.Context, requests []swego.CalcRequest) []swego.CalcResult {
+	req := &pb.CalcBatchRequest{Requests: make([]*pb.CalcBatchRequest_Item, len(requests))}
+	for i, r := range requests {
+		req.Requests[i] = &pb.CalcBatchRequest_Item{
+			Jd:     r.JD,
+			Ut:     r.UT,
+			Planet: int32(r.Planet),
+			Flags:  calcFlagsToPB(r.Flags),
+		}
+	}
+
+	results := make([]swego.CalcResult, len(requests))
+	resp, err := c.c.CalcBatch(ctx, req)
+	if err == nil && len(resp.Results) != len(requests) {
+		err = errors.New("swegrpc: invalid number of batch results")
+	}
+
+	if err != nil {
+		err = clientError(err)
+		for i := range results {
+			results[i] = swego.CalcResult{Flags: -1, Err: err}
+		}
+
+		return results
+	}
+
+	for i, r := range resp.Results {
+		results[i] = swego.CalcResult{XX: r.Xx, Flags: int(r.Flags)}
+		if r.Error != "" {
+			results[i].Err = swego.Error(r.Error)
+		}
+	}
+
+	return results
+}
+
+// FixStar2 is equal to FixStar2 of swego.ContextInterface.
+func (c *Client) FixStar2(ctx context.Context, star string, et float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	resp, err := c.c.FixStar2(ctx, &pb.FixStarRequest{Star: star, Jd: et, Flags: calcFlagsToPB(fl)})
+	if err != nil {
+		return "", nil, -1, clientError(err)
+	}
+
+	return resp.Name, resp.Xx, int(resp.Flags), nil
+}
+
+// FixStar2UT is equal to FixStar2UT of swego.ContextInterface.
+func (c *Client) FixStar2UT(ctx context.Context, star string, ut float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	resp, err := c.c.FixStar2UT(ctx, &pb.FixStarRequest{Star: star, Jd: ut, Flags: calcFlagsToPB(fl)})
+	if err != nil {
+		return "", nil, -1, clientError(err)
+	}
+
+	return resp.Name, resp.Xx, int(resp.Flags), nil
+}
+
+// FixStar2Mag is equal to FixStar2Mag of swego.ContextInterface.
+func (c *Client) FixStar2Mag(ctx context.Context, star string) (string, float64, error) {
+	resp, err := c.c.FixStar2Mag(ctx, &pb.FixStarMagRequest{Star: star})
+	if err != nil {
+		return "", 0, clientError(err)
+	}
+
+	return resp.Name, resp.Mag, nil
+}
+
+// HousesEx2 is equal to HousesEx2 of swego.ContextInterface.
+func (c *Client) HousesEx2(ctx context.Context, ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) (swego.Houses, error) {
+	resp, err := c.c.HousesEx2(ctx, &pb.HousesRequest{
+		Ut:     ut,
+		Flags:  housesExFlagsToPB(fl),
+		Geolat: geolat,
+		Geolon: geolon,
+		Hsys:   string(rune(hsys)),
+	})
+
+	if err != nil {
+		return swego.Houses{}, clientError(err)
+	}
+
+	return housesFromPB(resp), nil
+}
+
+// HousesARMCEx2 is equal to HousesARMCEx2 of swego.ContextInterface.
+func (c *Client) HousesARMCEx2(ctx context.Context, armc, geolat, eps float64, hsys swego.HSys) (swego.Houses, error) {
+	resp, err := c.c.HousesARMCEx2(ctx, &pb.HousesARMCRequest{Armc: armc, Geolat: geolat, Eps: eps, Hsys: string(rune(hsys))})
+	if err != nil {
+		return swego.Houses{}, clientError(err)
+	}
+
+	return housesFromPB(resp), nil
+}
+
+// HousePos is equal to HousePos of swego.ContextInterface.
+func (c *Client) HousePos(ctx context.Context, armc, geolat, eps float64, hsys swego.HSys, pllng, pllat float64) (float64, error) {
+	resp, err := c.c.HousePos(ctx, &pb.HousePosRequest{
+		Armc:   armc,
+		Geolat: geolat,
+		Eps:    eps,
+		Hsys:   string(rune(hsys)),
+		Pllng:  pllng,
+		Pllat:  pllat,
+	})
+
+	if err != nil {
+		return 0, clientError(err)
+	}
+
+	return resp.Pos, nil
+}
+
+// HouseName is equal to HouseName of swego.ContextInterface.
+func (c *Client) HouseName(ctx context.Context, hsys swego.HSys) (string, error) {
+	resp, err := c.c.HouseName(ctx, &pb.HouseNameRequest{Hsys: string(rune(hsys))})
+	if err != nil {
+		return "", clientError(err)
+	}
+
+	return resp.Name, nil
+}
+
+// JulDay is equal to JulDay of swego.ContextInterface.
+func (c *Client) JulDay(ctx context.Context, y, m, d int, h float64, ct swego.CalType) (float64, error) {
+	date := &pb.Date{Year: int32(y), Month: int32(m), Day: int32(d), Hour: h}
+	resp, err := c.c.JulDay(ctx, &pb.JulDayRequest{Date: date, Calendar: pb.Calendar(ct)})
+	if err != nil {
+		return 0, clientError(err)
+	}
+
+	return resp.Jd, nil
+}
+
+// RevJul is equal to RevJul of swego.ContextInterface.
+func (c *Client) RevJul(ctx context.Context, jd float64, ct swego.CalType) (int, int, int, float64, error) {
+	resp, err := c.c.RevJul(ctx, &pb.RevJulRequest{Jd: jd, Calendar: pb.Calendar(ct)})
+	if err != nil {
+		return 0, 0, 0, 0, clientError(err)
+	}
+
+	return int(resp.Year), int(resp.Month), int(resp.Day), resp.Hour, nil
+}
+
+// UTCToJD is equal to UTCToJD of swego.ContextInterface.
+func (c *Client) UTCToJD(ctx context.Context, y, m, d, h, i int, s float64, fl *swego.DateConvertFlags) (float64, float64, error) {
+	resp, err := c.c.UTCToJD(ctx, &pb.UTCToJDRequest{Utc: dateTimeToPB(y, m, d, h, i, s), Flags: dateConvertFlagsToPB(fl)})
+	if err != nil {
+		return 0, 0, clientError(err)
+	}
+
+	return resp.Et, resp.Ut, nil
+}
+
+// JdETToUTC is equal to JdETToUTC of swego.ContextInterface.
+func (c *Client) JdETToUTC(ctx context.Context, et float64, fl *swego.DateConvertFlags) (int, int, int, int, int, float64, error) {
+	resp, err := c.c.JdETToUTC(ctx, &pb.JdToUTCRequest{Jd: et, Flags: dateConvertFlagsToPB(fl)})
+	if err != nil {
+		return 0, 0, 0, 0, 0, 0, clientError(err)
+	}
+
+	y, m, d, h, i, s := dateTimeFromPB(resp)
+	return y, m, d, h, i, s, nil
+}
+
+// JdUT1ToUTC is equal to JdUT1ToUTC of swego.ContextInterface.
+func (c *Client) JdUT1ToUTC(ctx context.Context, ut1 float64, fl *swego.DateConvertFlags) (int, int, int, int, int, float64, error) {
+	resp, err := c.c.JdUT1ToUTC(ctx, &pb.JdToUTCRequest{Jd: ut1, Flags: dateConvertFlagsToPB(fl)})
+	if err != nil {
+		return 0, 0, 0, 0, 0, 0, clientError(err)
+	}
+
+	y, m, d, h, i, s := dateTimeFromPB(resp)
+	return y, m, d, h, i, s, nil
+}
+
+// UTCTimeZone is equal to UTCTimeZone of swego.ContextInterface.
+func (c *Client) UTCTimeZone(ctx context.Context, y, m, d, h, i int, s, tz float64) (int, int, int, int, int, float64, error) {
+	resp, err := c.c.UTCTimeZone(ctx, &pb.UTCTimeZoneRequest{Date: dateTimeToPB(y, m, d, h, i, s), Tz: tz})
+	if err != nil {
+		return 0, 0, 0, 0, 0, 0, clientError(err)
+	}
+
+	y, m, d, h, i, s = dateTimeFromPB(resp)
+	return y, m, d, h, i, s, nil
+}
+
+// DeltaTEx is equal to DeltaTEx of swego.ContextInterface.
+func (c *Client) DeltaTEx(ctx context.Context, jd float64, eph swego.Ephemeris) (float64, error) {
+	resp, err := c.c.DeltaTEx(ctx, &pb.DeltaTRequest{Jd: jd, Ephemeris: int32(eph)})
+	if err != nil {
+		return 0, clientError(err)
+	}
+
+	return resp.DeltaT, nil
+}
+
+// SidTime is equal to SidTime of swego.ContextInterface.
+func (c *Client) SidTime(ctx context.Context, ut float64, fl *swego.SidTimeFlags) (float64, error) {
+	resp, err := c.c.SidTime(ctx, &pb.SidTimeRequest{Ut: ut, Flags: sidTimeFlagsToPB(fl)})
+	if err != nil {
+		return 0, clientError(err)
+	}
+
+	return resp.SidTime, nil
+}
+
+// GetAyanamsaEx is equal to GetAyanamsaEx of swego.ContextInterface.
+func (c *Client) GetAyanamsaEx(ctx context.Context, et float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	resp, err := c.c.GetAyanamsaEx(ctx, &pb.AyanamsaRequest{Jd: et, Flags: ayanamsaExFlagsToPB(fl)})
+	if err != nil {
+		return 0, clientError(err)
+	}
+
+	return resp.Ayanamsa, nil
+}
+
+// GetAyanamsaExUT is equal to GetAyanamsaExUT of swego.ContextInterface.
+func (c *Client) GetAyanamsaExUT(ctx context.Context, ut float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	resp, err := c.c.GetAyanamsaExUT(ctx, &pb.AyanamsaRequest{Jd: ut, Flags: ayanamsaExFlagsToPB(fl)})
+	if err != nil {
+		return 0, clientError(err)
+	}
+
+	return resp.Ayanamsa, nil
+}
+
+// GetAyanamsaName is equal to GetAyanamsaName of swego.ContextInterface.
+func (c *Client) GetAyanamsaName(ctx context.Context, ayan swego.Ayanamsa) (string, error) {
+	resp, err := c.c.GetAyanamsaName(ctx, &pb.AyanamsaNameRequest{Ayanamsa: int32(ayan)})
+	if err != nil {
+		return "", clientError(err)
+	}
+
+	return resp.Name, nil
+}
@@ -0,0 +1,197 @@
+package swegrpc
+
+import (
+	"github.com/howesteve/swego"
+	pb "github.com/howesteve/swego/swegrpc/swegopb"
+)
+
+// The functions in this file convert between the types of package swego and
+// the protobuf messages. A nil message is converted to zero flags, as not all
+// implementations of swego.Interface accept nil flags.
+
+func geoLocFromPB(m *pb.GeoLoc) *swego.GeoLoc {
+	if m == nil {
+		return nil
+	}
+
+	return &swego.GeoLoc{Long: m.Long, Lat: m.Lat, Alt: m.Alt}
+}
+
+func geoLocToPB(loc *swego.GeoLoc) *pb.GeoLoc {
+	if loc == nil {
+		return nil
+	}
+
+	return &pb.GeoLoc{Long: loc.Long, Lat: loc.Lat, Alt: loc.Alt}
+}
+
+func sidModeFromPB(m *pb.SidMode) *swego.SidMode {
+	if m == nil {
+		return nil
+	}
+
+	return &swego.SidMode{Mode: swego.Ayanamsa(m.Mode), T0: m.T0, AyanT0: m.AyanT0}
+}
+
+func sidModeToPB(sm *swego.SidMode) *pb.SidMode {
+	if sm == nil {
+		return nil
+	}
+
+	return &pb.SidMode{Mode: int32(sm.Mode), T0: sm.T0, AyanT0: sm.AyanT0}
+}
+
+func calcFlagsFromPB(m *pb.CalcFlags) *swego.CalcFlags {
+	if m == nil {
+		return new(swego.CalcFlags)
+	}
+
+	return &swego.CalcFlags{
+		Flags:   m.Flags,
+		TopoLoc: geoLocFromPB(m.TopoLoc),
+		SidMode: sidModeFromPB(m.SidMode),
+		JPLFile: m.JplFile,
+		DeltaT:  m.DeltaT,
+	}
+}
+
+func calcFlagsToPB(fl *swego.CalcFlags) *pb.CalcFlags {
+	if fl == nil {
+		return nil
+	}
+
+	return &pb.CalcFlags{
+		Flags:   fl.Flags,
+		TopoLoc: geoLocToPB(fl.TopoLoc),
+		SidMode: sidModeToPB(fl.SidMode),
+		JplFile: fl.JPLFile,
+		DeltaT:  fl.DeltaT,
+	}
+}
+
+func ayanamsaExFlagsFromPB(m *pb.AyanamsaExFlags) *swego.AyanamsaExFlags {
+	if m == nil {
+		return new(swego.AyanamsaExFlags)
+	}
+
+	return &swego.AyanamsaExFlags{Flags: m.Flags, SidMode: sidModeFromPB(m.SidMode), DeltaT: m.DeltaT}
+}
+
+func ayanamsaExFlagsToPB(fl *swego.AyanamsaExFlags) *pb.AyanamsaExFlags {
+	if fl == nil {
+		return nil
+	}
+
+	return &pb.AyanamsaExFlags{Flags: fl.Flags, SidMode: sidModeToPB(fl.SidMode), DeltaT: fl.DeltaT}
+}
+
+func dateConvertFlagsFromPB(m *pb.DateConvertFlags) *swego.DateConvertFlags {
+	if m == nil {
+		return new(swego.DateConvertFlags)
+	}
+
+	return &swego.DateConvertFlags{Calendar: swego.CalType(m.Calendar), DeltaT: m.DeltaT}
+}
+
+func dateConvertFlagsToPB(fl *swego.DateConvertFlags) *pb.DateConvertFlags {
+	if fl == nil {
+		return nil
+	}
+
+	return &pb.DateConvertFlags{Calendar: pb.Calendar(fl.Calendar), DeltaT: fl.DeltaT}
+}
+
+func housesExFlagsFromPB(m *pb.HousesExFlags) *swego.HousesExFlags {
+	if m == nil {
+		return new(swego.HousesExFlags)
+	}
+
+	return &swego.HousesExFlags{Flags: m.Flags, SidMode: sidModeFromPB(m.SidMode), DeltaT: m.DeltaT}
+}
+
+func housesExFlagsToPB(fl *swego.HousesExFlags) *pb.HousesExFlags {
+	if fl == nil {
+		return nil
+	}
+
+	return &pb.HousesExFlags{Flags: fl.Flags, SidMode: sidModeToPB(fl.SidMode), DeltaT: fl.DeltaT}
+}
+
+func sidTimeFlagsFromPB(m *pb.SidTimeFlags) *swego.SidTimeFlags {
+	if m == nil {
+		return new(swego.SidTimeFlags)
+	}
+
+	return &swego.SidTimeFlags{DeltaT: m.DeltaT}
+}
+
+func sidTimeFlagsToPB(fl *swego.SidTimeFlags) *pb.SidTimeFlags {
+	if fl == nil {
+		return nil
+	}
+
+	return &pb.SidTimeFlags{DeltaT: fl.DeltaT}
+}
+
+func housesToPB(h swego.Houses) *pb.Houses {
+	return &pb.Houses{
+		Cusps:       h.Cusps,
+		CuspSpeeds:  h.CuspSpeeds,
+		Asc:         h.Asc,
+		Mc:          h.MC,
+		Armc:        h.ARMC,
+		Vertex:      h.Vertex,
+		EquAsc:      h.EquAsc,
+		CoAsc1:      h.CoAsc1,
+		CoAsc2:      h.CoAsc2,
+		PolAsc:      h.PolAsc,
+		AscSpeed:    h.AscSpeed,
+		McSpeed:     h.MCSpeed,
+		ArmcSpeed:   h.ARMCSpeed,
+		VertexSpeed: h.VertexSpeed,
+		EquAscSpeed: h.EquAscSpeed,
+		CoAsc1Speed: h.CoAsc1Speed,
+		CoAsc2Speed: h.CoAsc2Speed,
+		PolAscSpeed: h.PolAscSpeed,
+		Warning:     h.Warning,
+	}
+}
+
+func housesFromPB(m *pb.Houses) swego.Houses {
+	return swego.Houses{
+		Cusps:       m.Cusps,
+		CuspSpeeds:  m.CuspSpeeds,
+		Asc:         m.Asc,
+		MC:          m.Mc,
+		ARMC:        m.Armc,
+		Vertex:      m.Vertex,
+		EquAsc:      m.EquAsc,
+		CoAsc1:      m.CoAsc1,
+		CoAsc2:      m.CoAsc2,
+		PolAsc:      m.PolAsc,
+		AscSpeed:    m.AscSpeed,
+		MCSpeed:     m.McSpeed,
+		ARMCSpeed:   m.ArmcSpeed,
+		VertexSpeed: m.VertexSpeed,
+		EquAscSpeed: m.EquAscSpeed,
+		CoAsc1Speed: m.CoAsc1Speed,
+		CoAsc2Speed: m.CoAsc2Speed,
+		PolAscSpeed: m.PolAscSpeed,
+		Warning:     m.Warning,
+	}
+}
+
+func dateTimeToPB(y, m, d, h, i int, s float64) *pb.DateTime {
+	return &pb.DateTime{
+		Year:   int32(y),
+		Month:  int32(m),
+		Day:    int32(d),
+		Hour:   int32(h),
+		Minute: int32(i),
+		Second: s,
+	}
+}
+
+func dateTimeFromPB(m *pb.DateTime) (y, mo, d, h, i int, s float64) {
+	return int(m.GetYear()), int(m.GetMonth()), int(m.GetDay()), int(m.GetHour()), int(m.GetMinute()), m.GetSecond()
+}
@@ -0,0 +1,347 @@
+// Package swegrpc serves swego.Interface via gRPC and implements a client for
+// the service. The protobuf schema is defined in package swegopb.
+package swegrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/howesteve/swego"
+	pb "github.com/howesteve/swego/swegrpc/swegopb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements the Ephemeris gRPC service on top of a swego.Interface,
+// e.g. swecgo or a swerker.Client.
+type Server struct {
+	pb.UnimplementedEphemerisServer
+	swe swego.Interface
+}
+
+var _ pb.EphemerisServer = (*Server)(nil) // assert interface
+
+// NewServer returns a Server that calls swe.
+func NewServer(swe swego.Interface) *Server {
+	return &Server{swe: swe}
+}
+
+// Register registers a Server that calls swe with gRPC server s.
+func Register(s *grpc.Server, swe swego.Interface) {
+	pb.RegisterEphemerisServer(s, NewServer(swe))
+}
+
+// statusError converts err to a gRPC status error. A swego.Error is returned
+// with code InvalidArgument and the message as LibraryError detail.
+func statusError(err error) error {
+	var libErr swego.Error
+	if !errors.As(err, &libErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	st, detailErr := status.New(codes.InvalidArgument, libErr.Error()).
+		WithDetails(&pb.LibraryError{Msg: string(libErr)})
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, libErr.Error())
+	}
+
+	return st.Err()
+}
+
+func hsysFromPB(s string) (swego.HSys, error) {
+	if len(s) == 1 {
+		if hsys, ok := swego.NewHSys(s[0]); ok {
+			return hsys, nil
+		}
+	}
+
+	return 0, status.Errorf(codes.InvalidArgument, "invalid house system %q", s)
+}
+
+// Version implements swegopb.EphemerisServer.
+func (s *Server) Version(ctx context.Context, req *pb.VersionRequest) (*pb.VersionResponse, error) {
+	v, err := s.swe.Version()
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.VersionResponse{Version: v}, nil
+}
+
+// PlanetName implements swegopb.EphemerisServer.
+func (s *Server) PlanetName(ctx context.Context, req *pb.PlanetNameRequest) (*pb.NameResponse, error) {
+	name, err := s.swe.PlanetName(swego.Planet(req.Planet))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.NameResponse{Name: name}, nil
+}
+
+// Calc implements swegopb.EphemerisServer.
+func (s *Server) Calc(ctx context.Context, req *pb.CalcRequest) (*pb.CalcResponse, error) {
+	xx, cfl, err := s.swe.Calc(req.Jd, swego.Planet(req.Planet), calcFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.CalcResponse{Xx: xx, Flags: int32(cfl)}, nil
+}
+
+// CalcUT implements swegopb.EphemerisServer.
+func (s *Server) CalcUT(ctx context.Context, req *pb.CalcRequest) (*pb.CalcResponse, error) {
+	xx, cfl, err := s.swe.CalcUT(req.Jd, swego.Planet(req.Planet), calcFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.CalcResponse{Xx: xx, Flags: int32(cfl)}, nil
+}
+
+// CalcPctr implements swegopb.EphemerisServer.
+func (s *Server) CalcPctr(ctx context.Context, req *pb.CalcPctrRequest) (*pb.CalcResponse, error) {
+	xx, cfl, err := s.swe.CalcPctr(req.Et, swego.Planet(req.Planet), swego.Planet(req.Center), calcFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.CalcResponse{Xx: xx, Flags: int32(cfl)}, nil
+}
+
+// CalcBatch implements swegopb.EphemerisServer.
+func (s *Server) CalcBatch(ctx context.Context, req *pb.CalcBatchRequest) (*pb.CalcBatchResponse, error) {
+	requests := make([]swego.CalcRequest, len(req.Requests))
+	for i, r := range req.Requests {
+		requests[i] = swego.CalcRequest{
+			JD:     r.Jd,
+			UT:     r.Ut,
+			Planet: swego.Planet(r.Planet),
+			Flags:  calcFlagsFromPB(r.Flags),
+		}
+	}
+
+	results := s.swe.CalcBatch(requests)
+	resp := &pb.CalcBatchResponse{Results: make([]*pb.CalcBatchResponse_Result, len(results))}
+	for i, r := range results {
+		resp.Results[i] = &pb.CalcBatchResponse_Result{Xx: r.XX, Flags: int32(r.Flags)}
+		if r.Err == nil {
+			continue
+		}
+
+		// Only library errors are returned per result, other errors fail
+		// the call.
+		var libErr swego.Error
+		if !errors.As(r.Err, &libErr) {
+			return nil, statusError(r.Err)
+		}
+
+		resp.Results[i].Error = string(libErr)
+	}
+
+	return resp, nil
+}
+
+// FixStar2 implements swegopb.EphemerisServer.
+func (s *Server) FixStar2(ctx context.Context, req *pb.FixStarRequest) (*pb.FixStarResponse, error) {
+	name, xx, cfl, err := s.swe.FixStar2(req.Star, req.Jd, calcFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.FixStarResponse{Name: name, Xx: xx, Flags: int32(cfl)}, nil
+}
+
+// FixStar2UT implements swegopb.EphemerisServer.
+func (s *Server) FixStar2UT(ctx context.Context, req *pb.FixStarRequest) (*pb.FixStarResponse, error) {
+	name, xx, cfl, err := s.swe.FixStar2UT(req.Star, req.Jd, calcFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.FixStarResponse{Name: name, Xx: xx, Flags: int32(cfl)}, nil
+}
+
+// FixStar2Mag implements swegopb.EphemerisServer.
+func (s *Server) FixStar2Mag(ctx context.Context, req *pb.FixStarMagRequest) (*pb.FixStarMagResponse, error) {
+	name, mag, err := s.swe.FixStar2Mag(req.Star)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.FixStarMagResponse{Name: name, Mag: mag}, nil
+}
+
+// HousesEx2 implements swegopb.EphemerisServer.
+func (s *Server) HousesEx2(ctx context.Context, req *pb.HousesRequest) (*pb.Houses, error) {
+	hsys, err := hsysFromPB(req.Hsys)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := s.swe.HousesEx2(req.Ut, housesExFlagsFromPB(req.Flags), req.Geolat, req.Geolon, hsys)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return housesToPB(h), nil
+}
+
+// HousesARMCEx2 implements swegopb.EphemerisServer.
+func (s *Server) HousesARMCEx2(ctx context.Context, req *pb.HousesARMCRequest) (*pb.Houses, error) {
+	hsys, err := hsysFromPB(req.Hsys)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := s.swe.HousesARMCEx2(req.Armc, req.Geolat, req.Eps, hsys)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return housesToPB(h), nil
+}
+
+// HousePos implements swegopb.EphemerisServer.
+func (s *Server) HousePos(ctx context.Context, req *pb.HousePosRequest) (*pb.HousePosResponse, error) {
+	hsys, err := hsysFromPB(req.Hsys)
+	if err != nil {
+		return nil, err
+	}
+
+	pos, err := s.swe.HousePos(req.Armc, req.Geolat, req.Eps, hsys, req.Pllng, req.Pllat)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.HousePosResponse{Pos: pos}, nil
+}
+
+// HouseName implements swegopb.EphemerisServer.
+func (s *Server) HouseName(ctx context.Context, req *pb.HouseNameRequest) (*pb.NameResponse, error) {
+	hsys, err := hsysFromPB(req.Hsys)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := s.swe.HouseName(hsys)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.NameResponse{Name: name}, nil
+}
+
+// JulDay implements swegopb.EphemerisServer.
+func (s *Server) JulDay(ctx context.Context, req *pb.JulDayRequest) (*pb.JulDayResponse, error) {
+	d := req.Date
+	jd, err := s.swe.JulDay(int(d.GetYear()), int(d.GetMonth()), int(d.GetDay()), d.GetHour(), swego.CalType(req.Calendar))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.JulDayResponse{Jd: jd}, nil
+}
+
+// RevJul implements swegopb.EphemerisServer.
+func (s *Server) RevJul(ctx context.Context, req *pb.RevJulRequest) (*pb.Date, error) {
+	y, m, d, h, err := s.swe.RevJul(req.Jd, swego.CalType(req.Calendar))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.Date{Year: int32(y), Month: int32(m), Day: int32(d), Hour: h}, nil
+}
+
+// UTCToJD implements swegopb.EphemerisServer.
+func (s *Server) UTCToJD(ctx context.Context, req *pb.UTCToJDRequest) (*pb.UTCToJDResponse, error) {
+	y, m, d, h, i, sec := dateTimeFromPB(req.Utc)
+	et, ut, err := s.swe.UTCToJD(y, m, d, h, i, sec, dateConvertFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.UTCToJDResponse{Et: et, Ut: ut}, nil
+}
+
+// JdETToUTC implements swegopb.EphemerisServer.
+func (s *Server) JdETToUTC(ctx context.Context, req *pb.JdToUTCRequest) (*pb.DateTime, error) {
+	y, m, d, h, i, sec, err := s.swe.JdETToUTC(req.Jd, dateConvertFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return dateTimeToPB(y, m, d, h, i, sec), nil
+}
+
+// JdUT1ToUTC implements swegopb.EphemerisServer.
+func (s *Server) JdUT1ToUTC(ctx context.Context, req *pb.JdToUTCRequest) (*pb.DateTime, error) {
+	y, m, d, h, i, sec, err := s.swe.JdUT1ToUTC(req.Jd, dateConvertFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return dateTimeToPB(y, m, d, h, i, sec), nil
+}
+
+// UTCTimeZone implements swegopb.EphemerisServer.
+func (s *Server) UTCTimeZone(ctx context.Context, req *pb.UTCTimeZoneRequest) (*pb.DateTime, error) {
+	y, m, d, h, i, sec := dateTimeFromPB(req.Date)
+	y, m, d, h, i, sec, err := s.swe.UTCTimeZone(y, m, d, h, i, sec, req.Tz)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return dateTimeToPB(y, m, d, h, i, sec), nil
+}
+
+// DeltaTEx implements swegopb.EphemerisServer.
+func (s *Server) DeltaTEx(ctx context.Context, req *pb.DeltaTRequest) (*pb.DeltaTResponse, error) {
+	dt, err := s.swe.DeltaTEx(req.Jd, swego.Ephemeris(req.Ephemeris))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.DeltaTResponse{DeltaT: dt}, nil
+}
+
+// SidTime implements swegopb.EphemerisServer.
+func (s *Server) SidTime(ctx context.Context, req *pb.SidTimeRequest) (*pb.SidTimeResponse, error) {
+	st, err := s.swe.SidTime(req.Ut, sidTimeFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.SidTimeResponse{SidTime: st}, nil
+}
+
+// GetAyanamsaEx implements swegopb.EphemerisServer.
+func (s *Server) GetAyanamsaEx(ctx context.Context, req *pb.AyanamsaRequest) (*pb.AyanamsaResponse, error) {
+	ayan, err := s.swe.GetAyanamsaEx(req.Jd, ayanamsaExFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.AyanamsaResponse{Ayanamsa: ayan}, nil
+}
+
+// GetAyanamsaExUT implements swegopb.EphemerisServer.
+func (s *Server) GetAyanamsaExUT(ctx context.Context, req *pb.AyanamsaRequest) (*pb.AyanamsaResponse, error) {
+	ayan, err := s.swe.GetAyanamsaExUT(req.Jd, ayanamsaExFlagsFromPB(req.Flags))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.AyanamsaResponse{Ayanamsa: ayan}, nil
+}
+
+// GetAyanamsaName implements swegopb.EphemerisServer.
+func (s *Server) GetAyanamsaName(ctx context.Context, req *pb.AyanamsaNameRequest) (*pb.NameResponse, error) {
+	name, err := s.swe.GetAyanamsaName(swego.Ayanamsa(req.Ayanamsa))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.NameResponse{Name: name}, nil
+}
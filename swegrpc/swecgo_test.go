@@ -0,0 +1,74 @@
+//go:build (linux && cgo) || (darwin && cgo)
+// +build linux,cgo darwin,cgo
+
+package swegrpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/howesteve/swego"
+	"github.com/howesteve/swego/swecgo"
+)
+
+func values(v ...interface{}) []interface{} { return v }
+
+// TestClient_swecgo compares the results of swecgo called via gRPC with the
+// results of swecgo called directly.
+func TestClient_swecgo(t *testing.T) {
+	swe := swecgo.Open()
+	c := newTestClient(t, swe)
+	ctx := context.Background()
+
+	const jd = 2451545
+	calcFl := &swego.CalcFlags{Flags: swego.FlagEphMoshier | swego.FlagSpeed}
+	sidFl := &swego.AyanamsaExFlags{Flags: swego.FlagEphMoshier, SidMode: &swego.SidMode{Mode: swego.SidmLahiri}}
+	dateFl := &swego.DateConvertFlags{Calendar: swego.Gregorian}
+
+	cases := []struct {
+		name string
+		got  func() []interface{}
+		want func() []interface{}
+	}{
+		{"Version", func() []interface{} { return values(c.Version(ctx)) }, func() []interface{} { return values(swe.Version()) }},
+		{"CalcUT",
+			func() []interface{} { return values(c.CalcUT(ctx, jd, swego.Moon, calcFl)) },
+			func() []interface{} { return values(swe.CalcUT(jd, swego.Moon, calcFl)) }},
+		{"CalcUT_error",
+			func() []interface{} { _, _, err := c.CalcUT(ctx, jd, -5, calcFl); return values(err) },
+			func() []interface{} { _, _, err := swe.CalcUT(jd, -5, calcFl); return values(err) }},
+		{"HousesEx2",
+			func() []interface{} { return values(c.HousesEx2(ctx, jd, nil, 52.083333, 5.116667, swego.Placidus)) },
+			func() []interface{} { return values(swe.HousesEx2(jd, nil, 52.083333, 5.116667, swego.Placidus)) }},
+		{"HouseName",
+			func() []interface{} { return values(c.HouseName(ctx, swego.Koch)) },
+			func() []interface{} { return values(swe.HouseName(swego.Koch)) }},
+		{"JulDay",
+			func() []interface{} { return values(c.JulDay(ctx, 2000, 1, 1, 12, swego.Gregorian)) },
+			func() []interface{} { return values(swe.JulDay(2000, 1, 1, 12, swego.Gregorian)) }},
+		{"RevJul",
+			func() []interface{} { return values(c.RevJul(ctx, jd, swego.Julian)) },
+			func() []interface{} { return values(swe.RevJul(jd, swego.Julian)) }},
+		{"UTCToJD",
+			func() []interface{} { return values(c.UTCToJD(ctx, 2000, 1, 1, 12, 0, 0, dateFl)) },
+			func() []interface{} { return values(swe.UTCToJD(2000, 1, 1, 12, 0, 0, dateFl)) }},
+		{"JdETToUTC",
+			func() []interface{} { return values(c.JdETToUTC(ctx, jd, dateFl)) },
+			func() []interface{} { return values(swe.JdETToUTC(jd, dateFl)) }},
+		{"GetAyanamsaExUT",
+			func() []interface{} { return values(c.GetAyanamsaExUT(ctx, jd, sidFl)) },
+			func() []interface{} { return values(swe.GetAyanamsaExUT(jd, sidFl)) }},
+		{"GetAyanamsaName",
+			func() []interface{} { return values(c.GetAyanamsaName(ctx, swego.SidmLahiri)) },
+			func() []interface{} { return values(swe.GetAyanamsaName(swego.SidmLahiri)) }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := tc.got(), tc.want(); !reflect.DeepEqual(got, want) {
+				t.Errorf("swegrpc = %v, want: %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Package swegopb contains the protobuf messages and the gRPC service
+// definition of the Swiss Ephemeris generated from swego.proto.
+package swegopb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative swego.proto
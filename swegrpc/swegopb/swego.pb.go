@@ -0,0 +1,3847 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: swego.proto
+
+package swegopb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Calendar int32
+
+const (
+	Calendar_CALENDAR_JULIAN    Calendar = 0
+	Calendar_CALENDAR_GREGORIAN Calendar = 1
+)
+
+// Enum value maps for Calendar.
+var (
+	Calendar_name = map[int32]string{
+		0: "CALENDAR_JULIAN",
+		1: "CALENDAR_GREGORIAN",
+	}
+	Calendar_value = map[string]int32{
+		"CALENDAR_JULIAN":    0,
+		"CALENDAR_GREGORIAN": 1,
+	}
+)
+
+func (x Calendar) Enum() *Calendar {
+	p := new(Calendar)
+	*p = x
+	return p
+}
+
+func (x Calendar) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Calendar) Descriptor() protoreflect.EnumDescriptor {
+	return file_swego_proto_enumTypes[0].Descriptor()
+}
+
+func (Calendar) Type() protoreflect.EnumType {
+	return &file_swego_proto_enumTypes[0]
+}
+
+func (x Calendar) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Calendar.Descriptor instead.
+func (Calendar) EnumDescriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{0}
+}
+
+// LibraryError is attached to the status of a call that failed because the
+// Swiss Ephemeris reported an error.
+type LibraryError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *LibraryError) Reset() {
+	*x = LibraryError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryError) ProtoMessage() {}
+
+func (x *LibraryError) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryError.ProtoReflect.Descriptor instead.
+func (*LibraryError) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LibraryError) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type GeoLoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Long float64 `protobuf:"fixed64,1,opt,name=long,proto3" json:"long,omitempty"`
+	Lat  float64 `protobuf:"fixed64,2,opt,name=lat,proto3" json:"lat,omitempty"`
+	Alt  float64 `protobuf:"fixed64,3,opt,name=alt,proto3" json:"alt,omitempty"`
+}
+
+func (x *GeoLoc) Reset() {
+	*x = GeoLoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoLoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoLoc) ProtoMessage() {}
+
+func (x *GeoLoc) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoLoc.ProtoReflect.Descriptor instead.
+func (*GeoLoc) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeoLoc) GetLong() float64 {
+	if x != nil {
+		return x.Long
+	}
+	return 0
+}
+
+func (x *GeoLoc) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GeoLoc) GetAlt() float64 {
+	if x != nil {
+		return x.Alt
+	}
+	return 0
+}
+
+type SidMode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode   int32   `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	T0     float64 `protobuf:"fixed64,2,opt,name=t0,proto3" json:"t0,omitempty"`
+	AyanT0 float64 `protobuf:"fixed64,3,opt,name=ayan_t0,json=ayanT0,proto3" json:"ayan_t0,omitempty"`
+}
+
+func (x *SidMode) Reset() {
+	*x = SidMode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidMode) ProtoMessage() {}
+
+func (x *SidMode) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidMode.ProtoReflect.Descriptor instead.
+func (*SidMode) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SidMode) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *SidMode) GetT0() float64 {
+	if x != nil {
+		return x.T0
+	}
+	return 0
+}
+
+func (x *SidMode) GetAyanT0() float64 {
+	if x != nil {
+		return x.AyanT0
+	}
+	return 0
+}
+
+// CalcFlags represents the library state of swe_calc and swe_calc_ut.
+type CalcFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags   int32    `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	TopoLoc *GeoLoc  `protobuf:"bytes,2,opt,name=topo_loc,json=topoLoc,proto3" json:"topo_loc,omitempty"`      // arguments to swe_set_topo
+	SidMode *SidMode `protobuf:"bytes,3,opt,name=sid_mode,json=sidMode,proto3" json:"sid_mode,omitempty"`      // arguments to swe_set_sid_mode
+	JplFile string   `protobuf:"bytes,4,opt,name=jpl_file,json=jplFile,proto3" json:"jpl_file,omitempty"`      // argument to swe_set_jpl_file
+	DeltaT  *float64 `protobuf:"fixed64,5,opt,name=delta_t,json=deltaT,proto3,oneof" json:"delta_t,omitempty"` // argument to swe_set_delta_t_userdef, unset resets it
+}
+
+func (x *CalcFlags) Reset() {
+	*x = CalcFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcFlags) ProtoMessage() {}
+
+func (x *CalcFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcFlags.ProtoReflect.Descriptor instead.
+func (*CalcFlags) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CalcFlags) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *CalcFlags) GetTopoLoc() *GeoLoc {
+	if x != nil {
+		return x.TopoLoc
+	}
+	return nil
+}
+
+func (x *CalcFlags) GetSidMode() *SidMode {
+	if x != nil {
+		return x.SidMode
+	}
+	return nil
+}
+
+func (x *CalcFlags) GetJplFile() string {
+	if x != nil {
+		return x.JplFile
+	}
+	return ""
+}
+
+func (x *CalcFlags) GetDeltaT() float64 {
+	if x != nil && x.DeltaT != nil {
+		return *x.DeltaT
+	}
+	return 0
+}
+
+type AyanamsaExFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags   int32    `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SidMode *SidMode `protobuf:"bytes,2,opt,name=sid_mode,json=sidMode,proto3" json:"sid_mode,omitempty"`
+	DeltaT  *float64 `protobuf:"fixed64,3,opt,name=delta_t,json=deltaT,proto3,oneof" json:"delta_t,omitempty"`
+}
+
+func (x *AyanamsaExFlags) Reset() {
+	*x = AyanamsaExFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AyanamsaExFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AyanamsaExFlags) ProtoMessage() {}
+
+func (x *AyanamsaExFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AyanamsaExFlags.ProtoReflect.Descriptor instead.
+func (*AyanamsaExFlags) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AyanamsaExFlags) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *AyanamsaExFlags) GetSidMode() *SidMode {
+	if x != nil {
+		return x.SidMode
+	}
+	return nil
+}
+
+func (x *AyanamsaExFlags) GetDeltaT() float64 {
+	if x != nil && x.DeltaT != nil {
+		return *x.DeltaT
+	}
+	return 0
+}
+
+type DateConvertFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calendar Calendar `protobuf:"varint,1,opt,name=calendar,proto3,enum=swego.Calendar" json:"calendar,omitempty"`
+	DeltaT   *float64 `protobuf:"fixed64,2,opt,name=delta_t,json=deltaT,proto3,oneof" json:"delta_t,omitempty"`
+}
+
+func (x *DateConvertFlags) Reset() {
+	*x = DateConvertFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateConvertFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateConvertFlags) ProtoMessage() {}
+
+func (x *DateConvertFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateConvertFlags.ProtoReflect.Descriptor instead.
+func (*DateConvertFlags) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DateConvertFlags) GetCalendar() Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return Calendar_CALENDAR_JULIAN
+}
+
+func (x *DateConvertFlags) GetDeltaT() float64 {
+	if x != nil && x.DeltaT != nil {
+		return *x.DeltaT
+	}
+	return 0
+}
+
+type HousesExFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags   int32    `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	SidMode *SidMode `protobuf:"bytes,2,opt,name=sid_mode,json=sidMode,proto3" json:"sid_mode,omitempty"`
+	DeltaT  *float64 `protobuf:"fixed64,3,opt,name=delta_t,json=deltaT,proto3,oneof" json:"delta_t,omitempty"`
+}
+
+func (x *HousesExFlags) Reset() {
+	*x = HousesExFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HousesExFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesExFlags) ProtoMessage() {}
+
+func (x *HousesExFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesExFlags.ProtoReflect.Descriptor instead.
+func (*HousesExFlags) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HousesExFlags) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *HousesExFlags) GetSidMode() *SidMode {
+	if x != nil {
+		return x.SidMode
+	}
+	return nil
+}
+
+func (x *HousesExFlags) GetDeltaT() float64 {
+	if x != nil && x.DeltaT != nil {
+		return *x.DeltaT
+	}
+	return 0
+}
+
+type SidTimeFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeltaT *float64 `protobuf:"fixed64,1,opt,name=delta_t,json=deltaT,proto3,oneof" json:"delta_t,omitempty"`
+}
+
+func (x *SidTimeFlags) Reset() {
+	*x = SidTimeFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidTimeFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidTimeFlags) ProtoMessage() {}
+
+func (x *SidTimeFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidTimeFlags.ProtoReflect.Descriptor instead.
+func (*SidTimeFlags) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SidTimeFlags) GetDeltaT() float64 {
+	if x != nil && x.DeltaT != nil {
+		return *x.DeltaT
+	}
+	return 0
+}
+
+type VersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{8}
+}
+
+type VersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type NameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NameResponse) Reset() {
+	*x = NameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameResponse) ProtoMessage() {}
+
+func (x *NameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameResponse.ProtoReflect.Descriptor instead.
+func (*NameResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PlanetNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Planet int32 `protobuf:"varint,1,opt,name=planet,proto3" json:"planet,omitempty"`
+}
+
+func (x *PlanetNameRequest) Reset() {
+	*x = PlanetNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanetNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanetNameRequest) ProtoMessage() {}
+
+func (x *PlanetNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanetNameRequest.ProtoReflect.Descriptor instead.
+func (*PlanetNameRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PlanetNameRequest) GetPlanet() int32 {
+	if x != nil {
+		return x.Planet
+	}
+	return 0
+}
+
+type CalcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jd     float64    `protobuf:"fixed64,1,opt,name=jd,proto3" json:"jd,omitempty"`
+	Planet int32      `protobuf:"varint,2,opt,name=planet,proto3" json:"planet,omitempty"`
+	Flags  *CalcFlags `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *CalcRequest) Reset() {
+	*x = CalcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcRequest) ProtoMessage() {}
+
+func (x *CalcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcRequest.ProtoReflect.Descriptor instead.
+func (*CalcRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CalcRequest) GetJd() float64 {
+	if x != nil {
+		return x.Jd
+	}
+	return 0
+}
+
+func (x *CalcRequest) GetPlanet() int32 {
+	if x != nil {
+		return x.Planet
+	}
+	return 0
+}
+
+func (x *CalcRequest) GetFlags() *CalcFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type CalcPctrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Et     float64    `protobuf:"fixed64,1,opt,name=et,proto3" json:"et,omitempty"`
+	Planet int32      `protobuf:"varint,2,opt,name=planet,proto3" json:"planet,omitempty"`
+	Center int32      `protobuf:"varint,3,opt,name=center,proto3" json:"center,omitempty"`
+	Flags  *CalcFlags `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *CalcPctrRequest) Reset() {
+	*x = CalcPctrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcPctrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcPctrRequest) ProtoMessage() {}
+
+func (x *CalcPctrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcPctrRequest.ProtoReflect.Descriptor instead.
+func (*CalcPctrRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CalcPctrRequest) GetEt() float64 {
+	if x != nil {
+		return x.Et
+	}
+	return 0
+}
+
+func (x *CalcPctrRequest) GetPlanet() int32 {
+	if x != nil {
+		return x.Planet
+	}
+	return 0
+}
+
+func (x *CalcPctrRequest) GetCenter() int32 {
+	if x != nil {
+		return x.Center
+	}
+	return 0
+}
+
+func (x *CalcPctrRequest) GetFlags() *CalcFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type CalcResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Xx    []float64 `protobuf:"fixed64,1,rep,packed,name=xx,proto3" json:"xx,omitempty"`
+	Flags int32     `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"` // flags returned by the library
+}
+
+func (x *CalcResponse) Reset() {
+	*x = CalcResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcResponse) ProtoMessage() {}
+
+func (x *CalcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcResponse.ProtoReflect.Descriptor instead.
+func (*CalcResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CalcResponse) GetXx() []float64 {
+	if x != nil {
+		return x.Xx
+	}
+	return nil
+}
+
+func (x *CalcResponse) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type CalcBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CalcBatchRequest_Item `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *CalcBatchRequest) Reset() {
+	*x = CalcBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcBatchRequest) ProtoMessage() {}
+
+func (x *CalcBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcBatchRequest.ProtoReflect.Descriptor instead.
+func (*CalcBatchRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CalcBatchRequest) GetRequests() []*CalcBatchRequest_Item {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type CalcBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*CalcBatchResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *CalcBatchResponse) Reset() {
+	*x = CalcBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcBatchResponse) ProtoMessage() {}
+
+func (x *CalcBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcBatchResponse.ProtoReflect.Descriptor instead.
+func (*CalcBatchResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CalcBatchResponse) GetResults() []*CalcBatchResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type FixStarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Star  string     `protobuf:"bytes,1,opt,name=star,proto3" json:"star,omitempty"`
+	Jd    float64    `protobuf:"fixed64,2,opt,name=jd,proto3" json:"jd,omitempty"`
+	Flags *CalcFlags `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *FixStarRequest) Reset() {
+	*x = FixStarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixStarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixStarRequest) ProtoMessage() {}
+
+func (x *FixStarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixStarRequest.ProtoReflect.Descriptor instead.
+func (*FixStarRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FixStarRequest) GetStar() string {
+	if x != nil {
+		return x.Star
+	}
+	return ""
+}
+
+func (x *FixStarRequest) GetJd() float64 {
+	if x != nil {
+		return x.Jd
+	}
+	return 0
+}
+
+func (x *FixStarRequest) GetFlags() *CalcFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type FixStarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Xx    []float64 `protobuf:"fixed64,2,rep,packed,name=xx,proto3" json:"xx,omitempty"`
+	Flags int32     `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *FixStarResponse) Reset() {
+	*x = FixStarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixStarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixStarResponse) ProtoMessage() {}
+
+func (x *FixStarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixStarResponse.ProtoReflect.Descriptor instead.
+func (*FixStarResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FixStarResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FixStarResponse) GetXx() []float64 {
+	if x != nil {
+		return x.Xx
+	}
+	return nil
+}
+
+func (x *FixStarResponse) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type FixStarMagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Star string `protobuf:"bytes,1,opt,name=star,proto3" json:"star,omitempty"`
+}
+
+func (x *FixStarMagRequest) Reset() {
+	*x = FixStarMagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixStarMagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixStarMagRequest) ProtoMessage() {}
+
+func (x *FixStarMagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixStarMagRequest.ProtoReflect.Descriptor instead.
+func (*FixStarMagRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FixStarMagRequest) GetStar() string {
+	if x != nil {
+		return x.Star
+	}
+	return ""
+}
+
+type FixStarMagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Mag  float64 `protobuf:"fixed64,2,opt,name=mag,proto3" json:"mag,omitempty"`
+}
+
+func (x *FixStarMagResponse) Reset() {
+	*x = FixStarMagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixStarMagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixStarMagResponse) ProtoMessage() {}
+
+func (x *FixStarMagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixStarMagResponse.ProtoReflect.Descriptor instead.
+func (*FixStarMagResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FixStarMagResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FixStarMagResponse) GetMag() float64 {
+	if x != nil {
+		return x.Mag
+	}
+	return 0
+}
+
+type HousesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ut     float64        `protobuf:"fixed64,1,opt,name=ut,proto3" json:"ut,omitempty"`
+	Flags  *HousesExFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Geolat float64        `protobuf:"fixed64,3,opt,name=geolat,proto3" json:"geolat,omitempty"`
+	Geolon float64        `protobuf:"fixed64,4,opt,name=geolon,proto3" json:"geolon,omitempty"`
+	Hsys   string         `protobuf:"bytes,5,opt,name=hsys,proto3" json:"hsys,omitempty"` // house system identifier, e.g. "P" for Placidus
+}
+
+func (x *HousesRequest) Reset() {
+	*x = HousesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesRequest) ProtoMessage() {}
+
+func (x *HousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesRequest.ProtoReflect.Descriptor instead.
+func (*HousesRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HousesRequest) GetUt() float64 {
+	if x != nil {
+		return x.Ut
+	}
+	return 0
+}
+
+func (x *HousesRequest) GetFlags() *HousesExFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *HousesRequest) GetGeolat() float64 {
+	if x != nil {
+		return x.Geolat
+	}
+	return 0
+}
+
+func (x *HousesRequest) GetGeolon() float64 {
+	if x != nil {
+		return x.Geolon
+	}
+	return 0
+}
+
+func (x *HousesRequest) GetHsys() string {
+	if x != nil {
+		return x.Hsys
+	}
+	return ""
+}
+
+type HousesARMCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Armc   float64 `protobuf:"fixed64,1,opt,name=armc,proto3" json:"armc,omitempty"`
+	Geolat float64 `protobuf:"fixed64,2,opt,name=geolat,proto3" json:"geolat,omitempty"`
+	Eps    float64 `protobuf:"fixed64,3,opt,name=eps,proto3" json:"eps,omitempty"`
+	Hsys   string  `protobuf:"bytes,4,opt,name=hsys,proto3" json:"hsys,omitempty"`
+}
+
+func (x *HousesARMCRequest) Reset() {
+	*x = HousesARMCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HousesARMCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousesARMCRequest) ProtoMessage() {}
+
+func (x *HousesARMCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousesARMCRequest.ProtoReflect.Descriptor instead.
+func (*HousesARMCRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HousesARMCRequest) GetArmc() float64 {
+	if x != nil {
+		return x.Armc
+	}
+	return 0
+}
+
+func (x *HousesARMCRequest) GetGeolat() float64 {
+	if x != nil {
+		return x.Geolat
+	}
+	return 0
+}
+
+func (x *HousesARMCRequest) GetEps() float64 {
+	if x != nil {
+		return x.Eps
+	}
+	return 0
+}
+
+func (x *HousesARMCRequest) GetHsys() string {
+	if x != nil {
+		return x.Hsys
+	}
+	return ""
+}
+
+// Houses mirrors swego.Houses.
+type Houses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cusps and cusp_speeds are indexed by house number, index 0 is unused.
+	Cusps       []float64 `protobuf:"fixed64,1,rep,packed,name=cusps,proto3" json:"cusps,omitempty"`
+	CuspSpeeds  []float64 `protobuf:"fixed64,2,rep,packed,name=cusp_speeds,json=cuspSpeeds,proto3" json:"cusp_speeds,omitempty"`
+	Asc         float64   `protobuf:"fixed64,3,opt,name=asc,proto3" json:"asc,omitempty"`
+	Mc          float64   `protobuf:"fixed64,4,opt,name=mc,proto3" json:"mc,omitempty"`
+	Armc        float64   `protobuf:"fixed64,5,opt,name=armc,proto3" json:"armc,omitempty"`
+	Vertex      float64   `protobuf:"fixed64,6,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	EquAsc      float64   `protobuf:"fixed64,7,opt,name=equ_asc,json=equAsc,proto3" json:"equ_asc,omitempty"`
+	CoAsc1      float64   `protobuf:"fixed64,8,opt,name=co_asc1,json=coAsc1,proto3" json:"co_asc1,omitempty"`
+	CoAsc2      float64   `protobuf:"fixed64,9,opt,name=co_asc2,json=coAsc2,proto3" json:"co_asc2,omitempty"`
+	PolAsc      float64   `protobuf:"fixed64,10,opt,name=pol_asc,json=polAsc,proto3" json:"pol_asc,omitempty"`
+	AscSpeed    float64   `protobuf:"fixed64,11,opt,name=asc_speed,json=ascSpeed,proto3" json:"asc_speed,omitempty"`
+	McSpeed     float64   `protobuf:"fixed64,12,opt,name=mc_speed,json=mcSpeed,proto3" json:"mc_speed,omitempty"`
+	ArmcSpeed   float64   `protobuf:"fixed64,13,opt,name=armc_speed,json=armcSpeed,proto3" json:"armc_speed,omitempty"`
+	VertexSpeed float64   `protobuf:"fixed64,14,opt,name=vertex_speed,json=vertexSpeed,proto3" json:"vertex_speed,omitempty"`
+	EquAscSpeed float64   `protobuf:"fixed64,15,opt,name=equ_asc_speed,json=equAscSpeed,proto3" json:"equ_asc_speed,omitempty"`
+	CoAsc1Speed float64   `protobuf:"fixed64,16,opt,name=co_asc1_speed,json=coAsc1Speed,proto3" json:"co_asc1_speed,omitempty"`
+	CoAsc2Speed float64   `protobuf:"fixed64,17,opt,name=co_asc2_speed,json=coAsc2Speed,proto3" json:"co_asc2_speed,omitempty"`
+	PolAscSpeed float64   `protobuf:"fixed64,18,opt,name=pol_asc_speed,json=polAscSpeed,proto3" json:"pol_asc_speed,omitempty"`
+	Warning     string    `protobuf:"bytes,19,opt,name=warning,proto3" json:"warning,omitempty"`
+}
+
+func (x *Houses) Reset() {
+	*x = Houses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Houses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Houses) ProtoMessage() {}
+
+func (x *Houses) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Houses.ProtoReflect.Descriptor instead.
+func (*Houses) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Houses) GetCusps() []float64 {
+	if x != nil {
+		return x.Cusps
+	}
+	return nil
+}
+
+func (x *Houses) GetCuspSpeeds() []float64 {
+	if x != nil {
+		return x.CuspSpeeds
+	}
+	return nil
+}
+
+func (x *Houses) GetAsc() float64 {
+	if x != nil {
+		return x.Asc
+	}
+	return 0
+}
+
+func (x *Houses) GetMc() float64 {
+	if x != nil {
+		return x.Mc
+	}
+	return 0
+}
+
+func (x *Houses) GetArmc() float64 {
+	if x != nil {
+		return x.Armc
+	}
+	return 0
+}
+
+func (x *Houses) GetVertex() float64 {
+	if x != nil {
+		return x.Vertex
+	}
+	return 0
+}
+
+func (x *Houses) GetEquAsc() float64 {
+	if x != nil {
+		return x.EquAsc
+	}
+	return 0
+}
+
+func (x *Houses) GetCoAsc1() float64 {
+	if x != nil {
+		return x.CoAsc1
+	}
+	return 0
+}
+
+func (x *Houses) GetCoAsc2() float64 {
+	if x != nil {
+		return x.CoAsc2
+	}
+	return 0
+}
+
+func (x *Houses) GetPolAsc() float64 {
+	if x != nil {
+		return x.PolAsc
+	}
+	return 0
+}
+
+func (x *Houses) GetAscSpeed() float64 {
+	if x != nil {
+		return x.AscSpeed
+	}
+	return 0
+}
+
+func (x *Houses) GetMcSpeed() float64 {
+	if x != nil {
+		return x.McSpeed
+	}
+	return 0
+}
+
+func (x *Houses) GetArmcSpeed() float64 {
+	if x != nil {
+		return x.ArmcSpeed
+	}
+	return 0
+}
+
+func (x *Houses) GetVertexSpeed() float64 {
+	if x != nil {
+		return x.VertexSpeed
+	}
+	return 0
+}
+
+func (x *Houses) GetEquAscSpeed() float64 {
+	if x != nil {
+		return x.EquAscSpeed
+	}
+	return 0
+}
+
+func (x *Houses) GetCoAsc1Speed() float64 {
+	if x != nil {
+		return x.CoAsc1Speed
+	}
+	return 0
+}
+
+func (x *Houses) GetCoAsc2Speed() float64 {
+	if x != nil {
+		return x.CoAsc2Speed
+	}
+	return 0
+}
+
+func (x *Houses) GetPolAscSpeed() float64 {
+	if x != nil {
+		return x.PolAscSpeed
+	}
+	return 0
+}
+
+func (x *Houses) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+type HousePosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Armc   float64 `protobuf:"fixed64,1,opt,name=armc,proto3" json:"armc,omitempty"`
+	Geolat float64 `protobuf:"fixed64,2,opt,name=geolat,proto3" json:"geolat,omitempty"`
+	Eps    float64 `protobuf:"fixed64,3,opt,name=eps,proto3" json:"eps,omitempty"`
+	Hsys   string  `protobuf:"bytes,4,opt,name=hsys,proto3" json:"hsys,omitempty"`
+	Pllng  float64 `protobuf:"fixed64,5,opt,name=pllng,proto3" json:"pllng,omitempty"`
+	Pllat  float64 `protobuf:"fixed64,6,opt,name=pllat,proto3" json:"pllat,omitempty"`
+}
+
+func (x *HousePosRequest) Reset() {
+	*x = HousePosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HousePosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousePosRequest) ProtoMessage() {}
+
+func (x *HousePosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousePosRequest.ProtoReflect.Descriptor instead.
+func (*HousePosRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *HousePosRequest) GetArmc() float64 {
+	if x != nil {
+		return x.Armc
+	}
+	return 0
+}
+
+func (x *HousePosRequest) GetGeolat() float64 {
+	if x != nil {
+		return x.Geolat
+	}
+	return 0
+}
+
+func (x *HousePosRequest) GetEps() float64 {
+	if x != nil {
+		return x.Eps
+	}
+	return 0
+}
+
+func (x *HousePosRequest) GetHsys() string {
+	if x != nil {
+		return x.Hsys
+	}
+	return ""
+}
+
+func (x *HousePosRequest) GetPllng() float64 {
+	if x != nil {
+		return x.Pllng
+	}
+	return 0
+}
+
+func (x *HousePosRequest) GetPllat() float64 {
+	if x != nil {
+		return x.Pllat
+	}
+	return 0
+}
+
+type HousePosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pos float64 `protobuf:"fixed64,1,opt,name=pos,proto3" json:"pos,omitempty"`
+}
+
+func (x *HousePosResponse) Reset() {
+	*x = HousePosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HousePosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HousePosResponse) ProtoMessage() {}
+
+func (x *HousePosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HousePosResponse.ProtoReflect.Descriptor instead.
+func (*HousePosResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HousePosResponse) GetPos() float64 {
+	if x != nil {
+		return x.Pos
+	}
+	return 0
+}
+
+type HouseNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hsys string `protobuf:"bytes,1,opt,name=hsys,proto3" json:"hsys,omitempty"`
+}
+
+func (x *HouseNameRequest) Reset() {
+	*x = HouseNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HouseNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseNameRequest) ProtoMessage() {}
+
+func (x *HouseNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseNameRequest.ProtoReflect.Descriptor instead.
+func (*HouseNameRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HouseNameRequest) GetHsys() string {
+	if x != nil {
+		return x.Hsys
+	}
+	return ""
+}
+
+type JulDayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date     *Date    `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Calendar Calendar `protobuf:"varint,2,opt,name=calendar,proto3,enum=swego.Calendar" json:"calendar,omitempty"`
+}
+
+func (x *JulDayRequest) Reset() {
+	*x = JulDayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JulDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JulDayRequest) ProtoMessage() {}
+
+func (x *JulDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JulDayRequest.ProtoReflect.Descriptor instead.
+func (*JulDayRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *JulDayRequest) GetDate() *Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *JulDayRequest) GetCalendar() Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return Calendar_CALENDAR_JULIAN
+}
+
+type JulDayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jd float64 `protobuf:"fixed64,1,opt,name=jd,proto3" json:"jd,omitempty"`
+}
+
+func (x *JulDayResponse) Reset() {
+	*x = JulDayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JulDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JulDayResponse) ProtoMessage() {}
+
+func (x *JulDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JulDayResponse.ProtoReflect.Descriptor instead.
+func (*JulDayResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *JulDayResponse) GetJd() float64 {
+	if x != nil {
+		return x.Jd
+	}
+	return 0
+}
+
+type RevJulRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jd       float64  `protobuf:"fixed64,1,opt,name=jd,proto3" json:"jd,omitempty"`
+	Calendar Calendar `protobuf:"varint,2,opt,name=calendar,proto3,enum=swego.Calendar" json:"calendar,omitempty"`
+}
+
+func (x *RevJulRequest) Reset() {
+	*x = RevJulRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevJulRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevJulRequest) ProtoMessage() {}
+
+func (x *RevJulRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevJulRequest.ProtoReflect.Descriptor instead.
+func (*RevJulRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevJulRequest) GetJd() float64 {
+	if x != nil {
+		return x.Jd
+	}
+	return 0
+}
+
+func (x *RevJulRequest) GetCalendar() Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return Calendar_CALENDAR_JULIAN
+}
+
+type Date struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year  int32   `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32   `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32   `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Hour  float64 `protobuf:"fixed64,4,opt,name=hour,proto3" json:"hour,omitempty"`
+}
+
+func (x *Date) Reset() {
+	*x = Date{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Date) ProtoMessage() {}
+
+func (x *Date) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Date.ProtoReflect.Descriptor instead.
+func (*Date) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Date) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Date) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *Date) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *Date) GetHour() float64 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+type DateTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year   int32   `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month  int32   `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day    int32   `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Hour   int32   `protobuf:"varint,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	Minute int32   `protobuf:"varint,5,opt,name=minute,proto3" json:"minute,omitempty"`
+	Second float64 `protobuf:"fixed64,6,opt,name=second,proto3" json:"second,omitempty"`
+}
+
+func (x *DateTime) Reset() {
+	*x = DateTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DateTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateTime) ProtoMessage() {}
+
+func (x *DateTime) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateTime.ProtoReflect.Descriptor instead.
+func (*DateTime) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DateTime) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *DateTime) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *DateTime) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *DateTime) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *DateTime) GetMinute() int32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *DateTime) GetSecond() float64 {
+	if x != nil {
+		return x.Second
+	}
+	return 0
+}
+
+type UTCToJDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Utc   *DateTime         `protobuf:"bytes,1,opt,name=utc,proto3" json:"utc,omitempty"`
+	Flags *DateConvertFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *UTCToJDRequest) Reset() {
+	*x = UTCToJDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTCToJDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTCToJDRequest) ProtoMessage() {}
+
+func (x *UTCToJDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTCToJDRequest.ProtoReflect.Descriptor instead.
+func (*UTCToJDRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UTCToJDRequest) GetUtc() *DateTime {
+	if x != nil {
+		return x.Utc
+	}
+	return nil
+}
+
+func (x *UTCToJDRequest) GetFlags() *DateConvertFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type UTCToJDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Et float64 `protobuf:"fixed64,1,opt,name=et,proto3" json:"et,omitempty"`
+	Ut float64 `protobuf:"fixed64,2,opt,name=ut,proto3" json:"ut,omitempty"`
+}
+
+func (x *UTCToJDResponse) Reset() {
+	*x = UTCToJDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTCToJDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTCToJDResponse) ProtoMessage() {}
+
+func (x *UTCToJDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTCToJDResponse.ProtoReflect.Descriptor instead.
+func (*UTCToJDResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UTCToJDResponse) GetEt() float64 {
+	if x != nil {
+		return x.Et
+	}
+	return 0
+}
+
+func (x *UTCToJDResponse) GetUt() float64 {
+	if x != nil {
+		return x.Ut
+	}
+	return 0
+}
+
+type JdToUTCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jd    float64           `protobuf:"fixed64,1,opt,name=jd,proto3" json:"jd,omitempty"`
+	Flags *DateConvertFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *JdToUTCRequest) Reset() {
+	*x = JdToUTCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JdToUTCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JdToUTCRequest) ProtoMessage() {}
+
+func (x *JdToUTCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JdToUTCRequest.ProtoReflect.Descriptor instead.
+func (*JdToUTCRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JdToUTCRequest) GetJd() float64 {
+	if x != nil {
+		return x.Jd
+	}
+	return 0
+}
+
+func (x *JdToUTCRequest) GetFlags() *DateConvertFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type UTCTimeZoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date *DateTime `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Tz   float64   `protobuf:"fixed64,2,opt,name=tz,proto3" json:"tz,omitempty"` // time zone offset in hours
+}
+
+func (x *UTCTimeZoneRequest) Reset() {
+	*x = UTCTimeZoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTCTimeZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTCTimeZoneRequest) ProtoMessage() {}
+
+func (x *UTCTimeZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTCTimeZoneRequest.ProtoReflect.Descriptor instead.
+func (*UTCTimeZoneRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UTCTimeZoneRequest) GetDate() *DateTime {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *UTCTimeZoneRequest) GetTz() float64 {
+	if x != nil {
+		return x.Tz
+	}
+	return 0
+}
+
+type DeltaTRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jd        float64 `protobuf:"fixed64,1,opt,name=jd,proto3" json:"jd,omitempty"`
+	Ephemeris int32   `protobuf:"varint,2,opt,name=ephemeris,proto3" json:"ephemeris,omitempty"`
+}
+
+func (x *DeltaTRequest) Reset() {
+	*x = DeltaTRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeltaTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeltaTRequest) ProtoMessage() {}
+
+func (x *DeltaTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeltaTRequest.ProtoReflect.Descriptor instead.
+func (*DeltaTRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeltaTRequest) GetJd() float64 {
+	if x != nil {
+		return x.Jd
+	}
+	return 0
+}
+
+func (x *DeltaTRequest) GetEphemeris() int32 {
+	if x != nil {
+		return x.Ephemeris
+	}
+	return 0
+}
+
+type DeltaTResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeltaT float64 `protobuf:"fixed64,1,opt,name=delta_t,json=deltaT,proto3" json:"delta_t,omitempty"`
+}
+
+func (x *DeltaTResponse) Reset() {
+	*x = DeltaTResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeltaTResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeltaTResponse) ProtoMessage() {}
+
+func (x *DeltaTResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeltaTResponse.ProtoReflect.Descriptor instead.
+func (*DeltaTResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeltaTResponse) GetDeltaT() float64 {
+	if x != nil {
+		return x.DeltaT
+	}
+	return 0
+}
+
+type SidTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ut    float64       `protobuf:"fixed64,1,opt,name=ut,proto3" json:"ut,omitempty"`
+	Flags *SidTimeFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *SidTimeRequest) Reset() {
+	*x = SidTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidTimeRequest) ProtoMessage() {}
+
+func (x *SidTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidTimeRequest.ProtoReflect.Descriptor instead.
+func (*SidTimeRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SidTimeRequest) GetUt() float64 {
+	if x != nil {
+		return x.Ut
+	}
+	return 0
+}
+
+func (x *SidTimeRequest) GetFlags() *SidTimeFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type SidTimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SidTime float64 `protobuf:"fixed64,1,opt,name=sid_time,json=sidTime,proto3" json:"sid_time,omitempty"`
+}
+
+func (x *SidTimeResponse) Reset() {
+	*x = SidTimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidTimeResponse) ProtoMessage() {}
+
+func (x *SidTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidTimeResponse.ProtoReflect.Descriptor instead.
+func (*SidTimeResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SidTimeResponse) GetSidTime() float64 {
+	if x != nil {
+		return x.SidTime
+	}
+	return 0
+}
+
+type AyanamsaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jd    float64          `protobuf:"fixed64,1,opt,name=jd,proto3" json:"jd,omitempty"`
+	Flags *AyanamsaExFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *AyanamsaRequest) Reset() {
+	*x = AyanamsaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AyanamsaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AyanamsaRequest) ProtoMessage() {}
+
+func (x *AyanamsaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AyanamsaRequest.ProtoReflect.Descriptor instead.
+func (*AyanamsaRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AyanamsaRequest) GetJd() float64 {
+	if x != nil {
+		return x.Jd
+	}
+	return 0
+}
+
+func (x *AyanamsaRequest) GetFlags() *AyanamsaExFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type AyanamsaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ayanamsa float64 `protobuf:"fixed64,1,opt,name=ayanamsa,proto3" json:"ayanamsa,omitempty"`
+}
+
+func (x *AyanamsaResponse) Reset() {
+	*x = AyanamsaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AyanamsaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AyanamsaResponse) ProtoMessage() {}
+
+func (x *AyanamsaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AyanamsaResponse.ProtoReflect.Descriptor instead.
+func (*AyanamsaResponse) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AyanamsaResponse) GetAyanamsa() float64 {
+	if x != nil {
+		return x.Ayanamsa
+	}
+	return 0
+}
+
+type AyanamsaNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ayanamsa int32 `protobuf:"varint,1,opt,name=ayanamsa,proto3" json:"ayanamsa,omitempty"`
+}
+
+func (x *AyanamsaNameRequest) Reset() {
+	*x = AyanamsaNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AyanamsaNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AyanamsaNameRequest) ProtoMessage() {}
+
+func (x *AyanamsaNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AyanamsaNameRequest.ProtoReflect.Descriptor instead.
+func (*AyanamsaNameRequest) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AyanamsaNameRequest) GetAyanamsa() int32 {
+	if x != nil {
+		return x.Ayanamsa
+	}
+	return 0
+}
+
+type CalcBatchRequest_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jd     float64    `protobuf:"fixed64,1,opt,name=jd,proto3" json:"jd,omitempty"`
+	Ut     bool       `protobuf:"varint,2,opt,name=ut,proto3" json:"ut,omitempty"` // calculate like CalcUT instead of Calc
+	Planet int32      `protobuf:"varint,3,opt,name=planet,proto3" json:"planet,omitempty"`
+	Flags  *CalcFlags `protobuf:"bytes,4,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *CalcBatchRequest_Item) Reset() {
+	*x = CalcBatchRequest_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcBatchRequest_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcBatchRequest_Item) ProtoMessage() {}
+
+func (x *CalcBatchRequest_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcBatchRequest_Item.ProtoReflect.Descriptor instead.
+func (*CalcBatchRequest_Item) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *CalcBatchRequest_Item) GetJd() float64 {
+	if x != nil {
+		return x.Jd
+	}
+	return 0
+}
+
+func (x *CalcBatchRequest_Item) GetUt() bool {
+	if x != nil {
+		return x.Ut
+	}
+	return false
+}
+
+func (x *CalcBatchRequest_Item) GetPlanet() int32 {
+	if x != nil {
+		return x.Planet
+	}
+	return 0
+}
+
+func (x *CalcBatchRequest_Item) GetFlags() *CalcFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type CalcBatchResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Xx    []float64 `protobuf:"fixed64,1,rep,packed,name=xx,proto3" json:"xx,omitempty"`
+	Flags int32     `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Error string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // error reported by the library, empty on success
+}
+
+func (x *CalcBatchResponse_Result) Reset() {
+	*x = CalcBatchResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_swego_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalcBatchResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalcBatchResponse_Result) ProtoMessage() {}
+
+func (x *CalcBatchResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_swego_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalcBatchResponse_Result.ProtoReflect.Descriptor instead.
+func (*CalcBatchResponse_Result) Descriptor() ([]byte, []int) {
+	return file_swego_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *CalcBatchResponse_Result) GetXx() []float64 {
+	if x != nil {
+		return x.Xx
+	}
+	return nil
+}
+
+func (x *CalcBatchResponse_Result) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *CalcBatchResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_swego_proto protoreflect.FileDescriptor
+
+var file_swego_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73,
+	0x77, 0x65, 0x67, 0x6f, 0x22, 0x20, 0x0a, 0x0c, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x40, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x4c, 0x6f, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x6c, 0x6f, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x6c, 0x74, 0x22, 0x46, 0x0a, 0x07, 0x53, 0x69, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x30, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x02, 0x74, 0x30, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x79, 0x61, 0x6e, 0x5f,
+	0x74, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x79, 0x61, 0x6e, 0x54, 0x30,
+	0x22, 0xbb, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x5f, 0x6c, 0x6f, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x47,
+	0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x6f, 0x4c, 0x6f, 0x63, 0x12, 0x29,
+	0x0a, 0x08, 0x73, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x53, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x73, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x70, 0x6c,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x70, 0x6c,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x88,
+	0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x22, 0x7c,
+	0x0a, 0x0f, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x45, 0x78, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x73, 0x69, 0x64, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x77, 0x65, 0x67,
+	0x6f, 0x2e, 0x53, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x73, 0x69, 0x64, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x22, 0x69, 0x0a, 0x10,
+	0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x2b, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1c, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x22, 0x7a, 0x0a, 0x0d, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x73, 0x45, 0x78, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x29,
+	0x0a, 0x08, 0x73, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x53, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x73, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x5f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x54, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x5f, 0x74, 0x22, 0x38, 0x0a, 0x0c, 0x53, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x88, 0x01,
+	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x22, 0x10, 0x0a,
+	0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x2b, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x0c,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x2b, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x22, 0x5d, 0x0a,
+	0x0b, 0x43, 0x61, 0x6c, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x6a, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6a, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x79, 0x0a, 0x0f,
+	0x43, 0x61, 0x6c, 0x63, 0x50, 0x63, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x34, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x78, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x02, 0x78, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xb4, 0x01,
+	0x0a, 0x10, 0x43, 0x61, 0x6c, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c,
+	0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x66, 0x0a, 0x04,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x6a, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x02, 0x6a, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x02, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x77,
+	0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x63, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x77,
+	0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x44, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x78, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x02, 0x78, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5c, 0x0a, 0x0e, 0x46,
+	0x69, 0x78, 0x53, 0x74, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x74, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x61,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x6a, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6a,
+	0x64, 0x12, 0x26, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x4b, 0x0a, 0x0f, 0x46, 0x69, 0x78,
+	0x53, 0x74, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x78, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x02, 0x78, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61,
+	0x72, 0x4d, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x74, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x61, 0x72, 0x22,
+	0x3a, 0x0a, 0x12, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x72, 0x4d, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x67, 0x22, 0x8f, 0x01, 0x0a, 0x0d,
+	0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x75, 0x74, 0x12, 0x2a, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
+	0x77, 0x65, 0x67, 0x6f, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x45, 0x78, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6f,
+	0x6c, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x61,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x73, 0x79,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x73, 0x79, 0x73, 0x22, 0x65, 0x0a,
+	0x11, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x41, 0x52, 0x4d, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x6d, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x61, 0x72, 0x6d, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x61, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x65, 0x70, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x73, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x73, 0x79, 0x73, 0x22, 0x95, 0x04, 0x0a, 0x06, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x75, 0x73, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05,
+	0x63, 0x75, 0x73, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x70, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x70,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x73, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x6d, 0x63, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6d, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x6d, 0x63,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x61, 0x72, 0x6d, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x71, 0x75, 0x5f, 0x61, 0x73, 0x63, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x65, 0x71, 0x75, 0x41, 0x73, 0x63, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x6f, 0x5f, 0x61, 0x73, 0x63, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x63, 0x6f, 0x41, 0x73, 0x63, 0x31, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x5f, 0x61, 0x73, 0x63,
+	0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x41, 0x73, 0x63, 0x32, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x70, 0x6f, 0x6c, 0x41, 0x73, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x5f,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x73, 0x63,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x63, 0x5f, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x63, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x6d, 0x63, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x72, 0x6d, 0x63, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x74, 0x65, 0x78, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x71, 0x75, 0x5f, 0x61, 0x73, 0x63, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x65, 0x71, 0x75, 0x41, 0x73,
+	0x63, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x5f, 0x61, 0x73, 0x63,
+	0x31, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63,
+	0x6f, 0x41, 0x73, 0x63, 0x31, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f,
+	0x5f, 0x61, 0x73, 0x63, 0x32, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x63, 0x6f, 0x41, 0x73, 0x63, 0x32, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x70, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x63, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x41, 0x73, 0x63, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x8f, 0x01, 0x0a,
+	0x0f, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x6d, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x61, 0x72, 0x6d, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x67, 0x65, 0x6f, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x65, 0x70, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x73, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x73,
+	0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x6c, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x70, 0x6c, 0x6c, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x6c, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x6c, 0x6c, 0x61, 0x74, 0x22, 0x24,
+	0x0a, 0x10, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x70, 0x6f, 0x73, 0x22, 0x26, 0x0a, 0x10, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x73, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x73, 0x79, 0x73, 0x22, 0x5d, 0x0a, 0x0d,
+	0x4a, 0x75, 0x6c, 0x44, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x77,
+	0x65, 0x67, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2b,
+	0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x20, 0x0a, 0x0e, 0x4a,
+	0x75, 0x6c, 0x44, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x6a, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6a, 0x64, 0x22, 0x4c, 0x0a,
+	0x0d, 0x52, 0x65, 0x76, 0x4a, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x6a, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6a, 0x64, 0x12, 0x2b,
+	0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0f, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x22, 0x56, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68,
+	0x6f, 0x75, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x22, 0x62, 0x0a, 0x0e, 0x55, 0x54, 0x43, 0x54, 0x6f, 0x4a, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x75, 0x74, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x03, 0x75, 0x74, 0x63, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x44, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x22, 0x31, 0x0a, 0x0f, 0x55, 0x54, 0x43, 0x54, 0x6f, 0x4a, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x02, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x02, 0x75, 0x74, 0x22, 0x4f, 0x0a, 0x0e, 0x4a, 0x64, 0x54, 0x6f, 0x55,
+	0x54, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6a, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6a, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x49, 0x0a, 0x12, 0x55, 0x54, 0x43, 0x54,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x77, 0x65, 0x67, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x02, 0x74, 0x7a, 0x22, 0x3d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6a, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x02, 0x6a, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x69,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x69, 0x73, 0x22, 0x29, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x22, 0x4b, 0x0a,
+	0x0e, 0x53, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x75, 0x74, 0x12,
+	0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x53, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x0f, 0x53, 0x69,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x69, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x73, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x0f, 0x41, 0x79, 0x61, 0x6e,
+	0x61, 0x6d, 0x73, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6a,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6a, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x77, 0x65,
+	0x67, 0x6f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x45, 0x78, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x2e, 0x0a, 0x10, 0x41, 0x79, 0x61,
+	0x6e, 0x61, 0x6d, 0x73, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x61, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x22, 0x31, 0x0a, 0x13, 0x41, 0x79, 0x61,
+	0x6e, 0x61, 0x6d, 0x73, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x2a, 0x37, 0x0a, 0x08,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x41, 0x4c, 0x45,
+	0x4e, 0x44, 0x41, 0x52, 0x5f, 0x4a, 0x55, 0x4c, 0x49, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x5f, 0x47, 0x52, 0x45, 0x47, 0x4f, 0x52,
+	0x49, 0x41, 0x4e, 0x10, 0x01, 0x32, 0x80, 0x0b, 0x0a, 0x09, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65,
+	0x72, 0x69, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15,
+	0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0a, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x77,
+	0x65, 0x67, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x43, 0x61,
+	0x6c, 0x63, 0x12, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43,
+	0x61, 0x6c, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x43,
+	0x61, 0x6c, 0x63, 0x55, 0x54, 0x12, 0x12, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61,
+	0x6c, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x77, 0x65, 0x67,
+	0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x08, 0x43, 0x61, 0x6c, 0x63, 0x50, 0x63, 0x74, 0x72, 0x12, 0x16, 0x2e, 0x73, 0x77, 0x65,
+	0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x50, 0x63, 0x74, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x63, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c,
+	0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x46, 0x69, 0x78, 0x53, 0x74,
+	0x61, 0x72, 0x32, 0x12, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x46, 0x69, 0x78, 0x53,
+	0x74, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x77, 0x65,
+	0x67, 0x6f, 0x2e, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x72, 0x32, 0x55, 0x54,
+	0x12, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e,
+	0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x0b, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x72, 0x32, 0x4d, 0x61, 0x67, 0x12, 0x18,
+	0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x72, 0x4d, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f,
+	0x2e, 0x46, 0x69, 0x78, 0x53, 0x74, 0x61, 0x72, 0x4d, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x45, 0x78, 0x32,
+	0x12, 0x14, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x41,
+	0x52, 0x4d, 0x43, 0x45, 0x78, 0x32, 0x12, 0x18, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x73, 0x41, 0x52, 0x4d, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x12,
+	0x3b, 0x0a, 0x08, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x77,
+	0x65, 0x67, 0x6f, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09,
+	0x48, 0x6f, 0x75, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x67,
+	0x6f, 0x2e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x4a, 0x75, 0x6c, 0x44, 0x61,
+	0x79, 0x12, 0x14, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x4a, 0x75, 0x6c, 0x44, 0x61, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e,
+	0x4a, 0x75, 0x6c, 0x44, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x06, 0x52, 0x65, 0x76, 0x4a, 0x75, 0x6c, 0x12, 0x14, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f,
+	0x2e, 0x52, 0x65, 0x76, 0x4a, 0x75, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b,
+	0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x55,
+	0x54, 0x43, 0x54, 0x6f, 0x4a, 0x44, 0x12, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x55,
+	0x54, 0x43, 0x54, 0x6f, 0x4a, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x55, 0x54, 0x43, 0x54, 0x6f, 0x4a, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x4a, 0x64, 0x45, 0x54, 0x54, 0x6f, 0x55,
+	0x54, 0x43, 0x12, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x4a, 0x64, 0x54, 0x6f, 0x55,
+	0x54, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x77, 0x65, 0x67,
+	0x6f, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x4a, 0x64,
+	0x55, 0x54, 0x31, 0x54, 0x6f, 0x55, 0x54, 0x43, 0x12, 0x15, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f,
+	0x2e, 0x4a, 0x64, 0x54, 0x6f, 0x55, 0x54, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x39, 0x0a, 0x0b, 0x55, 0x54, 0x43, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
+	0x19, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x55, 0x54, 0x43, 0x54, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x73, 0x77, 0x65,
+	0x67, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x54, 0x45, 0x78, 0x12, 0x14, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x53, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x15, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x53, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x53,
+	0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x45, 0x78, 0x12,
+	0x16, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e,
+	0x41, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x45,
+	0x78, 0x55, 0x54, 0x12, 0x16, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x41, 0x79, 0x61, 0x6e,
+	0x61, 0x6d, 0x73, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x77,
+	0x65, 0x67, 0x6f, 0x2e, 0x41, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x79, 0x61, 0x6e, 0x61,
+	0x6d, 0x73, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e,
+	0x41, 0x79, 0x61, 0x6e, 0x61, 0x6d, 0x73, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x65, 0x76, 0x65,
+	0x2f, 0x73, 0x77, 0x65, 0x67, 0x6f, 0x2f, 0x73, 0x77, 0x65, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73,
+	0x77, 0x65, 0x67, 0x6f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_swego_proto_rawDescOnce sync.Once
+	file_swego_proto_rawDescData = file_swego_proto_rawDesc
+)
+
+func file_swego_proto_rawDescGZIP() []byte {
+	file_swego_proto_rawDescOnce.Do(func() {
+		file_swego_proto_rawDescData = protoimpl.X.CompressGZIP(file_swego_proto_rawDescData)
+	})
+	return file_swego_proto_rawDescData
+}
+
+var file_swego_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_swego_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_swego_proto_goTypes = []interface{}{
+	(Calendar)(0),                    // 0: swego.Calendar
+	(*LibraryError)(nil),             // 1: swego.LibraryError
+	(*GeoLoc)(nil),                   // 2: swego.GeoLoc
+	(*SidMode)(nil),                  // 3: swego.SidMode
+	(*CalcFlags)(nil),                // 4: swego.CalcFlags
+	(*AyanamsaExFlags)(nil),          // 5: swego.AyanamsaExFlags
+	(*DateConvertFlags)(nil),         // 6: swego.DateConvertFlags
+	(*HousesExFlags)(nil),            // 7: swego.HousesExFlags
+	(*SidTimeFlags)(nil),             // 8: swego.SidTimeFlags
+	(*VersionRequest)(nil),           // 9: swego.VersionRequest
+	(*VersionResponse)(nil),          // 10: swego.VersionResponse
+	(*NameResponse)(nil),             // 11: swego.NameResponse
+	(*PlanetNameRequest)(nil),        // 12: swego.PlanetNameRequest
+	(*CalcRequest)(nil),              // 13: swego.CalcRequest
+	(*CalcPctrRequest)(nil),          // 14: swego.CalcPctrRequest
+	(*CalcResponse)(nil),             // 15: swego.CalcResponse
+	(*CalcBatchRequest)(nil),         // 16: swego.CalcBatchRequest
+	(*CalcBatchResponse)(nil),        // 17: swego.CalcBatchResponse
+	(*FixStarRequest)(nil),           // 18: swego.FixStarRequest
+	(*FixStarResponse)(nil),          // 19: swego.FixStarResponse
+	(*FixStarMagRequest)(nil),        // 20: swego.FixStarMagRequest
+	(*FixStarMagResponse)(nil),       // 21: swego.FixStarMagResponse
+	(*HousesRequest)(nil),            // 22: swego.HousesRequest
+	(*HousesARMCRequest)(nil),        // 23: swego.HousesARMCRequest
+	(*Houses)(nil),                   // 24: swego.Houses
+	(*HousePosRequest)(nil),          // 25: swego.HousePosRequest
+	(*HousePosResponse)(nil),         // 26: swego.HousePosResponse
+	(*HouseNameRequest)(nil),         // 27: swego.HouseNameRequest
+	(*JulDayRequest)(nil),            // 28: swego.JulDayRequest
+	(*JulDayResponse)(nil),           // 29: swego.JulDayResponse
+	(*RevJulRequest)(nil),            // 30: swego.RevJulRequest
+	(*Date)(nil),                     // 31: swego.Date
+	(*DateTime)(nil),                 // 32: swego.DateTime
+	(*UTCToJDRequest)(nil),           // 33: swego.UTCToJDRequest
+	(*UTCToJDResponse)(nil),          // 34: swego.UTCToJDResponse
+	(*JdToUTCRequest)(nil),           // 35: swego.JdToUTCRequest
+	(*UTCTimeZoneRequest)(nil),       // 36: swego.UTCTimeZoneRequest
+	(*DeltaTRequest)(nil),            // 37: swego.DeltaTRequest
+	(*DeltaTResponse)(nil),           // 38: swego.DeltaTResponse
+	(*SidTimeRequest)(nil),           // 39: swego.SidTimeRequest
+	(*SidTimeResponse)(nil),          // 40: swego.SidTimeResponse
+	(*AyanamsaRequest)(nil),          // 41: swego.AyanamsaRequest
+	(*AyanamsaResponse)(nil),         // 42: swego.AyanamsaResponse
+	(*AyanamsaNameRequest)(nil),      // 43: swego.AyanamsaNameRequest
+	(*CalcBatchRequest_Item)(nil),    // 44: swego.CalcBatchRequest.Item
+	(*CalcBatchResponse_Result)(nil), // 45: swego.CalcBatchResponse.Result
+}
+var file_swego_proto_depIdxs = []int32{
+	2,  // 0: swego.CalcFlags.topo_loc:type_name -> swego.GeoLoc
+	3,  // 1: swego.CalcFlags.sid_mode:type_name -> swego.SidMode
+	3,  // 2: swego.AyanamsaExFlags.sid_mode:type_name -> swego.SidMode
+	0,  // 3: swego.DateConvertFlags.calendar:type_name -> swego.Calendar
+	3,  // 4: swego.HousesExFlags.sid_mode:type_name -> swego.SidMode
+	4,  // 5: swego.CalcRequest.flags:type_name -> swego.CalcFlags
+	4,  // 6: swego.CalcPctrRequest.flags:type_name -> swego.CalcFlags
+	44, // 7: swego.CalcBatchRequest.requests:type_name -> swego.CalcBatchRequest.Item
+	45, // 8: swego.CalcBatchResponse.results:type_name -> swego.CalcBatchResponse.Result
+	4,  // 9: swego.FixStarRequest.flags:type_name -> swego.CalcFlags
+	7,  // 10: swego.HousesRequest.flags:type_name -> swego.HousesExFlags
+	31, // 11: swego.JulDayRequest.date:type_name -> swego.Date
+	0,  // 12: swego.JulDayRequest.calendar:type_name -> swego.Calendar
+	0,  // 13: swego.RevJulRequest.calendar:type_name -> swego.Calendar
+	32, // 14: swego.UTCToJDRequest.utc:type_name -> swego.DateTime
+	6,  // 15: swego.UTCToJDRequest.flags:type_name -> swego.DateConvertFlags
+	6,  // 16: swego.JdToUTCRequest.flags:type_name -> swego.DateConvertFlags
+	32, // 17: swego.UTCTimeZoneRequest.date:type_name -> swego.DateTime
+	8,  // 18: swego.SidTimeRequest.flags:type_name -> swego.SidTimeFlags
+	5,  // 19: swego.AyanamsaRequest.flags:type_name -> swego.AyanamsaExFlags
+	4,  // 20: swego.CalcBatchRequest.Item.flags:type_name -> swego.CalcFlags
+	9,  // 21: swego.Ephemeris.Version:input_type -> swego.VersionRequest
+	12, // 22: swego.Ephemeris.PlanetName:input_type -> swego.PlanetNameRequest
+	13, // 23: swego.Ephemeris.Calc:input_type -> swego.CalcRequest
+	13, // 24: swego.Ephemeris.CalcUT:input_type -> swego.CalcRequest
+	14, // 25: swego.Ephemeris.CalcPctr:input_type -> swego.CalcPctrRequest
+	16, // 26: swego.Ephemeris.CalcBatch:input_type -> swego.CalcBatchRequest
+	18, // 27: swego.Ephemeris.FixStar2:input_type -> swego.FixStarRequest
+	18, // 28: swego.Ephemeris.FixStar2UT:input_type -> swego.FixStarRequest
+	20, // 29: swego.Ephemeris.FixStar2Mag:input_type -> swego.FixStarMagRequest
+	22, // 30: swego.Ephemeris.HousesEx2:input_type -> swego.HousesRequest
+	23, // 31: swego.Ephemeris.HousesARMCEx2:input_type -> swego.HousesARMCRequest
+	25, // 32: swego.Ephemeris.HousePos:input_type -> swego.HousePosRequest
+	27, // 33: swego.Ephemeris.HouseName:input_type -> swego.HouseNameRequest
+	28, // 34: swego.Ephemeris.JulDay:input_type -> swego.JulDayRequest
+	30, // 35: swego.Ephemeris.RevJul:input_type -> swego.RevJulRequest
+	33, // 36: swego.Ephemeris.UTCToJD:input_type -> swego.UTCToJDRequest
+	35, // 37: swego.Ephemeris.JdETToUTC:input_type -> swego.JdToUTCRequest
+	35, // 38: swego.Ephemeris.JdUT1ToUTC:input_type -> swego.JdToUTCRequest
+	36, // 39: swego.Ephemeris.UTCTimeZone:input_type -> swego.UTCTimeZoneRequest
+	37, // 40: swego.Ephemeris.DeltaTEx:input_type -> swego.DeltaTRequest
+	39, // 41: swego.Ephemeris.SidTime:input_type -> swego.SidTimeRequest
+	41, // 42: swego.Ephemeris.GetAyanamsaEx:input_type -> swego.AyanamsaRequest
+	41, // 43: swego.Ephemeris.GetAyanamsaExUT:input_type -> swego.AyanamsaRequest
+	43, // 44: swego.Ephemeris.GetAyanamsaName:input_type -> swego.AyanamsaNameRequest
+	10, // 45: swego.Ephemeris.Version:output_type -> swego.VersionResponse
+	11, // 46: swego.Ephemeris.PlanetName:output_type -> swego.NameResponse
+	15, // 47: swego.Ephemeris.Calc:output_type -> swego.CalcResponse
+	15, // 48: swego.Ephemeris.CalcUT:output_type -> swego.CalcResponse
+	15, // 49: swego.Ephemeris.CalcPctr:output_type -> swego.CalcResponse
+	17, // 50: swego.Ephemeris.CalcBatch:output_type -> swego.CalcBatchResponse
+	19, // 51: swego.Ephemeris.FixStar2:output_type -> swego.FixStarResponse
+	19, // 52: swego.Ephemeris.FixStar2UT:output_type -> swego.FixStarResponse
+	21, // 53: swego.Ephemeris.FixStar2Mag:output_type -> swego.FixStarMagResponse
+	24, // 54: swego.Ephemeris.HousesEx2:output_type -> swego.Houses
+	24, // 55: swego.Ephemeris.HousesARMCEx2:output_type -> swego.Houses
+	26, // 56: swego.Ephemeris.HousePos:output_type -> swego.HousePosResponse
+	11, // 57: swego.Ephemeris.HouseName:output_type -> swego.NameResponse
+	29, // 58: swego.Ephemeris.JulDay:output_type -> swego.JulDayResponse
+	31, // 59: swego.Ephemeris.RevJul:output_type -> swego.Date
+	34, // 60: swego.Ephemeris.UTCToJD:output_type -> swego.UTCToJDResponse
+	32, // 61: swego.Ephemeris.JdETToUTC:output_type -> swego.DateTime
+	32, // 62: swego.Ephemeris.JdUT1ToUTC:output_type -> swego.DateTime
+	32, // 63: swego.Ephemeris.UTCTimeZone:output_type -> swego.DateTime
+	38, // 64: swego.Ephemeris.DeltaTEx:output_type -> swego.DeltaTResponse
+	40, // 65: swego.Ephemeris.SidTime:output_type -> swego.SidTimeResponse
+	42, // 66: swego.Ephemeris.GetAyanamsaEx:output_type -> swego.AyanamsaResponse
+	42, // 67: swego.Ephemeris.GetAyanamsaExUT:output_type -> swego.AyanamsaResponse
+	11, // 68: swego.Ephemeris.GetAyanamsaName:output_type -> swego.NameResponse
+	45, // [45:69] is the sub-list for method output_type
+	21, // [21:45] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_swego_proto_init() }
+func file_swego_proto_init() {
+	if File_swego_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_swego_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoLoc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AyanamsaExFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateConvertFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HousesExFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidTimeFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanetNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcPctrRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixStarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixStarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixStarMagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixStarMagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HousesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HousesARMCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Houses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HousePosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HousePosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HouseNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JulDayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JulDayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevJulRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DateTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UTCToJDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UTCToJDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JdToUTCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UTCTimeZoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeltaTRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeltaTResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidTimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidTimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AyanamsaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AyanamsaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AyanamsaNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcBatchRequest_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_swego_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalcBatchResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_swego_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_swego_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_swego_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_swego_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_swego_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_swego_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   45,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_swego_proto_goTypes,
+		DependencyIndexes: file_swego_proto_depIdxs,
+		EnumInfos:         file_swego_proto_enumTypes,
+		MessageInfos:      file_swego_proto_msgTypes,
+	}.Build()
+	File_swego_proto = out.File
+	file_swego_proto_rawDesc = nil
+	file_swego_proto_goTypes = nil
+	file_swego_proto_depIdxs = nil
+}
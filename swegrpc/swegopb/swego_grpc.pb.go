@@ -0,0 +1,968 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: swego.proto
+
+package swegopb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Ephemeris_Version_FullMethodName         = "/swego.Ephemeris/Version"
+	Ephemeris_PlanetName_FullMethodName      = "/swego.Ephemeris/PlanetName"
+	Ephemeris_Calc_FullMethodName            = "/swego.Ephemeris/Calc"
+	Ephemeris_CalcUT_FullMethodName          = "/swego.Ephemeris/CalcUT"
+	Ephemeris_CalcPctr_FullMethodName        = "/swego.Ephemeris/CalcPctr"
+	Ephemeris_CalcBatch_FullMethodName       = "/swego.Ephemeris/CalcBatch"
+	Ephemeris_FixStar2_FullMethodName        = "/swego.Ephemeris/FixStar2"
+	Ephemeris_FixStar2UT_FullMethodName      = "/swego.Ephemeris/FixStar2UT"
+	Ephemeris_FixStar2Mag_FullMethodName     = "/swego.Ephemeris/FixStar2Mag"
+	Ephemeris_HousesEx2_FullMethodName       = "/swego.Ephemeris/HousesEx2"
+	Ephemeris_HousesARMCEx2_FullMethodName   = "/swego.Ephemeris/HousesARMCEx2"
+	Ephemeris_HousePos_FullMethodName        = "/swego.Ephemeris/HousePos"
+	Ephemeris_HouseName_FullMethodName       = "/swego.Ephemeris/HouseName"
+	Ephemeris_JulDay_FullMethodName          = "/swego.Ephemeris/JulDay"
+	Ephemeris_RevJul_FullMethodName          = "/swego.Ephemeris/RevJul"
+	Ephemeris_UTCToJD_FullMethodName         = "/swego.Ephemeris/UTCToJD"
+	Ephemeris_JdETToUTC_FullMethodName       = "/swego.Ephemeris/JdETToUTC"
+	Ephemeris_JdUT1ToUTC_FullMethodName      = "/swego.Ephemeris/JdUT1ToUTC"
+	Ephemeris_UTCTimeZone_FullMethodName     = "/swego.Ephemeris/UTCTimeZone"
+	Ephemeris_DeltaTEx_FullMethodName        = "/swego.Ephemeris/DeltaTEx"
+	Ephemeris_SidTime_FullMethodName         = "/swego.Ephemeris/SidTime"
+	Ephemeris_GetAyanamsaEx_FullMethodName   = "/swego.Ephemeris/GetAyanamsaEx"
+	Ephemeris_GetAyanamsaExUT_FullMethodName = "/swego.Ephemeris/GetAyanamsaExUT"
+	Ephemeris_GetAyanamsaName_FullMethodName = "/swego.Ephemeris/GetAyanamsaName"
+)
+
+// EphemerisClient is the client API for Ephemeris service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EphemerisClient interface {
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	PlanetName(ctx context.Context, in *PlanetNameRequest, opts ...grpc.CallOption) (*NameResponse, error)
+	// Calc computes the position of a planet at a Julian Date in Ephemeris
+	// Time, CalcUT at a Julian Date in Universal Time.
+	Calc(ctx context.Context, in *CalcRequest, opts ...grpc.CallOption) (*CalcResponse, error)
+	CalcUT(ctx context.Context, in *CalcRequest, opts ...grpc.CallOption) (*CalcResponse, error)
+	CalcPctr(ctx context.Context, in *CalcPctrRequest, opts ...grpc.CallOption) (*CalcResponse, error)
+	// CalcBatch computes multiple positions, the errors are returned per
+	// result.
+	CalcBatch(ctx context.Context, in *CalcBatchRequest, opts ...grpc.CallOption) (*CalcBatchResponse, error)
+	FixStar2(ctx context.Context, in *FixStarRequest, opts ...grpc.CallOption) (*FixStarResponse, error)
+	FixStar2UT(ctx context.Context, in *FixStarRequest, opts ...grpc.CallOption) (*FixStarResponse, error)
+	FixStar2Mag(ctx context.Context, in *FixStarMagRequest, opts ...grpc.CallOption) (*FixStarMagResponse, error)
+	HousesEx2(ctx context.Context, in *HousesRequest, opts ...grpc.CallOption) (*Houses, error)
+	HousesARMCEx2(ctx context.Context, in *HousesARMCRequest, opts ...grpc.CallOption) (*Houses, error)
+	HousePos(ctx context.Context, in *HousePosRequest, opts ...grpc.CallOption) (*HousePosResponse, error)
+	HouseName(ctx context.Context, in *HouseNameRequest, opts ...grpc.CallOption) (*NameResponse, error)
+	JulDay(ctx context.Context, in *JulDayRequest, opts ...grpc.CallOption) (*JulDayResponse, error)
+	RevJul(ctx context.Context, in *RevJulRequest, opts ...grpc.CallOption) (*Date, error)
+	UTCToJD(ctx context.Context, in *UTCToJDRequest, opts ...grpc.CallOption) (*UTCToJDResponse, error)
+	JdETToUTC(ctx context.Context, in *JdToUTCRequest, opts ...grpc.CallOption) (*DateTime, error)
+	JdUT1ToUTC(ctx context.Context, in *JdToUTCRequest, opts ...grpc.CallOption) (*DateTime, error)
+	UTCTimeZone(ctx context.Context, in *UTCTimeZoneRequest, opts ...grpc.CallOption) (*DateTime, error)
+	DeltaTEx(ctx context.Context, in *DeltaTRequest, opts ...grpc.CallOption) (*DeltaTResponse, error)
+	SidTime(ctx context.Context, in *SidTimeRequest, opts ...grpc.CallOption) (*SidTimeResponse, error)
+	GetAyanamsaEx(ctx context.Context, in *AyanamsaRequest, opts ...grpc.CallOption) (*AyanamsaResponse, error)
+	GetAyanamsaExUT(ctx context.Context, in *AyanamsaRequest, opts ...grpc.CallOption) (*AyanamsaResponse, error)
+	GetAyanamsaName(ctx context.Context, in *AyanamsaNameRequest, opts ...grpc.CallOption) (*NameResponse, error)
+}
+
+type ephemerisClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEphemerisClient(cc grpc.ClientConnInterface) EphemerisClient {
+	return &ephemerisClient{cc}
+}
+
+func (c *ephemerisClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_Version_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) PlanetName(ctx context.Context, in *PlanetNameRequest, opts ...grpc.CallOption) (*NameResponse, error) {
+	out := new(NameResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_PlanetName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) Calc(ctx context.Context, in *CalcRequest, opts ...grpc.CallOption) (*CalcResponse, error) {
+	out := new(CalcResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_Calc_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) CalcUT(ctx context.Context, in *CalcRequest, opts ...grpc.CallOption) (*CalcResponse, error) {
+	out := new(CalcResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_CalcUT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) CalcPctr(ctx context.Context, in *CalcPctrRequest, opts ...grpc.CallOption) (*CalcResponse, error) {
+	out := new(CalcResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_CalcPctr_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) CalcBatch(ctx context.Context, in *CalcBatchRequest, opts ...grpc.CallOption) (*CalcBatchResponse, error) {
+	out := new(CalcBatchResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_CalcBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) FixStar2(ctx context.Context, in *FixStarRequest, opts ...grpc.CallOption) (*FixStarResponse, error) {
+	out := new(FixStarResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_FixStar2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) FixStar2UT(ctx context.Context, in *FixStarRequest, opts ...grpc.CallOption) (*FixStarResponse, error) {
+	out := new(FixStarResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_FixStar2UT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) FixStar2Mag(ctx context.Context, in *FixStarMagRequest, opts ...grpc.CallOption) (*FixStarMagResponse, error) {
+	out := new(FixStarMagResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_FixStar2Mag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) HousesEx2(ctx context.Context, in *HousesRequest, opts ...grpc.CallOption) (*Houses, error) {
+	out := new(Houses)
+	err := c.cc.Invoke(ctx, Ephemeris_HousesEx2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) HousesARMCEx2(ctx context.Context, in *HousesARMCRequest, opts ...grpc.CallOption) (*Houses, error) {
+	out := new(Houses)
+	err := c.cc.Invoke(ctx, Ephemeris_HousesARMCEx2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) HousePos(ctx context.Context, in *HousePosRequest, opts ...grpc.CallOption) (*HousePosResponse, error) {
+	out := new(HousePosResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_HousePos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) HouseName(ctx context.Context, in *HouseNameRequest, opts ...grpc.CallOption) (*NameResponse, error) {
+	out := new(NameResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_HouseName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) JulDay(ctx context.Context, in *JulDayRequest, opts ...grpc.CallOption) (*JulDayResponse, error) {
+	out := new(JulDayResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_JulDay_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) RevJul(ctx context.Context, in *RevJulRequest, opts ...grpc.CallOption) (*Date, error) {
+	out := new(Date)
+	err := c.cc.Invoke(ctx, Ephemeris_RevJul_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) UTCToJD(ctx context.Context, in *UTCToJDRequest, opts ...grpc.CallOption) (*UTCToJDResponse, error) {
+	out := new(UTCToJDResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_UTCToJD_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) JdETToUTC(ctx context.Context, in *JdToUTCRequest, opts ...grpc.CallOption) (*DateTime, error) {
+	out := new(DateTime)
+	err := c.cc.Invoke(ctx, Ephemeris_JdETToUTC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) JdUT1ToUTC(ctx context.Context, in *JdToUTCRequest, opts ...grpc.CallOption) (*DateTime, error) {
+	out := new(DateTime)
+	err := c.cc.Invoke(ctx, Ephemeris_JdUT1ToUTC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) UTCTimeZone(ctx context.Context, in *UTCTimeZoneRequest, opts ...grpc.CallOption) (*DateTime, error) {
+	out := new(DateTime)
+	err := c.cc.Invoke(ctx, Ephemeris_UTCTimeZone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) DeltaTEx(ctx context.Context, in *DeltaTRequest, opts ...grpc.CallOption) (*DeltaTResponse, error) {
+	out := new(DeltaTResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_DeltaTEx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) SidTime(ctx context.Context, in *SidTimeRequest, opts ...grpc.CallOption) (*SidTimeResponse, error) {
+	out := new(SidTimeResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_SidTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) GetAyanamsaEx(ctx context.Context, in *AyanamsaRequest, opts ...grpc.CallOption) (*AyanamsaResponse, error) {
+	out := new(AyanamsaResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_GetAyanamsaEx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) GetAyanamsaExUT(ctx context.Context, in *AyanamsaRequest, opts ...grpc.CallOption) (*AyanamsaResponse, error) {
+	out := new(AyanamsaResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_GetAyanamsaExUT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ephemerisClient) GetAyanamsaName(ctx context.Context, in *AyanamsaNameRequest, opts ...grpc.CallOption) (*NameResponse, error) {
+	out := new(NameResponse)
+	err := c.cc.Invoke(ctx, Ephemeris_GetAyanamsaName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EphemerisServer is the server API for Ephemeris service.
+// All implementations must embed UnimplementedEphemerisServer
+// for forward compatibility
+type EphemerisServer interface {
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	PlanetName(context.Context, *PlanetNameRequest) (*NameResponse, error)
+	// Calc computes the position of a planet at a Julian Date in Ephemeris
+	// Time, CalcUT at a Julian Date in Universal Time.
+	Calc(context.Context, *CalcRequest) (*CalcResponse, error)
+	CalcUT(context.Context, *CalcRequest) (*CalcResponse, error)
+	CalcPctr(context.Context, *CalcPctrRequest) (*CalcResponse, error)
+	// CalcBatch computes multiple positions, the errors are returned per
+	// result.
+	CalcBatch(context.Context, *CalcBatchRequest) (*CalcBatchResponse, error)
+	FixStar2(context.Context, *FixStarRequest) (*FixStarResponse, error)
+	FixStar2UT(context.Context, *FixStarRequest) (*FixStarResponse, error)
+	FixStar2Mag(context.Context, *FixStarMagRequest) (*FixStarMagResponse, error)
+	HousesEx2(context.Context, *HousesRequest) (*Houses, error)
+	HousesARMCEx2(context.Context, *HousesARMCRequest) (*Houses, error)
+	HousePos(context.Context, *HousePosRequest) (*HousePosResponse, error)
+	HouseName(context.Context, *HouseNameRequest) (*NameResponse, error)
+	JulDay(context.Context, *JulDayRequest) (*JulDayResponse, error)
+	RevJul(context.Context, *RevJulRequest) (*Date, error)
+	UTCToJD(context.Context, *UTCToJDRequest) (*UTCToJDResponse, error)
+	JdETToUTC(context.Context, *JdToUTCRequest) (*DateTime, error)
+	JdUT1ToUTC(context.Context, *JdToUTCRequest) (*DateTime, error)
+	UTCTimeZone(context.Context, *UTCTimeZoneRequest) (*DateTime, error)
+	DeltaTEx(context.Context, *DeltaTRequest) (*DeltaTResponse, error)
+	SidTime(context.Context, *SidTimeRequest) (*SidTimeResponse, error)
+	GetAyanamsaEx(context.Context, *AyanamsaRequest) (*AyanamsaResponse, error)
+	GetAyanamsaExUT(context.Context, *AyanamsaRequest) (*AyanamsaResponse, error)
+	GetAyanamsaName(context.Context, *AyanamsaNameRequest) (*NameResponse, error)
+	mustEmbedUnimplementedEphemerisServer()
+}
+
+// UnimplementedEphemerisServer must be embedded to have forward compatible implementations.
+type UnimplementedEphemerisServer struct {
+}
+
+func (UnimplementedEphemerisServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (UnimplementedEphemerisServer) PlanetName(context.Context, *PlanetNameRequest) (*NameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanetName not implemented")
+}
+func (UnimplementedEphemerisServer) Calc(context.Context, *CalcRequest) (*CalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Calc not implemented")
+}
+func (UnimplementedEphemerisServer) CalcUT(context.Context, *CalcRequest) (*CalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcUT not implemented")
+}
+func (UnimplementedEphemerisServer) CalcPctr(context.Context, *CalcPctrRequest) (*CalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcPctr not implemented")
+}
+func (UnimplementedEphemerisServer) CalcBatch(context.Context, *CalcBatchRequest) (*CalcBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcBatch not implemented")
+}
+func (UnimplementedEphemerisServer) FixStar2(context.Context, *FixStarRequest) (*FixStarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixStar2 not implemented")
+}
+func (UnimplementedEphemerisServer) FixStar2UT(context.Context, *FixStarRequest) (*FixStarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixStar2UT not implemented")
+}
+func (UnimplementedEphemerisServer) FixStar2Mag(context.Context, *FixStarMagRequest) (*FixStarMagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixStar2Mag not implemented")
+}
+func (UnimplementedEphemerisServer) HousesEx2(context.Context, *HousesRequest) (*Houses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HousesEx2 not implemented")
+}
+func (UnimplementedEphemerisServer) HousesARMCEx2(context.Context, *HousesARMCRequest) (*Houses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HousesARMCEx2 not implemented")
+}
+func (UnimplementedEphemerisServer) HousePos(context.Context, *HousePosRequest) (*HousePosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HousePos not implemented")
+}
+func (UnimplementedEphemerisServer) HouseName(context.Context, *HouseNameRequest) (*NameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HouseName not implemented")
+}
+func (UnimplementedEphemerisServer) JulDay(context.Context, *JulDayRequest) (*JulDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JulDay not implemented")
+}
+func (UnimplementedEphemerisServer) RevJul(context.Context, *RevJulRequest) (*Date, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevJul not implemented")
+}
+func (UnimplementedEphemerisServer) UTCToJD(context.Context, *UTCToJDRequest) (*UTCToJDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UTCToJD not implemented")
+}
+func (UnimplementedEphemerisServer) JdETToUTC(context.Context, *JdToUTCRequest) (*DateTime, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JdETToUTC not implemented")
+}
+func (UnimplementedEphemerisServer) JdUT1ToUTC(context.Context, *JdToUTCRequest) (*DateTime, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JdUT1ToUTC not implemented")
+}
+func (UnimplementedEphemerisServer) UTCTimeZone(context.Context, *UTCTimeZoneRequest) (*DateTime, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UTCTimeZone not implemented")
+}
+func (UnimplementedEphemerisServer) DeltaTEx(context.Context, *DeltaTRequest) (*DeltaTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeltaTEx not implemented")
+}
+func (UnimplementedEphemerisServer) SidTime(context.Context, *SidTimeRequest) (*SidTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SidTime not implemented")
+}
+func (UnimplementedEphemerisServer) GetAyanamsaEx(context.Context, *AyanamsaRequest) (*AyanamsaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAyanamsaEx not implemented")
+}
+func (UnimplementedEphemerisServer) GetAyanamsaExUT(context.Context, *AyanamsaRequest) (*AyanamsaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAyanamsaExUT not implemented")
+}
+func (UnimplementedEphemerisServer) GetAyanamsaName(context.Context, *AyanamsaNameRequest) (*NameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAyanamsaName not implemented")
+}
+func (UnimplementedEphemerisServer) mustEmbedUnimplementedEphemerisServer() {}
+
+// UnsafeEphemerisServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EphemerisServer will
+// result in compilation errors.
+type UnsafeEphemerisServer interface {
+	mustEmbedUnimplementedEphemerisServer()
+}
+
+func RegisterEphemerisServer(s grpc.ServiceRegistrar, srv EphemerisServer) {
+	s.RegisterService(&Ephemeris_ServiceDesc, srv)
+}
+
+func _Ephemeris_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_Version_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).Version(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_PlanetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanetNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).PlanetName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_PlanetName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).PlanetName(ctx, req.(*PlanetNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_Calc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).Calc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_Calc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).Calc(ctx, req.(*CalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_CalcUT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).CalcUT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_CalcUT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).CalcUT(ctx, req.(*CalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_CalcPctr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalcPctrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).CalcPctr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_CalcPctr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).CalcPctr(ctx, req.(*CalcPctrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_CalcBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalcBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).CalcBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_CalcBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).CalcBatch(ctx, req.(*CalcBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_FixStar2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FixStarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).FixStar2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_FixStar2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).FixStar2(ctx, req.(*FixStarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_FixStar2UT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FixStarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).FixStar2UT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_FixStar2UT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).FixStar2UT(ctx, req.(*FixStarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_FixStar2Mag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FixStarMagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).FixStar2Mag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_FixStar2Mag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).FixStar2Mag(ctx, req.(*FixStarMagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_HousesEx2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).HousesEx2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_HousesEx2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).HousesEx2(ctx, req.(*HousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_HousesARMCEx2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HousesARMCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).HousesARMCEx2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_HousesARMCEx2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).HousesARMCEx2(ctx, req.(*HousesARMCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_HousePos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HousePosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).HousePos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_HousePos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).HousePos(ctx, req.(*HousePosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_HouseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).HouseName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_HouseName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).HouseName(ctx, req.(*HouseNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_JulDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JulDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).JulDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_JulDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).JulDay(ctx, req.(*JulDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_RevJul_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevJulRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).RevJul(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_RevJul_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).RevJul(ctx, req.(*RevJulRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_UTCToJD_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UTCToJDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).UTCToJD(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_UTCToJD_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).UTCToJD(ctx, req.(*UTCToJDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_JdETToUTC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JdToUTCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).JdETToUTC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_JdETToUTC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).JdETToUTC(ctx, req.(*JdToUTCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_JdUT1ToUTC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JdToUTCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).JdUT1ToUTC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_JdUT1ToUTC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).JdUT1ToUTC(ctx, req.(*JdToUTCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_UTCTimeZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UTCTimeZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).UTCTimeZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_UTCTimeZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).UTCTimeZone(ctx, req.(*UTCTimeZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_DeltaTEx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeltaTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).DeltaTEx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_DeltaTEx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).DeltaTEx(ctx, req.(*DeltaTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_SidTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SidTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).SidTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_SidTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).SidTime(ctx, req.(*SidTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_GetAyanamsaEx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AyanamsaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).GetAyanamsaEx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_GetAyanamsaEx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).GetAyanamsaEx(ctx, req.(*AyanamsaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_GetAyanamsaExUT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AyanamsaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).GetAyanamsaExUT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_GetAyanamsaExUT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).GetAyanamsaExUT(ctx, req.(*AyanamsaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ephemeris_GetAyanamsaName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AyanamsaNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EphemerisServer).GetAyanamsaName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ephemeris_GetAyanamsaName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EphemerisServer).GetAyanamsaName(ctx, req.(*AyanamsaNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Ephemeris_ServiceDesc is the grpc.ServiceDesc for Ephemeris service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Ephemeris_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "swego.Ephemeris",
+	HandlerType: (*EphemerisServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _Ephemeris_Version_Handler,
+		},
+		{
+			MethodName: "PlanetName",
+			Handler:    _Ephemeris_PlanetName_Handler,
+		},
+		{
+			MethodName: "Calc",
+			Handler:    _Ephemeris_Calc_Handler,
+		},
+		{
+			MethodName: "CalcUT",
+			Handler:    _Ephemeris_CalcUT_Handler,
+		},
+		{
+			MethodName: "CalcPctr",
+			Handler:    _Ephemeris_CalcPctr_Handler,
+		},
+		{
+			MethodName: "CalcBatch",
+			Handler:    _Ephemeris_CalcBatch_Handler,
+		},
+		{
+			MethodName: "FixStar2",
+			Handler:    _Ephemeris_FixStar2_Handler,
+		},
+		{
+			MethodName: "FixStar2UT",
+			Handler:    _Ephemeris_FixStar2UT_Handler,
+		},
+		{
+			MethodName: "FixStar2Mag",
+			Handler:    _Ephemeris_FixStar2Mag_Handler,
+		},
+		{
+			MethodName: "HousesEx2",
+			Handler:    _Ephemeris_HousesEx2_Handler,
+		},
+		{
+			MethodName: "HousesARMCEx2",
+			Handler:    _Ephemeris_HousesARMCEx2_Handler,
+		},
+		{
+			MethodName: "HousePos",
+			Handler:    _Ephemeris_HousePos_Handler,
+		},
+		{
+			MethodName: "HouseName",
+			Handler:    _Ephemeris_HouseName_Handler,
+		},
+		{
+			MethodName: "JulDay",
+			Handler:    _Ephemeris_JulDay_Handler,
+		},
+		{
+			MethodName: "RevJul",
+			Handler:    _Ephemeris_RevJul_Handler,
+		},
+		{
+			MethodName: "UTCToJD",
+			Handler:    _Ephemeris_UTCToJD_Handler,
+		},
+		{
+			MethodName: "JdETToUTC",
+			Handler:    _Ephemeris_JdETToUTC_Handler,
+		},
+		{
+			MethodName: "JdUT1ToUTC",
+			Handler:    _Ephemeris_JdUT1ToUTC_Handler,
+		},
+		{
+			MethodName: "UTCTimeZone",
+			Handler:    _Ephemeris_UTCTimeZone_Handler,
+		},
+		{
+			MethodName: "DeltaTEx",
+			Handler:    _Ephemeris_DeltaTEx_Handler,
+		},
+		{
+			MethodName: "SidTime",
+			Handler:    _Ephemeris_SidTime_Handler,
+		},
+		{
+			MethodName: "GetAyanamsaEx",
+			Handler:    _Ephemeris_GetAyanamsaEx_Handler,
+		},
+		{
+			MethodName: "GetAyanamsaExUT",
+			Handler:    _Ephemeris_GetAyanamsaExUT_Handler,
+		},
+		{
+			MethodName: "GetAyanamsaName",
+			Handler:    _Ephemeris_GetAyanamsaName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "swego.proto",
+}
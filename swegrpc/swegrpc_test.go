@@ -0,0 +1,199 @@
+package swegrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/howesteve/swego"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testSwe implements the methods of swego.Interface used by the tests, the
+// other methods panic.
+type testSwe struct {
+	swego.Interface
+	err   error
+	calls []interface{} // arguments of the calls
+}
+
+func (swe *testSwe) CalcUT(ut float64, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	swe.calls = append(swe.calls, []interface{}{ut, pl, fl})
+	if swe.err != nil {
+		return nil, -1, swe.err
+	}
+
+	return []float64{1, 2, 3, 4, 5, 6}, int(fl.Flags), nil
+}
+
+func (swe *testSwe) CalcBatch(requests []swego.CalcRequest) []swego.CalcResult {
+	swe.calls = append(swe.calls, []interface{}{requests})
+	return []swego.CalcResult{
+		{XX: []float64{1, 2, 3, 4, 5, 6}, Flags: 4},
+		{XX: make([]float64, 6), Flags: -1, Err: swego.Error("illegal planet number")},
+	}
+}
+
+func (swe *testSwe) HousesEx2(ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) (swego.Houses, error) {
+	swe.calls = append(swe.calls, []interface{}{ut, fl, geolat, geolon, hsys})
+	return swego.Houses{Cusps: make([]float64, 13), Asc: 1, MCSpeed: 2, CoAsc2: 3, Warning: "fallback"}, nil
+}
+
+func (swe *testSwe) UTCToJD(y, m, d, h, i int, s float64, fl *swego.DateConvertFlags) (float64, float64, error) {
+	swe.calls = append(swe.calls, []interface{}{y, m, d, h, i, s, fl})
+	return 2451545.0007, 2451545, nil
+}
+
+func (swe *testSwe) GetAyanamsaExUT(ut float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	swe.calls = append(swe.calls, []interface{}{ut, fl})
+	return 23.85, swe.err
+}
+
+// newTestClient serves swe via an in-process listener and returns a client
+// connected to it.
+func newTestClient(t *testing.T, swe swego.Interface) *Client {
+	ln := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	Register(s, swe)
+	go s.Serve(ln)
+
+	dial := func(ctx context.Context, addr string) (net.Conn, error) { return ln.DialContext(ctx) }
+	cc, err := grpc.Dial("bufnet", grpc.WithContextDialer(dial), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	t.Cleanup(func() {
+		cc.Close()
+		s.Stop()
+	})
+
+	return NewClient(cc)
+}
+
+func TestClient_CalcUT(t *testing.T) {
+	swe := new(testSwe)
+	c := newTestClient(t, swe)
+
+	fl := &swego.CalcFlags{
+		Flags:   swego.FlagEphMoshier | swego.FlagSpeed | swego.FlagTopo,
+		TopoLoc: &swego.GeoLoc{Long: 5.1, Lat: 52.1, Alt: 10},
+		SidMode: &swego.SidMode{Mode: swego.SidmLahiri, T0: 1, AyanT0: 2},
+		JPLFile: "de441.eph",
+	}
+
+	fl.SetDeltaT(60.0 / 86400)
+
+	xx, cfl, err := c.CalcUT(context.Background(), 2451545, swego.Mars, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if want := []float64{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(xx, want) || cfl != int(fl.Flags) {
+		t.Errorf("CalcUT = %v, %d, want: %v, %d", xx, cfl, want, fl.Flags)
+	}
+
+	want := []interface{}{2451545.0, swego.Mars, fl}
+	if len(swe.calls) != 1 || !reflect.DeepEqual(swe.calls[0], want) {
+		t.Errorf("CalcUT calls = %v, want: [%v]", swe.calls, want)
+	}
+}
+
+func TestClient_nilFlags(t *testing.T) {
+	swe := new(testSwe)
+	c := newTestClient(t, swe)
+
+	if _, _, err := c.CalcUT(context.Background(), 2451545, swego.Sun, nil); err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if fl := swe.calls[0].([]interface{})[2]; !reflect.DeepEqual(fl, new(swego.CalcFlags)) {
+		t.Errorf("CalcUT fl = %v, want: %v", fl, new(swego.CalcFlags))
+	}
+
+	et, ut, err := c.UTCToJD(context.Background(), 2000, 1, 1, 12, 0, 0, nil)
+	if err != nil || et != 2451545.0007 || ut != 2451545 {
+		t.Errorf("UTCToJD = %v, %v, %v, want: 2451545.0007, 2451545, nil", et, ut, err)
+	}
+
+	want := []interface{}{2000, 1, 1, 12, 0, 0.0, new(swego.DateConvertFlags)}
+	if !reflect.DeepEqual(swe.calls[1], want) {
+		t.Errorf("UTCToJD args = %v, want: %v", swe.calls[1], want)
+	}
+}
+
+func TestClient_HousesEx2(t *testing.T) {
+	swe := new(testSwe)
+	c := newTestClient(t, swe)
+
+	fl := &swego.HousesExFlags{Flags: swego.FlagSidereal, SidMode: &swego.SidMode{Mode: swego.SidmLahiri}}
+	h, err := c.HousesEx2(context.Background(), 2451545, fl, 52.1, 5.1, swego.Koch)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if want, _ := swe.HousesEx2(0, nil, 0, 0, 0); !reflect.DeepEqual(h, want) {
+		t.Errorf("HousesEx2 = %+v, want: %+v", h, want)
+	}
+
+	if want := []interface{}{2451545.0, fl, 52.1, 5.1, swego.Koch}; !reflect.DeepEqual(swe.calls[0], want) {
+		t.Errorf("HousesEx2 args = %v, want: %v", swe.calls[0], want)
+	}
+
+	_, err = c.HousesEx2(context.Background(), 2451545, nil, 52.1, 5.1, swego.HSys('Z'))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("err = %v, want status code %s", err, codes.InvalidArgument)
+	}
+}
+
+func TestClient_CalcBatch(t *testing.T) {
+	swe := new(testSwe)
+	c := newTestClient(t, swe)
+
+	requests := []swego.CalcRequest{
+		{JD: 2451545, UT: true, Planet: swego.Sun, Flags: &swego.CalcFlags{Flags: swego.FlagEphMoshier}},
+		{JD: 2451545, Planet: -5, Flags: new(swego.CalcFlags)},
+	}
+
+	got := c.CalcBatch(context.Background(), requests)
+	want := []swego.CalcResult{
+		{XX: []float64{1, 2, 3, 4, 5, 6}, Flags: 4},
+		{XX: make([]float64, 6), Flags: -1, Err: swego.Error("illegal planet number")},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CalcBatch = %v, want: %v", got, want)
+	}
+
+	if args := swe.calls[0].([]interface{})[0]; !reflect.DeepEqual(args, requests) {
+		t.Errorf("CalcBatch args = %v, want: %v", args, requests)
+	}
+}
+
+func TestClient_errors(t *testing.T) {
+	libErr := swego.Error("illegal planet number")
+	c := newTestClient(t, &testSwe{err: libErr})
+
+	if _, _, err := c.CalcUT(context.Background(), 2451545, -5, nil); err != libErr {
+		t.Errorf("err = %v, want: %v", err, libErr)
+	}
+
+	c = newTestClient(t, &testSwe{err: errors.New("worker: unexpected exit")})
+	if _, err := c.GetAyanamsaExUT(context.Background(), 2451545, nil); status.Code(err) != codes.Internal {
+		t.Errorf("err = %v, want status code %s", err, codes.Internal)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.GetAyanamsaExUT(ctx, 2451545, nil); status.Code(err) != codes.Canceled {
+		t.Errorf("err = %v, want status code %s", err, codes.Canceled)
+	}
+}
@@ -0,0 +1,1864 @@
+package swerker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/howesteve/swego"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// Client implements swego.Interface by translating each method to a Call that
+// is dispatched to a backend worker. The library state a method depends on is
+// passed along as context calls, so a Client can be used with any worker in a
+// pool regardless of previous calls.
+type Client struct {
+	d   Dispatcher
+	ctx context.Context // set by ContextClient
+}
+
+var _ swego.Interface = (*Client)(nil) // assert interface
+
+// NewClient returns a Client that dispatches calls via dispatcher d.
+func NewClient(d Dispatcher) *Client {
+	return &Client{d: d}
+}
+
+// FuncNotFoundError is returned if a function is not exposed by the backend.
+type FuncNotFoundError struct {
+	Name string
+}
+
+func (e *FuncNotFoundError) Error() string {
+	return fmt.Sprintf("swerker: function %q not found", e.Name)
+}
+
+// ResultError is returned if the result of a call can not be decoded.
+type ResultError struct {
+	Name string
+	Err  error
+}
+
+func (e *ResultError) Error() string {
+	return fmt.Sprintf("swerker: invalid result of %q: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the underlying decode error.
+func (e *ResultError) Unwrap() error { return e.Err }
+
+// resetDeltaT is the value of SE_DELTAT_AUTOMATIC.
+const resetDeltaT = -1e-10
+
+// errFlag is the value of ERR returned by library functions on error.
+const errFlag = -1
+
+// callCtx collects context calls for a single call.
+type callCtx struct {
+	d     Dispatcher
+	calls []*CtxCall
+	err   error
+}
+
+func (cc *callCtx) add(name string, a msgp.Raw) {
+	if cc.err != nil {
+		return
+	}
+
+	idx, ok := cc.d.IndexForName(name)
+	if !ok {
+		cc.err = &FuncNotFoundError{name}
+		return
+	}
+
+	cc.calls = append(cc.calls, &CtxCall{Func: idx, Args: a})
+}
+
+func (cc *callCtx) setTopo(loc *swego.GeoLoc) {
+	var lng, lat, alt float64
+	if loc != nil {
+		lng = loc.Long
+		lat = loc.Lat
+		alt = loc.Alt
+	}
+
+	cc.add("swe_set_topo", args(lng, lat, alt))
+}
+
+func (cc *callCtx) setSidMode(sm *swego.SidMode) {
+	var mode swego.Ayanamsa
+	var t0, ayanT0 float64
+	if sm != nil {
+		mode = sm.Mode
+		t0 = sm.T0
+		ayanT0 = sm.AyanT0
+	}
+
+	cc.add("swe_set_sid_mode", args(int32(mode), t0, ayanT0))
+}
+
+func (cc *callCtx) setDeltaT(dt *float64) {
+	f := resetDeltaT
+	if dt != nil {
+		f = *dt
+	}
+
+	cc.add("swe_set_delta_t_userdef", args(f))
+}
+
+// calcFlags adds the context calls that represent the library state of
+// calculation flags fl and returns the flags passed to the library function.
+func (cc *callCtx) calcFlags(fl *swego.CalcFlags) int32 {
+	if fl == nil {
+		cc.setDeltaT(nil)
+		return 0
+	}
+
+	if (fl.Flags & swego.FlagTopo) == swego.FlagTopo {
+		cc.setTopo(fl.TopoLoc)
+	}
+
+	if (fl.Flags & swego.FlagSidereal) == swego.FlagSidereal {
+		cc.setSidMode(fl.SidMode)
+	}
+
+	if (fl.Flags&swego.FlagEphJPL) > 0 && fl.JPLFile != "" {
+		cc.add("swe_set_jpl_file", args(fl.JPLFile))
+	}
+
+	cc.setDeltaT(fl.DeltaT)
+	return fl.Flags
+}
+
+// eclipseFlags adds the context calls that represent the library state of
+// eclipse flags fl and returns the flags passed to the library function.
+func (cc *callCtx) eclipseFlags(fl *swego.EclipseFlags) int32 {
+	if fl == nil {
+		cc.setDeltaT(nil)
+		return 0
+	}
+
+	if (fl.Flags&swego.FlagEphJPL) > 0 && fl.JPLFile != "" {
+		cc.add("swe_set_jpl_file", args(fl.JPLFile))
+	}
+
+	cc.setDeltaT(fl.DeltaT)
+	return fl.Flags
+}
+
+// args encodes the arguments of a call as msgpack array.
+func args(v ...interface{}) msgp.Raw {
+	b := msgp.AppendArrayHeader(nil, uint32(len(v)))
+	for _, v := range v {
+		switch v := v.(type) {
+		case int:
+			b = msgp.AppendInt(b, v)
+		case int32:
+			b = msgp.AppendInt32(b, v)
+		case float64:
+			b = msgp.AppendFloat64(b, v)
+		case string:
+			b = msgp.AppendString(b, v)
+		case []float64:
+			b = msgp.AppendArrayHeader(b, uint32(len(v)))
+			for _, f := range v {
+				b = msgp.AppendFloat64(b, f)
+			}
+		default:
+			panic(fmt.Sprintf("swerker: unsupported argument type %T", v))
+		}
+	}
+
+	return b
+}
+
+func (c *Client) newCallCtx() *callCtx {
+	return &callCtx{d: c.d}
+}
+
+// call dispatches function name with arguments a and context calls cc, cc may
+// be nil. The result array is returned as decoder.
+func (c *Client) call(cc *callCtx, name string, a msgp.Raw) (*decoder, error) {
+	var calls []*CtxCall
+	if cc != nil {
+		if cc.err != nil {
+			return nil, cc.err
+		}
+
+		calls = cc.calls
+	}
+
+	idx, ok := c.d.IndexForName(name)
+	if !ok {
+		return nil, &FuncNotFoundError{name}
+	}
+
+	data, err := c.dispatch(&Call{Ctx: calls, Func: idx, Args: a})
+	if err != nil {
+		return nil, err
+	}
+
+	return &decoder{name: name, data: data}, nil
+}
+
+func (c *Client) dispatch(call *Call) (msgp.Raw, error) {
+	if c.ctx == nil {
+		return c.d.Dispatch(call)
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if d, ok := c.d.(ContextDispatcher); ok {
+		return d.DispatchContext(c.ctx, call)
+	}
+
+	return c.d.Dispatch(call)
+}
+
+// decoder decodes the values of a result array. The first decode error is
+// retained, all subsequent reads return zero values.
+type decoder struct {
+	name string
+	data []byte
+	err  error
+}
+
+func (dec *decoder) array(n uint32) {
+	if dec.err != nil {
+		return
+	}
+
+	var size uint32
+	size, dec.data, dec.err = msgp.ReadArrayHeaderBytes(dec.data)
+	if dec.err == nil && size != n {
+		dec.err = msgp.ArrayError{Wanted: n, Got: size}
+	}
+}
+
+// arrayMax decodes an array header of at most n values and returns its size.
+func (dec *decoder) arrayMax(n uint32) uint32 {
+	if dec.err != nil {
+		return 0
+	}
+
+	var size uint32
+	size, dec.data, dec.err = msgp.ReadArrayHeaderBytes(dec.data)
+	if dec.err == nil && size > n {
+		dec.err = msgp.ArrayError{Wanted: n, Got: size}
+	}
+
+	return size
+}
+
+func (dec *decoder) int() (i int) {
+	if dec.err != nil {
+		return 0
+	}
+
+	var v int64
+	v, dec.data, dec.err = msgp.ReadInt64Bytes(dec.data)
+	return int(v)
+}
+
+func (dec *decoder) int32() int32 { return int32(dec.int()) }
+
+func (dec *decoder) float() (f float64) {
+	if dec.err != nil {
+		return 0
+	}
+
+	f, dec.data, dec.err = msgp.ReadFloat64Bytes(dec.data)
+	return f
+}
+
+func (dec *decoder) floats() []float64 {
+	if dec.err != nil {
+		return nil
+	}
+
+	var size uint32
+	size, dec.data, dec.err = msgp.ReadArrayHeaderBytes(dec.data)
+	if dec.err != nil {
+		return nil
+	}
+
+	s := make([]float64, size)
+	for i := range s {
+		s[i] = dec.float()
+	}
+
+	return s
+}
+
+// floatsN decodes an array of exactly n floats.
+func (dec *decoder) floatsN(n uint32) []float64 {
+	s := dec.floats()
+	if dec.err == nil && uint32(len(s)) != n {
+		dec.err = msgp.ArrayError{Wanted: n, Got: uint32(len(s))}
+	}
+
+	return s
+}
+
+func (dec *decoder) string() (s string) {
+	if dec.err != nil {
+		return ""
+	}
+
+	s, dec.data, dec.err = msgp.ReadStringBytes(dec.data)
+	return s
+}
+
+// done returns the decode error, if any.
+func (dec *decoder) done() error {
+	if dec.err != nil {
+		return &ResultError{dec.name, dec.err}
+	}
+
+	return nil
+}
+
+// libError returns a swego.Error if the return value rv of a library function
+// equals ERR.
+func libError(rv int, msg string) error {
+	if rv == errFlag {
+		return swego.Error(msg)
+	}
+
+	return nil
+}
+
+// Version implements swego.Interface.
+func (c *Client) Version() (string, error) {
+	dec, err := c.call(nil, "swe_version", nil)
+	if err != nil {
+		return "", err
+	}
+
+	dec.array(1)
+	v := dec.string()
+	return v, dec.done()
+}
+
+// PlanetName implements swego.Interface.
+func (c *Client) PlanetName(pl swego.Planet) (string, error) {
+	dec, err := c.call(nil, "swe_get_planet_name", args(int(pl)))
+	if err != nil {
+		return "", err
+	}
+
+	dec.array(1)
+	name := dec.string()
+	return name, dec.done()
+}
+
+func (c *Client) calc(name string, jd float64, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, name, args(jd, int(pl), flags))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dec.array(3)
+	cfl := dec.int()
+	xx := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return nil, 0, err
+	}
+
+	return xx, cfl, libError(cfl, msg)
+}
+
+// Calc implements swego.Interface.
+func (c *Client) Calc(et float64, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	return c.calc("swe_calc", et, pl, fl)
+}
+
+// CalcUT implements swego.Interface.
+func (c *Client) CalcUT(ut float64, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	return c.calc("swe_calc_ut", ut, pl, fl)
+}
+
+// maxMultiSize is the size limit of the calls of a rpc_multi message, it is
+// below the request size limit of the worker.
+const maxMultiSize = 1<<20 - 64
+
+// CalcBatch implements swego.Interface. The requests are sent as calls of a
+// single rpc_multi message, unless it exceeds the size limits of the worker,
+// then multiple messages are sent. If the backend does not implement
+// rpc_multi, each request is sent separately.
+func (c *Client) CalcBatch(requests []swego.CalcRequest) []swego.CalcResult {
+	results := make([]swego.CalcResult, len(requests))
+	if _, ok := c.d.IndexForName("rpc_multi"); !ok {
+		for i, r := range requests {
+			res := &results[i]
+			res.XX, res.Flags, res.Err = c.calc(calcName(r.UT), r.JD, r.Planet, r.Flags)
+		}
+
+		return results
+	}
+
+	for i := 0; i < len(requests); {
+		n, err := c.calcMulti(requests[i:], results[i:])
+		if err != nil {
+			setBatchError(results[i:], err)
+			break
+		}
+
+		i += n
+	}
+
+	return results
+}
+
+// calcMulti sends requests in a single rpc_multi message and returns the
+// number of requests that are calculated by the worker. The library state is
+// only set if the flags differ from the previous request.
+func (c *Client) calcMulti(requests []swego.CalcRequest, results []swego.CalcResult) (int, error) {
+	var calls []byte
+	var n uint32
+	var fl *swego.CalcFlags
+	var flags int32
+	for i, r := range requests {
+		call := new(Call)
+		if i == 0 || r.Flags != fl {
+			cc := c.newCallCtx()
+			flags = cc.calcFlags(r.Flags)
+			if cc.err != nil {
+				return 0, cc.err
+			}
+
+			fl = r.Flags
+			call.Ctx = cc.calls
+		}
+
+		name := calcName(r.UT)
+		idx, ok := c.d.IndexForName(name)
+		if !ok {
+			return 0, &FuncNotFoundError{name}
+		}
+
+		call.Func = idx
+		call.Args = args(r.JD, int(r.Planet), flags)
+
+		data, err := call.MarshalMsg(nil)
+		if err != nil {
+			return 0, err
+		}
+
+		if n > 0 && len(calls)+len(data) > maxMultiSize {
+			break
+		}
+
+		calls = append(calls, data...)
+		n++
+	}
+
+	a := msgp.AppendArrayHeader(nil, 1)
+	a = msgp.AppendArrayHeader(a, n)
+	a = append(a, calls...)
+
+	dec, err := c.call(nil, "rpc_multi", a)
+	if err != nil {
+		return 0, err
+	}
+
+	size := dec.arrayMax(n)
+	for i := uint32(0); i < size && dec.err == nil; i++ {
+		dec.array(3)
+		cfl := dec.int()
+		xx := dec.floats()
+		msg := dec.string()
+		results[i] = swego.CalcResult{XX: xx, Flags: cfl, Err: libError(cfl, msg)}
+	}
+
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	if size == 0 {
+		return 0, &ResultError{"rpc_multi", msgp.ArrayError{Wanted: n, Got: 0}}
+	}
+
+	return int(size), nil
+}
+
+func calcName(ut bool) string {
+	if ut {
+		return "swe_calc_ut"
+	}
+
+	return "swe_calc"
+}
+
+func setBatchError(results []swego.CalcResult, err error) {
+	for i := range results {
+		results[i].Err = err
+	}
+}
+
+// CalcPctr implements swego.Interface.
+func (c *Client) CalcPctr(et float64, pl, center swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, "swe_calc_pctr", args(et, int(pl), int(center), flags))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dec.array(3)
+	cfl := dec.int()
+	xx := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return nil, 0, err
+	}
+
+	return xx, cfl, libError(cfl, msg)
+}
+
+func (c *Client) fixStar(name, star string, jd float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, name, args(star, jd, flags))
+	if err != nil {
+		return "", nil, 0, err
+	}
+
+	dec.array(4)
+	star = dec.string()
+	cfl := dec.int()
+	xx := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return "", nil, 0, err
+	}
+
+	return star, xx, cfl, libError(cfl, msg)
+}
+
+// FixStar implements swego.Interface.
+func (c *Client) FixStar(star string, et float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	return c.fixStar("swe_fixstar", star, et, fl)
+}
+
+// FixStarUT implements swego.Interface.
+func (c *Client) FixStarUT(star string, ut float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	return c.fixStar("swe_fixstar_ut", star, ut, fl)
+}
+
+// FixStar2 implements swego.Interface.
+func (c *Client) FixStar2(star string, et float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	return c.fixStar("swe_fixstar2", star, et, fl)
+}
+
+// FixStar2UT implements swego.Interface.
+func (c *Client) FixStar2UT(star string, ut float64, fl *swego.CalcFlags) (string, []float64, int, error) {
+	return c.fixStar("swe_fixstar2_ut", star, ut, fl)
+}
+
+func (c *Client) fixStarMag(name, star string) (string, float64, error) {
+	dec, err := c.call(nil, name, args(star))
+	if err != nil {
+		return "", 0, err
+	}
+
+	dec.array(4)
+	star = dec.string()
+	rv := dec.int()
+	mag := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return "", 0, err
+	}
+
+	return star, mag, libError(rv, msg)
+}
+
+// FixStarMag implements swego.Interface.
+func (c *Client) FixStarMag(star string) (string, float64, error) {
+	return c.fixStarMag("swe_fixstar_mag", star)
+}
+
+// FixStar2Mag implements swego.Interface.
+func (c *Client) FixStar2Mag(star string) (string, float64, error) {
+	return c.fixStarMag("swe_fixstar2_mag", star)
+}
+
+func (c *Client) nodAps(name string, jd float64, pl swego.Planet, fl *swego.CalcFlags, m swego.NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, name, args(jd, int(pl), flags, int32(m)))
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	dec.array(6)
+	rv := dec.int()
+	nasc = dec.floats()
+	ndsc = dec.floats()
+	peri = dec.floats()
+	aphe = dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return nasc, ndsc, peri, aphe, libError(rv, msg)
+}
+
+// NodAps implements swego.Interface.
+func (c *Client) NodAps(et float64, pl swego.Planet, fl *swego.CalcFlags, m swego.NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error) {
+	return c.nodAps("swe_nod_aps", et, pl, fl, m)
+}
+
+// NodApsUT implements swego.Interface.
+func (c *Client) NodApsUT(ut float64, pl swego.Planet, fl *swego.CalcFlags, m swego.NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error) {
+	return c.nodAps("swe_nod_aps_ut", ut, pl, fl, m)
+}
+
+// GetOrbitalElements implements swego.Interface.
+func (c *Client) GetOrbitalElements(et float64, pl swego.Planet, fl *swego.CalcFlags) (o swego.OrbitalElements, err error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, "swe_get_orbital_elements", args(et, int(pl), flags))
+	if err != nil {
+		return o, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	dret := dec.floatsN(50)
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return o, err
+	}
+
+	if err := libError(rv, msg); err != nil {
+		return o, err
+	}
+
+	o.SetElements(dret)
+	return o, nil
+}
+
+// OrbitMaxMinTrueDistance implements swego.Interface.
+func (c *Client) OrbitMaxMinTrueDistance(et float64, pl swego.Planet, fl *swego.CalcFlags) (dmax, dmin, dtrue float64, err error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, "swe_orbit_max_min_true_distance", args(et, int(pl), flags))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	dec.array(5)
+	rv := dec.int()
+	dmax = dec.float()
+	dmin = dec.float()
+	dtrue = dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	return dmax, dmin, dtrue, libError(rv, msg)
+}
+
+// crossError returns an error if t is before the start jd of a crossing search.
+func crossError(t, jd float64, msg string) error {
+	if t < jd {
+		return swego.Error(msg)
+	}
+
+	return nil
+}
+
+func (c *Client) cross(name string, x2cross, jd float64, fl *swego.CalcFlags) (float64, error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, name, args(x2cross, jd, flags))
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(2)
+	t := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	return t, crossError(t, jd, msg)
+}
+
+// SolCross implements swego.Interface.
+func (c *Client) SolCross(x2cross, et float64, fl *swego.CalcFlags) (float64, error) {
+	return c.cross("swe_solcross", x2cross, et, fl)
+}
+
+// SolCrossUT implements swego.Interface.
+func (c *Client) SolCrossUT(x2cross, ut float64, fl *swego.CalcFlags) (float64, error) {
+	return c.cross("swe_solcross_ut", x2cross, ut, fl)
+}
+
+// MoonCross implements swego.Interface.
+func (c *Client) MoonCross(x2cross, et float64, fl *swego.CalcFlags) (float64, error) {
+	return c.cross("swe_mooncross", x2cross, et, fl)
+}
+
+// MoonCrossUT implements swego.Interface.
+func (c *Client) MoonCrossUT(x2cross, ut float64, fl *swego.CalcFlags) (float64, error) {
+	return c.cross("swe_mooncross_ut", x2cross, ut, fl)
+}
+
+func (c *Client) moonCrossNode(name string, jd float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, name, args(jd, flags))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	dec.array(4)
+	t = dec.float()
+	lng = dec.float()
+	lat = dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	return t, lng, lat, crossError(t, jd, msg)
+}
+
+// MoonCrossNode implements swego.Interface.
+func (c *Client) MoonCrossNode(et float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	return c.moonCrossNode("swe_mooncross_node", et, fl)
+}
+
+// MoonCrossNodeUT implements swego.Interface.
+func (c *Client) MoonCrossNodeUT(ut float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	return c.moonCrossNode("swe_mooncross_node_ut", ut, fl)
+}
+
+func (c *Client) helioCross(name string, pl swego.Planet, x2cross, jd float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dir := int32(1)
+	if backward {
+		dir = -1
+	}
+
+	dec, err := c.call(cc, name, args(int(pl), x2cross, jd, flags, dir))
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	t := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	return t, libError(rv, msg)
+}
+
+// HelioCross implements swego.Interface.
+func (c *Client) HelioCross(pl swego.Planet, x2cross, et float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	return c.helioCross("swe_helio_cross", pl, x2cross, et, fl, backward)
+}
+
+// HelioCrossUT implements swego.Interface.
+func (c *Client) HelioCrossUT(pl swego.Planet, x2cross, ut float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	return c.helioCross("swe_helio_cross_ut", pl, x2cross, ut, fl, backward)
+}
+
+func (c *Client) getAyanamsaEx(name string, jd float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	cc := c.newCallCtx()
+	cc.setSidMode(fl.SidMode)
+	cc.setDeltaT(fl.DeltaT)
+
+	dec, err := c.call(cc, name, args(jd, fl.Flags))
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	aya := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	return aya, libError(rv, msg)
+}
+
+// GetAyanamsaEx implements swego.Interface.
+func (c *Client) GetAyanamsaEx(et float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	return c.getAyanamsaEx("swe_get_ayanamsa_ex", et, fl)
+}
+
+// GetAyanamsaExUT implements swego.Interface.
+func (c *Client) GetAyanamsaExUT(ut float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	return c.getAyanamsaEx("swe_get_ayanamsa_ex_ut", ut, fl)
+}
+
+// GetAyanamsaName implements swego.Interface.
+func (c *Client) GetAyanamsaName(ayan swego.Ayanamsa) (string, error) {
+	dec, err := c.call(nil, "swe_get_ayanamsa_name", args(int32(ayan)))
+	if err != nil {
+		return "", err
+	}
+
+	dec.array(1)
+	name := dec.string()
+	return name, dec.done()
+}
+
+// JulDay implements swego.Interface.
+func (c *Client) JulDay(y, m, d int, h float64, ct swego.CalType) (float64, error) {
+	dec, err := c.call(nil, "swe_julday", args(y, m, d, h, int(ct)))
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(1)
+	jd := dec.float()
+	return jd, dec.done()
+}
+
+// RevJul implements swego.Interface.
+func (c *Client) RevJul(jd float64, ct swego.CalType) (y, m, d int, h float64, err error) {
+	dec, err := c.call(nil, "swe_revjul", args(jd, int(ct)))
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	dec.array(4)
+	y = dec.int()
+	m = dec.int()
+	d = dec.int()
+	h = dec.float()
+	return y, m, d, h, dec.done()
+}
+
+// UTCToJD implements swego.Interface.
+func (c *Client) UTCToJD(y, m, d, h, i int, s float64, fl *swego.DateConvertFlags) (et, ut float64, err error) {
+	cc := c.newCallCtx()
+	cc.setDeltaT(fl.DeltaT)
+
+	dec, err := c.call(cc, "swe_utc_to_jd", args(y, m, d, h, i, s, int(fl.Calendar)))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	dret := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, 0, err
+	}
+
+	if len(dret) != 2 {
+		return 0, 0, &ResultError{"swe_utc_to_jd", msgp.ArrayError{Wanted: 2, Got: uint32(len(dret))}}
+	}
+
+	return dret[0], dret[1], libError(rv, msg)
+}
+
+func (c *Client) jdToUTC(name string, jd float64, fl *swego.DateConvertFlags) (y, m, d, h, i int, s float64, err error) {
+	cc := c.newCallCtx()
+	cc.setDeltaT(fl.DeltaT)
+
+	dec, err := c.call(cc, name, args(jd, int(fl.Calendar)))
+	if err != nil {
+		return 0, 0, 0, 0, 0, 0, err
+	}
+
+	dec.array(6)
+	y = dec.int()
+	m = dec.int()
+	d = dec.int()
+	h = dec.int()
+	i = dec.int()
+	s = dec.float()
+	return y, m, d, h, i, s, dec.done()
+}
+
+// JdETToUTC implements swego.Interface.
+func (c *Client) JdETToUTC(et float64, fl *swego.DateConvertFlags) (y, m, d, h, i int, s float64, err error) {
+	return c.jdToUTC("swe_jdet_to_utc", et, fl)
+}
+
+// JdUT1ToUTC implements swego.Interface.
+func (c *Client) JdUT1ToUTC(ut1 float64, fl *swego.DateConvertFlags) (y, m, d, h, i int, s float64, err error) {
+	return c.jdToUTC("swe_jdut1_to_utc", ut1, fl)
+}
+
+// UTCTimeZone implements swego.Interface.
+func (c *Client) UTCTimeZone(y, m, d, h, i int, s, tz float64) (int, int, int, int, int, float64, error) {
+	dec, err := c.call(c.newCallCtx(), "swe_utc_time_zone", args(y, m, d, h, i, s, tz))
+	if err != nil {
+		return 0, 0, 0, 0, 0, 0, err
+	}
+
+	dec.array(6)
+	y = dec.int()
+	m = dec.int()
+	d = dec.int()
+	h = dec.int()
+	i = dec.int()
+	s = dec.float()
+	return y, m, d, h, i, s, dec.done()
+}
+
+func (c *Client) houses(cc *callCtx, name string, a msgp.Raw) ([]float64, []float64, error) {
+	dec, err := c.call(cc, name, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	cusps := dec.floats()
+	ascmc := dec.floats()
+	if err := dec.done(); err != nil {
+		return nil, nil, err
+	}
+
+	if rv == errFlag {
+		err = swego.Error("swe_house() error")
+	}
+
+	return cusps, ascmc, err
+}
+
+func (cc *callCtx) housesExFlags(fl *swego.HousesExFlags) int32 {
+	if fl == nil {
+		cc.setDeltaT(nil)
+		return 0
+	}
+
+	if (fl.Flags & swego.FlagSidereal) == swego.FlagSidereal {
+		cc.setSidMode(fl.SidMode)
+	}
+
+	cc.setDeltaT(fl.DeltaT)
+	return fl.Flags
+}
+
+// HousesEx implements swego.Interface.
+func (c *Client) HousesEx(ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) ([]float64, []float64, error) {
+	cc := c.newCallCtx()
+	flags := cc.housesExFlags(fl)
+	return c.houses(cc, "swe_houses_ex", args(ut, flags, geolat, geolon, int(hsys)))
+}
+
+// HousesARMC implements swego.Interface.
+func (c *Client) HousesARMC(armc, geolat, eps float64, hsys swego.HSys) ([]float64, []float64, error) {
+	return c.houses(nil, "swe_houses_armc", args(armc, geolat, eps, int(hsys)))
+}
+
+func (c *Client) houses2(cc *callCtx, name string, a msgp.Raw) (h swego.Houses, err error) {
+	dec, err := c.call(cc, name, a)
+	if err != nil {
+		return h, err
+	}
+
+	dec.array(6)
+	rv := dec.int()
+	cusps := dec.floats()
+	ascmc := dec.floatsN(10)
+	cuspSpeeds := dec.floatsN(uint32(len(cusps)))
+	ascmcSpeeds := dec.floatsN(10)
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return h, err
+	}
+
+	// A message is also returned along with valid houses if the library falls
+	// back to Porphyry houses, it is only an error without message.
+	if rv == errFlag && msg == "" {
+		err = swego.Error("swe_houses() error")
+	}
+
+	h.Cusps = cusps
+	h.CuspSpeeds = cuspSpeeds
+	h.SetAscMC(ascmc, ascmcSpeeds)
+	h.Warning = msg
+	return h, err
+}
+
+// HousesEx2 implements swego.Interface.
+func (c *Client) HousesEx2(ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) (swego.Houses, error) {
+	cc := c.newCallCtx()
+	flags := cc.housesExFlags(fl)
+	return c.houses2(cc, "swe_houses_ex2", args(ut, flags, geolat, geolon, int(hsys)))
+}
+
+// HousesARMCEx2 implements swego.Interface.
+func (c *Client) HousesARMCEx2(armc, geolat, eps float64, hsys swego.HSys) (swego.Houses, error) {
+	return c.houses2(nil, "swe_houses_armc_ex2", args(armc, geolat, eps, int(hsys)))
+}
+
+// HousePos implements swego.Interface.
+func (c *Client) HousePos(armc, geolat, eps float64, hsys swego.HSys, pllng, pllat float64) (float64, error) {
+	dec, err := c.call(nil, "swe_house_pos", args(armc, geolat, eps, int(hsys), []float64{pllng, pllat}))
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(2)
+	pos := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	if msg != "" {
+		return pos, swego.Error(msg)
+	}
+
+	return pos, nil
+}
+
+// GauquelinSector implements swego.Interface.
+func (c *Client) GauquelinSector(ut float64, body swego.Body, loc swego.GeoLoc, method swego.GauquelinMethod, atpress, attemp float64, fl *swego.CalcFlags) (float64, error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+	cc.setLapseRate(nil)
+
+	dec, err := c.call(cc, "swe_gauquelin_sector", args(ut, int(body.Planet), body.Star, flags, int32(method), geoPos(loc), atpress, attemp))
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	sect := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	return sect, libError(rv, msg)
+}
+
+// HouseName implements swego.Interface.
+func (c *Client) HouseName(hsys swego.HSys) (string, error) {
+	dec, err := c.call(nil, "swe_house_name", args(int(hsys)))
+	if err != nil {
+		return "", err
+	}
+
+	dec.array(1)
+	name := dec.string()
+	return name, dec.done()
+}
+
+// geoPos returns geographic location loc as geopos argument.
+func geoPos(loc swego.GeoLoc) []float64 {
+	return []float64{loc.Long, loc.Lat, loc.Alt}
+}
+
+func backwardFlag(backward bool) int32 {
+	if backward {
+		return 1
+	}
+
+	return 0
+}
+
+// SolEclipseWhenGlob implements swego.Interface.
+func (c *Client) SolEclipseWhenGlob(ut float64, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (e swego.SolarEclipse, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_sol_eclipse_when_glob", args(ut, flags, int32(typ), backwardFlag(backward)))
+	if err != nil {
+		return e, err
+	}
+
+	dec.array(3)
+	rv := dec.int32()
+	tret := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return e, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetGlobalTimes(tret)
+	return e, nil
+}
+
+// SolEclipseWhenLoc implements swego.Interface.
+func (c *Client) SolEclipseWhenLoc(ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (e swego.SolarEclipse, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_sol_eclipse_when_loc", args(ut, flags, geoPos(loc), backwardFlag(backward)))
+	if err != nil {
+		return e, err
+	}
+
+	dec.array(4)
+	rv := dec.int32()
+	tret := dec.floats()
+	attr := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return e, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetLocalTimes(tret)
+	e.SetAttributes(attr)
+	return e, nil
+}
+
+// SolEclipseWhere implements swego.Interface.
+func (c *Client) SolEclipseWhere(ut float64, fl *swego.EclipseFlags) (e swego.SolarEclipse, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_sol_eclipse_where", args(ut, flags))
+	if err != nil {
+		return e, err
+	}
+
+	dec.array(4)
+	rv := dec.int32()
+	geopos := dec.floats()
+	attr := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return e, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetAttributes(attr)
+	if len(geopos) >= 2 {
+		e.CentralLine = swego.GeoLoc{Long: geopos[0], Lat: geopos[1]}
+	}
+
+	return e, nil
+}
+
+// SolEclipseHow implements swego.Interface.
+func (c *Client) SolEclipseHow(ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc) (e swego.SolarEclipse, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_sol_eclipse_how", args(ut, flags, geoPos(loc)))
+	if err != nil {
+		return e, err
+	}
+
+	dec.array(3)
+	rv := dec.int32()
+	attr := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return e, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetAttributes(attr)
+	return e, nil
+}
+
+// LunOccultWhenGlob implements swego.Interface.
+func (c *Client) LunOccultWhenGlob(ut float64, body swego.Body, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (o swego.Occultation, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	// SE_ECL_ONE_TRY is passed along with the backward flag.
+	backflag := backwardFlag(backward) | int32(typ&swego.EclOneTry)
+	typ &^= swego.EclOneTry
+
+	dec, err := c.call(cc, "swe_lun_occult_when_glob", args(ut, int(body.Planet), body.Star, flags, int32(typ), backflag))
+	if err != nil {
+		return o, err
+	}
+
+	dec.array(3)
+	rv := dec.int32()
+	tret := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return o, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return o, err
+	}
+
+	o.SetType(swego.EclipseType(rv))
+	o.SetGlobalTimes(tret)
+	return o, nil
+}
+
+// LunOccultWhenLoc implements swego.Interface.
+func (c *Client) LunOccultWhenLoc(ut float64, body swego.Body, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (o swego.Occultation, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_lun_occult_when_loc", args(ut, int(body.Planet), body.Star, flags, geoPos(loc), backwardFlag(backward)))
+	if err != nil {
+		return o, err
+	}
+
+	dec.array(4)
+	rv := dec.int32()
+	tret := dec.floats()
+	attr := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return o, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return o, err
+	}
+
+	o.SetType(swego.EclipseType(rv))
+	o.SetLocalTimes(tret)
+	o.SetAttributes(attr)
+	return o, nil
+}
+
+// LunOccultWhere implements swego.Interface.
+func (c *Client) LunOccultWhere(ut float64, body swego.Body, fl *swego.EclipseFlags) (o swego.Occultation, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_lun_occult_where", args(ut, int(body.Planet), body.Star, flags))
+	if err != nil {
+		return o, err
+	}
+
+	dec.array(4)
+	rv := dec.int32()
+	geopos := dec.floats()
+	attr := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return o, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return o, err
+	}
+
+	o.SetType(swego.EclipseType(rv))
+	o.SetAttributes(attr)
+	if len(geopos) >= 2 {
+		o.CentralLine = swego.GeoLoc{Long: geopos[0], Lat: geopos[1]}
+	}
+
+	return o, nil
+}
+
+// LunEclipseWhen implements swego.Interface.
+func (c *Client) LunEclipseWhen(ut float64, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (e swego.LunarEclipse, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_lun_eclipse_when", args(ut, flags, int32(typ), backwardFlag(backward)))
+	if err != nil {
+		return e, err
+	}
+
+	dec.array(3)
+	rv := dec.int32()
+	tret := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return e, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetTimes(tret)
+	return e, nil
+}
+
+// LunEclipseWhenLoc implements swego.Interface.
+func (c *Client) LunEclipseWhenLoc(ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (e swego.LunarEclipse, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_lun_eclipse_when_loc", args(ut, flags, geoPos(loc), backwardFlag(backward)))
+	if err != nil {
+		return e, err
+	}
+
+	dec.array(4)
+	rv := dec.int32()
+	tret := dec.floats()
+	attr := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return e, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetTimes(tret)
+	e.SetAttributes(attr)
+	return e, nil
+}
+
+// LunEclipseHow implements swego.Interface.
+func (c *Client) LunEclipseHow(ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc) (e swego.LunarEclipse, err error) {
+	cc := c.newCallCtx()
+	flags := cc.eclipseFlags(fl)
+
+	dec, err := c.call(cc, "swe_lun_eclipse_how", args(ut, flags, geoPos(loc)))
+	if err != nil {
+		return e, err
+	}
+
+	dec.array(3)
+	rv := dec.int32()
+	attr := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return e, err
+	}
+
+	if err := libError(int(rv), msg); err != nil {
+		return e, err
+	}
+
+	e.SetType(swego.EclipseType(rv))
+	e.SetAttributes(attr)
+	return e, nil
+}
+
+func (c *Client) pheno(name string, jd float64, pl swego.Planet, fl *swego.CalcFlags) (p swego.Phenomena, err error) {
+	cc := c.newCallCtx()
+	flags := cc.calcFlags(fl)
+
+	dec, err := c.call(cc, name, args(jd, int(pl), flags))
+	if err != nil {
+		return p, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	attr := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return p, err
+	}
+
+	if err := libError(rv, msg); err != nil {
+		return p, err
+	}
+
+	p.SetAttributes(attr)
+	return p, nil
+}
+
+// Pheno implements swego.Interface.
+func (c *Client) Pheno(et float64, pl swego.Planet, fl *swego.CalcFlags) (swego.Phenomena, error) {
+	return c.pheno("swe_pheno", et, pl, fl)
+}
+
+// PhenoUT implements swego.Interface.
+func (c *Client) PhenoUT(ut float64, pl swego.Planet, fl *swego.CalcFlags) (swego.Phenomena, error) {
+	return c.pheno("swe_pheno_ut", ut, pl, fl)
+}
+
+// riseTransNotFound is the return value of swe_rise_trans if no rise or set
+// is found.
+const riseTransNotFound = -2
+
+// RiseTrans implements swego.Interface.
+func (c *Client) RiseTrans(ut float64, body swego.Body, loc swego.GeoLoc, event swego.RiseTransEvent, fl *swego.RiseTransFlags) (float64, error) {
+	if fl == nil {
+		fl = new(swego.RiseTransFlags)
+	}
+
+	cc := c.newCallCtx()
+	if (fl.Flags&swego.FlagEphJPL) > 0 && fl.JPLFile != "" {
+		cc.add("swe_set_jpl_file", args(fl.JPLFile))
+	}
+
+	cc.setLapseRate(nil)
+	cc.setDeltaT(fl.DeltaT)
+
+	name := "swe_rise_trans"
+	a := args(ut, int(body.Planet), body.Star, fl.Flags, int32(event), geoPos(loc), fl.AtPress, fl.AtTemp)
+	if fl.HorHgt != nil {
+		name = "swe_rise_trans_true_hor"
+		a = args(ut, int(body.Planet), body.Star, fl.Flags, int32(event), geoPos(loc), fl.AtPress, fl.AtTemp, *fl.HorHgt)
+	}
+
+	dec, err := c.call(cc, name, a)
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	t := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	if rv == riseTransNotFound {
+		return 0, &swego.CircumpolarError{Body: body, Event: event}
+	}
+
+	return t, libError(rv, msg)
+}
+
+// setLapseRate sets the lapse rate to lr, nil resets it. The rise and set,
+// Gauquelin sector and heliacal functions read the lapse rate set for AzAlt,
+// they reset it.
+func (cc *callCtx) setLapseRate(lr *float64) {
+	f := swego.DefaultLapseRate
+	if lr != nil {
+		f = *lr
+	}
+
+	cc.add("swe_set_lapse_rate", args(f))
+}
+
+// azAltFlags adds the context calls that represent the library state of
+// flags fl.
+func (cc *callCtx) azAltFlags(fl *swego.AzAltFlags) {
+	cc.setLapseRate(fl.LapseRate)
+	cc.setDeltaT(fl.DeltaT)
+}
+
+// AzAlt implements swego.Interface.
+func (c *Client) AzAlt(ut float64, loc swego.GeoLoc, mode swego.AzAltMode, lng, lat float64, fl *swego.AzAltFlags) (az, trueAlt, appAlt float64, err error) {
+	if fl == nil {
+		fl = new(swego.AzAltFlags)
+	}
+
+	cc := c.newCallCtx()
+	cc.azAltFlags(fl)
+
+	xin := []float64{lng, lat, 1}
+	dec, err := c.call(cc, "swe_azalt", args(ut, int32(mode), geoPos(loc), fl.AtPress, fl.AtTemp, xin))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	dec.array(1)
+	xaz := dec.floatsN(3)
+	if err := dec.done(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	return xaz[0], xaz[1], xaz[2], nil
+}
+
+// AzAltRev implements swego.Interface.
+func (c *Client) AzAltRev(ut float64, loc swego.GeoLoc, mode swego.AzAltMode, az, alt float64, fl *swego.AzAltFlags) (lng, lat float64, err error) {
+	if fl == nil {
+		fl = new(swego.AzAltFlags)
+	}
+
+	cc := c.newCallCtx()
+	cc.azAltFlags(fl)
+
+	xin := []float64{az, alt}
+	dec, err := c.call(cc, "swe_azalt_rev", args(ut, int32(mode), geoPos(loc), xin))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	dec.array(1)
+	xout := dec.floatsN(2)
+	if err := dec.done(); err != nil {
+		return 0, 0, err
+	}
+
+	return xout[0], xout[1], nil
+}
+
+// Refrac implements swego.Interface.
+func (c *Client) Refrac(alt, atpress, attemp float64, mode swego.RefracMode) (float64, error) {
+	dec, err := c.call(nil, "swe_refrac", args(alt, atpress, attemp, int32(mode)))
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(1)
+	f := dec.float()
+	return f, dec.done()
+}
+
+// RefracExtended implements swego.Interface.
+func (c *Client) RefracExtended(alt, geoalt, atpress, attemp, lapseRate float64, mode swego.RefracMode) (float64, swego.Refraction, error) {
+	var r swego.Refraction
+
+	a := args(alt, geoalt, atpress, attemp, lapseRate, int32(mode))
+	dec, err := c.call(nil, "swe_refrac_extended", a)
+	if err != nil {
+		return 0, r, err
+	}
+
+	dec.array(2)
+	f := dec.float()
+	dret := dec.floatsN(4)
+	if err := dec.done(); err != nil {
+		return 0, r, err
+	}
+
+	r.TrueAlt = dret[0]
+	r.AppAlt = dret[1]
+	r.Refraction = dret[2]
+	r.Dip = dret[3]
+	return f, r, nil
+}
+
+func atmosphere(atm swego.Atmosphere) []float64 {
+	return []float64{atm.Pressure, atm.Temperature, atm.Humidity, atm.Extinction}
+}
+
+func observer(obs swego.Observer) []float64 {
+	var binocular float64
+	if obs.Binocular {
+		binocular = 1
+	}
+
+	return []float64{obs.Age, obs.SnellenRatio, binocular, obs.Magnification, obs.Aperture, obs.Transmission}
+}
+
+// heliacalFlags adds the context calls that represent the library state of
+// flags fl.
+func (cc *callCtx) heliacalFlags(fl *swego.HeliacalFlags) {
+	if (fl.Flags&swego.FlagEphJPL) > 0 && fl.JPLFile != "" {
+		cc.add("swe_set_jpl_file", args(fl.JPLFile))
+	}
+
+	cc.setLapseRate(nil)
+	cc.setDeltaT(fl.DeltaT)
+}
+
+func (c *Client) heliacal(name string, ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) ([]float64, error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	cc := c.newCallCtx()
+	cc.heliacalFlags(fl)
+
+	a := args(ut, geoPos(loc), atmosphere(fl.Atmosphere), observer(fl.Observer), body.HeliacalName(), int32(event), fl.Flags)
+	dec, err := c.call(cc, name, a)
+	if err != nil {
+		return nil, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	dret := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return nil, err
+	}
+
+	return dret, libError(rv, msg)
+}
+
+// HeliacalUT implements swego.Interface.
+func (c *Client) HeliacalUT(ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) (h swego.HeliacalTimes, err error) {
+	dret, err := c.heliacal("swe_heliacal_ut", ut, loc, body, event, fl)
+	if err != nil {
+		return h, err
+	}
+
+	h.SetTimes(dret)
+	return h, nil
+}
+
+// HeliacalPhenoUT implements swego.Interface.
+func (c *Client) HeliacalPhenoUT(ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) (p swego.HeliacalPheno, err error) {
+	darr, err := c.heliacal("swe_heliacal_pheno_ut", ut, loc, body, event, fl)
+	if err != nil {
+		return p, err
+	}
+
+	p.SetAttributes(darr)
+	return p, nil
+}
+
+// visLimitBelowHorizon is the return value of swe_vis_limit_mag if the object
+// is below the horizon.
+const visLimitBelowHorizon = -2
+
+// VisLimitMag implements swego.Interface.
+func (c *Client) VisLimitMag(ut float64, loc swego.GeoLoc, body swego.Body, fl *swego.HeliacalFlags) (v swego.VisLimit, err error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	cc := c.newCallCtx()
+	cc.heliacalFlags(fl)
+
+	a := args(ut, geoPos(loc), atmosphere(fl.Atmosphere), observer(fl.Observer), body.HeliacalName(), fl.Flags)
+	dec, err := c.call(cc, "swe_vis_limit_mag", a)
+	if err != nil {
+		return v, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	dret := dec.floats()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return v, err
+	}
+
+	if err := libError(rv, msg); err != nil {
+		return v, err
+	}
+
+	if rv == visLimitBelowHorizon {
+		return v, swego.ErrBelowHorizon
+	}
+
+	v.Mode = swego.VisionMode(rv)
+	v.SetAttributes(dret)
+	return v, nil
+}
+
+// HeliacalAngle implements swego.Interface.
+func (c *Client) HeliacalAngle(ut float64, loc swego.GeoLoc, mag, objAz, sunAz, moonAz, moonAlt float64, fl *swego.HeliacalFlags) (angle, arcVis, sunAlt float64, err error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	cc := c.newCallCtx()
+	cc.heliacalFlags(fl)
+
+	a := args(ut, geoPos(loc), atmosphere(fl.Atmosphere), observer(fl.Observer), fl.Flags, mag, objAz, sunAz, moonAz, moonAlt)
+	dec, err := c.call(cc, "swe_heliacal_angle", a)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	dret := dec.floatsN(3)
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	if err := libError(rv, msg); err != nil {
+		return 0, 0, 0, err
+	}
+
+	return dret[0], dret[1], dret[2], nil
+}
+
+// TopoArcusVisionis implements swego.Interface.
+func (c *Client) TopoArcusVisionis(ut float64, loc swego.GeoLoc, mag, objAz, objAlt, sunAz, moonAz, moonAlt float64, fl *swego.HeliacalFlags) (float64, error) {
+	if fl == nil {
+		fl = new(swego.HeliacalFlags)
+	}
+
+	cc := c.newCallCtx()
+	cc.heliacalFlags(fl)
+
+	a := args(ut, geoPos(loc), atmosphere(fl.Atmosphere), observer(fl.Observer), fl.Flags, mag, objAz, objAlt, sunAz, moonAz, moonAlt)
+	dec, err := c.call(cc, "swe_topo_arcus_visionis", a)
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	arcVis := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	return arcVis, libError(rv, msg)
+}
+
+// DeltaTEx implements swego.Interface.
+func (c *Client) DeltaTEx(jd float64, eph swego.Ephemeris) (float64, error) {
+	dec, err := c.call(nil, "swe_deltat_ex", args(jd, int32(eph)))
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(2)
+	dt := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	if msg != "" {
+		return dt, swego.Error(msg)
+	}
+
+	return dt, nil
+}
+
+func (c *Client) timeEqu(name string, a msgp.Raw, fl *swego.TimeEquFlags) (float64, error) {
+	cc := c.newCallCtx()
+	if fl == nil {
+		cc.setDeltaT(nil)
+	} else {
+		cc.setDeltaT(fl.DeltaT)
+	}
+
+	dec, err := c.call(cc, name, a)
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(3)
+	rv := dec.int()
+	f := dec.float()
+	msg := dec.string()
+	if err := dec.done(); err != nil {
+		return 0, err
+	}
+
+	return f, libError(rv, msg)
+}
+
+// TimeEqu implements swego.Interface.
+func (c *Client) TimeEqu(jd float64, fl *swego.TimeEquFlags) (float64, error) {
+	return c.timeEqu("swe_time_equ", args(jd), fl)
+}
+
+// LMTToLAT implements swego.Interface.
+func (c *Client) LMTToLAT(jdLMT, geolon float64, fl *swego.TimeEquFlags) (float64, error) {
+	return c.timeEqu("swe_lmt_to_lat", args(jdLMT, geolon), fl)
+}
+
+// LATToLMT implements swego.Interface.
+func (c *Client) LATToLMT(jdLAT, geolon float64, fl *swego.TimeEquFlags) (float64, error) {
+	return c.timeEqu("swe_lat_to_lmt", args(jdLAT, geolon), fl)
+}
+
+func (c *Client) sidTime(name string, a msgp.Raw, fl *swego.SidTimeFlags) (float64, error) {
+	cc := c.newCallCtx()
+	if fl == nil {
+		cc.setDeltaT(nil)
+	} else {
+		cc.setDeltaT(fl.DeltaT)
+	}
+
+	dec, err := c.call(cc, name, a)
+	if err != nil {
+		return 0, err
+	}
+
+	dec.array(1)
+	st := dec.float()
+	return st, dec.done()
+}
+
+// SidTime0 implements swego.Interface.
+func (c *Client) SidTime0(ut, eps, nut float64, fl *swego.SidTimeFlags) (float64, error) {
+	return c.sidTime("swe_sidtime0", args(ut, eps, nut), fl)
+}
+
+// SidTime implements swego.Interface.
+func (c *Client) SidTime(ut float64, fl *swego.SidTimeFlags) (float64, error) {
+	return c.sidTime("swe_sidtime", args(ut), fl)
+}
+
+func (c *Client) coTrans(name string, xpo []float64, n int, eps float64) ([]float64, error) {
+	in := make([]float64, n)
+	copy(in, xpo)
+
+	dec, err := c.call(nil, name, args(in, eps))
+	if err != nil {
+		return nil, err
+	}
+
+	dec.array(1)
+	xpn := dec.floatsN(uint32(n))
+	if err := dec.done(); err != nil {
+		return nil, err
+	}
+
+	return xpn, nil
+}
+
+// CoTrans implements swego.Interface.
+func (c *Client) CoTrans(xpo []float64, eps float64) ([]float64, error) {
+	return c.coTrans("swe_cotrans", xpo, 3, eps)
+}
+
+// CoTransSp implements swego.Interface.
+func (c *Client) CoTransSp(xpo []float64, eps float64) ([]float64, error) {
+	return c.coTrans("swe_cotrans_sp", xpo, 6, eps)
+}
+
+// SplitDeg implements swego.Interface. As the method has no error return
+// value, zero values are returned if the call fails.
+func (c *Client) SplitDeg(ddeg float64, roundflag int) (ideg int32, imin int32, isec int32, dsecfr float64, isgn int32) {
+	dec, err := c.call(nil, "swe_split_deg", args(ddeg, int32(roundflag)))
+	if err != nil {
+		return 0, 0, 0, 0, 0
+	}
+
+	dec.array(5)
+	ideg = dec.int32()
+	imin = dec.int32()
+	isec = dec.int32()
+	dsecfr = dec.float()
+	isgn = dec.int32()
+	if dec.done() != nil {
+		return 0, 0, 0, 0, 0
+	}
+
+	return ideg, imin, isec, dsecfr, isgn
+}
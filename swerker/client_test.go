@@ -0,0 +1,932 @@
+package swerker
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/howesteve/swego"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+type testDispatcher struct {
+	funcs []string
+	calls []*Call
+	reply func(name string, c *Call) (msgp.Raw, error)
+}
+
+func (d *testDispatcher) IndexForName(name string) (uint8, bool) {
+	for i, fn := range d.funcs {
+		if fn == name {
+			return uint8(i), true
+		}
+	}
+
+	return 0, false
+}
+
+func (d *testDispatcher) Dispatch(c *Call) (msgp.Raw, error) {
+	d.calls = append(d.calls, c)
+	return d.reply(d.funcs[c.Func], c)
+}
+
+var testFuncs = []string{
+	"rpc_funcs",
+	"swe_version",
+	"swe_calc",
+	"swe_calc_ut",
+	"swe_calc_pctr",
+	"swe_fixstar_ut",
+	"swe_fixstar2_mag",
+	"swe_set_jpl_file",
+	"swe_set_topo",
+	"swe_set_sid_mode",
+	"swe_julday",
+	"swe_utc_to_jd",
+	"swe_utc_time_zone",
+	"swe_houses_ex",
+	"swe_houses_ex2",
+	"swe_gauquelin_sector",
+	"swe_sol_eclipse_when_loc",
+	"swe_lun_occult_when_glob",
+	"swe_lun_eclipse_when",
+	"swe_pheno_ut",
+	"swe_get_orbital_elements",
+	"swe_solcross_ut",
+	"swe_helio_cross",
+	"swe_rise_trans_true_hor",
+	"swe_set_lapse_rate",
+	"swe_azalt",
+	"swe_set_delta_t_userdef",
+	"swe_cotrans_sp",
+	"swe_split_deg",
+	"swe_vis_limit_mag",
+}
+
+func ctxFuncs(d *testDispatcher, c *Call) (names []string) {
+	for _, cc := range c.Ctx {
+		names = append(names, d.funcs[cc.Func])
+	}
+
+	return names
+}
+
+func TestClient_Version(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		if name != "swe_version" {
+			t.Errorf("name = %q, want: \"swe_version\"", name)
+		}
+
+		return msgp.Raw("\x91\xa42.10"), nil
+	}}
+
+	v, err := NewClient(d).Version()
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if v != "2.10" {
+		t.Errorf("v = %q, want: \"2.10\"", v)
+	}
+}
+
+func TestClient_Calc(t *testing.T) {
+	reply := args(int32(swego.FlagEphJPL|swego.FlagTopo|swego.FlagSidereal),
+		[]float64{1, 2, 3, 4, 5, 6}, "")
+
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return reply, nil
+	}}
+
+	fl := &swego.CalcFlags{
+		Flags:   swego.FlagEphJPL | swego.FlagTopo | swego.FlagSidereal,
+		TopoLoc: &swego.GeoLoc{Long: 5.116667, Lat: 52.083333},
+		SidMode: &swego.SidMode{Mode: swego.SidmLahiri},
+		JPLFile: swego.FnameDE431,
+	}
+	fl.SetDeltaT(0.5)
+
+	xx, cfl, err := NewClient(d).Calc(2451544.5, swego.Sun, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if want := []float64{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(xx, want) {
+		t.Errorf("xx = %v, want: %v", xx, want)
+	}
+
+	if cfl != int(fl.Flags) {
+		t.Errorf("cfl = %d, want: %d", cfl, fl.Flags)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_calc" {
+		t.Errorf("func = %q, want: \"swe_calc\"", name)
+	}
+
+	if a := args(2451544.5, int(swego.Sun), fl.Flags); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+
+	want := []string{"swe_set_topo", "swe_set_sid_mode", "swe_set_jpl_file", "swe_set_delta_t_userdef"}
+	if got := ctxFuncs(d, c); !reflect.DeepEqual(got, want) {
+		t.Errorf("ctx = %q, want: %q", got, want)
+	}
+
+	ctxArgs := []msgp.Raw{
+		args(5.116667, 52.083333, 0.0),
+		args(int32(swego.SidmLahiri), 0.0, 0.0),
+		args(swego.FnameDE431),
+		args(0.5),
+	}
+
+	for i, a := range ctxArgs {
+		if !bytes.Equal(c.Ctx[i].Args, a) {
+			t.Errorf("%s args =\n\t[% x]\nwant:\n\t[% x]", want[i], c.Ctx[i].Args, a)
+		}
+	}
+}
+
+func TestClient_Calc_nilFlags(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(2, []float64{1, 2, 3, 0, 0, 0}, ""), nil
+	}}
+
+	if _, _, err := NewClient(d).CalcUT(2451544.5, swego.Sun, nil); err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	c := d.calls[0]
+	if got, want := ctxFuncs(d, c), []string{"swe_set_delta_t_userdef"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ctx = %q, want: %q", got, want)
+	}
+
+	if a := args(resetDeltaT); !bytes.Equal(c.Ctx[0].Args, a) {
+		t.Errorf("swe_set_delta_t_userdef args =\n\t[% x]\nwant:\n\t[% x]", c.Ctx[0].Args, a)
+	}
+}
+
+func TestClient_Calc_error(t *testing.T) {
+	const msg = "jd 99999999.000000 outside JPL eph. range -3027215.50 .. 7930192.50;"
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(-1, make([]float64, 6), msg), nil
+	}}
+
+	_, cfl, err := NewClient(d).Calc(99999999., swego.Sun, nil)
+	if err != swego.Error(msg) {
+		t.Errorf("err = %v, want: %q", err, msg)
+	}
+
+	if cfl != -1 {
+		t.Errorf("cfl = %d, want: -1", cfl)
+	}
+}
+
+func multiCalls(t *testing.T, a msgp.Raw) []*Call {
+	t.Helper()
+	if _, rest, err := msgp.ReadArrayHeaderBytes(a); err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	} else {
+		a = rest
+	}
+
+	n, a, err := msgp.ReadArrayHeaderBytes(a)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	calls := make([]*Call, n)
+	for i := range calls {
+		calls[i] = new(Call)
+		if a, err = calls[i].UnmarshalMsg(a); err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+	}
+
+	return calls
+}
+
+func TestClient_CalcBatch(t *testing.T) {
+	const msg = "illegal planet number 99."
+	var multi [][]*Call
+	d := &testDispatcher{funcs: append(testFuncs, "rpc_multi"), reply: func(name string, c *Call) (msgp.Raw, error) {
+		calls := multiCalls(t, c.Args)
+		multi = append(multi, calls)
+
+		// the first message is partially executed
+		if len(multi) == 1 {
+			calls = calls[:2]
+		}
+
+		reply := msgp.AppendArrayHeader(nil, uint32(len(calls)))
+		for _, c := range calls {
+			if bytes.Equal(c.Args, args(2451545.0, 99, int32(swego.FlagSpeed))) {
+				reply = append(reply, args(-1, make([]float64, 6), msg)...)
+			} else {
+				reply = append(reply, args(int32(swego.FlagSpeed), []float64{1, 2, 3, 4, 5, 6}, "")...)
+			}
+		}
+
+		return reply, nil
+	}}
+
+	fl1 := &swego.CalcFlags{Flags: swego.FlagSpeed}
+	fl2 := &swego.CalcFlags{Flags: swego.FlagSpeed}
+	requests := []swego.CalcRequest{
+		{JD: 2451545, Planet: swego.Sun, Flags: fl1},
+		{JD: 2451545, UT: true, Planet: swego.Moon, Flags: fl1},
+		{JD: 2451545, Planet: 99, Flags: fl1},
+		{JD: 2451545, Planet: swego.Mars, Flags: fl2},
+	}
+
+	results := NewClient(d).CalcBatch(requests)
+	if len(results) != len(requests) {
+		t.Fatalf("len(results) = %d, want: %d", len(results), len(requests))
+	}
+
+	for i, r := range results {
+		if i == 2 {
+			if r.Err != swego.Error(msg) || r.Flags != -1 {
+				t.Errorf("results[%d] = %d, %v, want: -1, %q", i, r.Flags, r.Err, msg)
+			}
+
+			continue
+		}
+
+		if r.Err != nil || !reflect.DeepEqual(r.XX, []float64{1, 2, 3, 4, 5, 6}) {
+			t.Errorf("results[%d] = %v, %v, want: [1 2 3 4 5 6], nil", i, r.XX, r.Err)
+		}
+	}
+
+	if len(d.calls) != 2 || len(multi[0]) != 4 || len(multi[1]) != 2 {
+		t.Fatalf("rpc_multi calls = %d, want: 2 (4 and 2 calls)", len(d.calls))
+	}
+
+	// the context is only set for the first call and if the flags change
+	calls := append(multi[0], multi[1]...)
+	for i, want := range []int{1, 0, 0, 1, 1, 1} {
+		if c := calls[i]; len(c.Ctx) != want {
+			t.Errorf("call %d: len(ctx) = %d, want: %d", i, len(c.Ctx), want)
+		}
+	}
+
+	if name := d.funcs[multi[0][1].Func]; name != "swe_calc_ut" {
+		t.Errorf("func = %q, want: \"swe_calc_ut\"", name)
+	}
+}
+
+func TestClient_CalcBatch_noMulti(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(2, []float64{1, 2, 3, 0, 0, 0}, ""), nil
+	}}
+
+	requests := []swego.CalcRequest{{JD: 2451545, Planet: swego.Sun}, {JD: 2451545, UT: true, Planet: swego.Moon}}
+	results := NewClient(d).CalcBatch(requests)
+	for i, r := range results {
+		if r.Err != nil {
+			t.Errorf("results[%d].Err = %v, want: nil", i, r.Err)
+		}
+	}
+
+	if len(d.calls) != 2 {
+		t.Errorf("len(calls) = %d, want: 2", len(d.calls))
+	}
+}
+
+func TestClient_FixStarUT(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args("Aldebaran,alTau", int32(swego.FlagEphMoshier), []float64{69.79, -5.46, 4.2e6, 0, 0, 0}, ""), nil
+	}}
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	name, xx, cfl, err := NewClient(d).FixStarUT(",alTau", 2451544.5, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if name != "Aldebaran,alTau" {
+		t.Errorf("name = %q, want: \"Aldebaran,alTau\"", name)
+	}
+
+	if want := []float64{69.79, -5.46, 4.2e6, 0, 0, 0}; !reflect.DeepEqual(xx, want) {
+		t.Errorf("xx = %v, want: %v", xx, want)
+	}
+
+	if cfl != swego.FlagEphMoshier {
+		t.Errorf("cfl = %d, want: %d", cfl, swego.FlagEphMoshier)
+	}
+
+	c := d.calls[0]
+	if a := args(",alTau", 2451544.5, fl.Flags); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_FixStar2Mag(t *testing.T) {
+	const msg = "error, swe_fixstar(): could not find star name nonexistent"
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args("Nonexistent", -1, 0.0, msg), nil
+	}}
+
+	_, _, err := NewClient(d).FixStar2Mag("Nonexistent")
+	if err != swego.Error(msg) {
+		t.Errorf("err = %v, want: %q", err, msg)
+	}
+}
+
+func TestClient_funcNotFound(t *testing.T) {
+	d := &testDispatcher{funcs: []string{"rpc_funcs", "swe_calc"}}
+
+	_, _, err := NewClient(d).Calc(2451544.5, swego.Sun, nil)
+	want := &FuncNotFoundError{"swe_set_delta_t_userdef"}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("err = %v, want: %v", err, want)
+	}
+
+	_, err = NewClient(d).HouseName(swego.Placidus)
+	want = &FuncNotFoundError{"swe_house_name"}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("err = %v, want: %v", err, want)
+	}
+
+	if len(d.calls) != 0 {
+		t.Errorf("len(calls) = %d, want: 0", len(d.calls))
+	}
+}
+
+func TestClient_dispatchError(t *testing.T) {
+	dispErr := errors.New("dispatch error")
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return nil, dispErr
+	}}
+
+	if _, err := NewClient(d).JulDay(2000, 1, 1, 0, swego.Gregorian); err != dispErr {
+		t.Errorf("err = %v, want: %v", err, dispErr)
+	}
+}
+
+func TestClient_resultError(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args("not a float"), nil
+	}}
+
+	_, err := NewClient(d).JulDay(2000, 1, 1, 0, swego.Gregorian)
+	if _, ok := err.(*ResultError); !ok {
+		t.Errorf("err = %#v, want: %T value", err, (*ResultError)(nil))
+	}
+}
+
+func TestClient_UTCToJD(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(0, []float64{2451544.500743, 2451544.500004}, ""), nil
+	}}
+
+	fl := &swego.DateConvertFlags{Calendar: swego.Gregorian}
+	et, ut, err := NewClient(d).UTCToJD(2000, 1, 1, 0, 0, 0, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if et != 2451544.500743 || ut != 2451544.500004 {
+		t.Errorf("[et, ut] = [%f %f], want: [2451544.500743 2451544.500004]", et, ut)
+	}
+
+	c := d.calls[0]
+	if a := args(2000, 1, 1, 0, 0, 0.0, int(swego.Gregorian)); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_UTCTimeZone(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(1999, 12, 31, 23, 30, 60.5), nil
+	}}
+
+	y, m, dd, h, i, s, err := NewClient(d).UTCTimeZone(2000, 1, 1, 0, 30, 60.5, 1)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	got := []float64{float64(y), float64(m), float64(dd), float64(h), float64(i), s}
+	want := []float64{1999, 12, 31, 23, 30, 60.5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("date = %v, want: %v", got, want)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_utc_time_zone" {
+		t.Errorf("func = %q, want: \"swe_utc_time_zone\"", name)
+	}
+
+	if a := args(2000, 1, 1, 0, 30, 60.5, 1.0); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+
+	if len(c.Ctx) != 0 {
+		t.Errorf("ctx = %q, want: []", ctxFuncs(d, c))
+	}
+}
+
+func TestClient_CalcPctr(t *testing.T) {
+	xx := []float64{1, 2, 3, 4, 5, 6}
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(int32(swego.FlagSpeed), xx, ""), nil
+	}}
+
+	fl := &swego.CalcFlags{Flags: swego.FlagSpeed | swego.FlagSidereal, SidMode: &swego.SidMode{}}
+	got, cfl, err := NewClient(d).CalcPctr(2451545, swego.Earth, swego.Mars, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !reflect.DeepEqual(got, xx) || cfl != swego.FlagSpeed {
+		t.Errorf("xx, cfl = %v, %d, want: %v, %d", got, cfl, xx, swego.FlagSpeed)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_calc_pctr" {
+		t.Errorf("func = %q, want: \"swe_calc_pctr\"", name)
+	}
+
+	if a := args(2451545.0, int(swego.Earth), int(swego.Mars), fl.Flags); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+
+	want := []string{"swe_set_sid_mode", "swe_set_delta_t_userdef"}
+	if got := ctxFuncs(d, c); !reflect.DeepEqual(got, want) {
+		t.Errorf("ctx = %q, want: %q", got, want)
+	}
+}
+
+func TestClient_HousesEx(t *testing.T) {
+	cusps := make([]float64, 13)
+	ascmc := make([]float64, 10)
+	for i := range cusps {
+		cusps[i] = float64(i * 30)
+	}
+
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(0, cusps, ascmc), nil
+	}}
+
+	fl := &swego.HousesExFlags{Flags: swego.FlagSidereal}
+	gotCusps, gotAscmc, err := NewClient(d).HousesEx(2451544.5, fl, 52.083333, 5.116667, swego.Placidus)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !reflect.DeepEqual(gotCusps, cusps) {
+		t.Errorf("cusps = %v, want: %v", gotCusps, cusps)
+	}
+
+	if !reflect.DeepEqual(gotAscmc, ascmc) {
+		t.Errorf("ascmc = %v, want: %v", gotAscmc, ascmc)
+	}
+
+	want := []string{"swe_set_sid_mode", "swe_set_delta_t_userdef"}
+	if got := ctxFuncs(d, d.calls[0]); !reflect.DeepEqual(got, want) {
+		t.Errorf("ctx = %q, want: %q", got, want)
+	}
+}
+
+func TestClient_HousesEx2(t *testing.T) {
+	cusps := make([]float64, 13)
+	ascmc := make([]float64, 10)
+	speeds := make([]float64, 13)
+	ascmcSpeeds := make([]float64, 10)
+	for i := range cusps {
+		cusps[i] = float64(i * 30)
+		speeds[i] = 360
+	}
+	ascmc[swego.MC], ascmcSpeeds[swego.MC] = 270, 361
+
+	msg := "within polar circle, switched to Porphyry"
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(-1, cusps, ascmc, speeds, ascmcSpeeds, msg), nil
+	}}
+
+	h, err := NewClient(d).HousesEx2(2451544.5, nil, 70, 5.116667, swego.Placidus)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !reflect.DeepEqual(h.Cusps, cusps) || !reflect.DeepEqual(h.CuspSpeeds, speeds) {
+		t.Errorf("h = %+v, want cusps: %v, speeds: %v", h, cusps, speeds)
+	}
+
+	if h.MC != 270 || h.MCSpeed != 361 || h.Warning != msg {
+		t.Errorf("h = %+v, want: MC 270, MCSpeed 361, Warning %q", h, msg)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_houses_ex2" {
+		t.Errorf("func = %q, want: \"swe_houses_ex2\"", name)
+	}
+
+	if a := args(2451544.5, int32(0), 70.0, 5.116667, int(swego.Placidus)); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_HousesEx2_error(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(-1, make([]float64, 13), make([]float64, 10), make([]float64, 13), make([]float64, 10), ""), nil
+	}}
+
+	_, err := NewClient(d).HousesEx2(2451544.5, nil, 70, 5.116667, swego.Placidus)
+	if _, ok := err.(swego.Error); !ok {
+		t.Errorf("err = %v, want: %T value", err, swego.Error(""))
+	}
+}
+
+func TestClient_GauquelinSector(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(0, 33.5, ""), nil
+	}}
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+	sect, err := NewClient(d).GauquelinSector(2451545, swego.Body{Star: "Aldebaran"}, loc, swego.GauqDiscCenterRefrac, 1013.25, 15, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if sect != 33.5 {
+		t.Errorf("sect = %f, want: 33.5", sect)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_gauquelin_sector" {
+		t.Errorf("func = %q, want: \"swe_gauquelin_sector\"", name)
+	}
+
+	a := args(2451545.0, 0, "Aldebaran", fl.Flags, int32(swego.GauqDiscCenterRefrac), []float64{8.55, 47.37, 0}, 1013.25, 15.0)
+	if !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_SolEclipseWhenLoc(t *testing.T) {
+	typ := swego.EclNonCentral | swego.EclPartial | swego.EclVisible | swego.Ecl4thVisible
+	tret := []float64{2452790.65, 2452790.60, 0, 0, 2452790.68, 2452790.65, 0, 0, 0, 0}
+	attr := make([]float64, 20)
+	attr[0], attr[9], attr[10] = 0.7, 147, 22
+
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(int32(typ), tret, attr, ""), nil
+	}}
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37}
+	e, err := NewClient(d).SolEclipseWhenLoc(2451544.5, fl, loc, true)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if e.Type != typ || !e.Partial || !e.FourthContactVisible {
+		t.Errorf("Type = %d, want: %d", e.Type, typ)
+	}
+
+	if e.Max != tret[0] || e.FirstContact != tret[1] || e.FourthContact != tret[4] {
+		t.Errorf("[max, first, fourth] = [%f %f %f], want: [%f %f %f]",
+			e.Max, e.FirstContact, e.FourthContact, tret[0], tret[1], tret[4])
+	}
+
+	if e.Magnitude != 0.7 || e.Saros != 147 || e.SarosMember != 22 {
+		t.Errorf("[mag, saros, member] = [%f %d %d], want: [0.7 147 22]", e.Magnitude, e.Saros, e.SarosMember)
+	}
+
+	c := d.calls[0]
+	if a := args(2451544.5, fl.Flags, []float64{8.55, 47.37, 0}, int32(1)); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_LunOccultWhenGlob(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(0, []float64{2451542.4, 0, 0, 0, 0, 0, 0, 0, 0, 0}, ""), nil
+	}}
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	o, err := NewClient(d).LunOccultWhenGlob(2451544.5, swego.Body{Star: "Spica"}, fl, swego.EclTotal|swego.EclOneTry, true)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if o.Type != 0 || o.Max != 2451542.4 {
+		t.Errorf("[type, max] = [%d %f], want: [0 2451542.4]", o.Type, o.Max)
+	}
+
+	c := d.calls[0]
+	a := args(2451544.5, 0, "Spica", fl.Flags, int32(swego.EclTotal), int32(1|swego.EclOneTry))
+	if !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_LunEclipseWhen(t *testing.T) {
+	tret := []float64{2451564.69, 0, 2451564.62, 2451564.76, 2451564.67, 2451564.72, 2451564.58, 2451564.80, 0, 0}
+
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(int32(swego.EclTotal), tret, ""), nil
+	}}
+
+	fl := &swego.EclipseFlags{Flags: swego.FlagEphMoshier}
+	e, err := NewClient(d).LunEclipseWhen(2451544.5, fl, swego.EclAllTypesLunar, false)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !e.Total || e.Max != tret[0] || e.PenumbralEnd != tret[7] {
+		t.Errorf("e = %+v, want: total eclipse at %f", e, tret[0])
+	}
+
+	c := d.calls[0]
+	if a := args(2451544.5, fl.Flags, int32(swego.EclAllTypesLunar), int32(0)); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_PhenoUT(t *testing.T) {
+	attr := make([]float64, 20)
+	copy(attr, []float64{1, 2, 3, 4, 5, 6})
+
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(int32(swego.FlagEphMoshier), attr, ""), nil
+	}}
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	p, err := NewClient(d).PhenoUT(2451545, swego.Moon, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	want := swego.Phenomena{
+		PhaseAngle:         1,
+		Phase:              2,
+		Elongation:         3,
+		DiscDiameter:       4,
+		Magnitude:          5,
+		HorizontalParallax: 6,
+	}
+	if p != want {
+		t.Errorf("p = %+v, want: %+v", p, want)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_pheno_ut" {
+		t.Errorf("func = %q, want: \"swe_pheno_ut\"", name)
+	}
+
+	if a := args(2451545.0, int(swego.Moon), fl.Flags); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_GetOrbitalElements(t *testing.T) {
+	dret := make([]float64, 50)
+	for i := 0; i < 17; i++ {
+		dret[i] = float64(i + 1)
+	}
+
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(0, dret, ""), nil
+	}}
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	o, err := NewClient(d).GetOrbitalElements(2451545, swego.Mars, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if o.SemiMajorAxis != 1 || o.AphelionDistance != 17 {
+		t.Errorf("o = %+v, want: elements 1 to 17", o)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_get_orbital_elements" {
+		t.Errorf("func = %q, want: \"swe_get_orbital_elements\"", name)
+	}
+
+	if a := args(2451545.0, int(swego.Mars), fl.Flags); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_SolCrossUT(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(2451623.5, ""), nil
+	}}
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	jd, err := NewClient(d).SolCrossUT(0, 2451545, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if jd != 2451623.5 {
+		t.Errorf("jd = %f, want: 2451623.5", jd)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_solcross_ut" {
+		t.Errorf("func = %q, want: \"swe_solcross_ut\"", name)
+	}
+
+	if a := args(0.0, 2451545.0, fl.Flags); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_SolCrossUT_error(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(-1.0, "out of range"), nil
+	}}
+
+	_, err := NewClient(d).SolCrossUT(0, 0, &swego.CalcFlags{})
+	if want := swego.Error("out of range"); err != want {
+		t.Errorf("err = %v, want: %v", err, want)
+	}
+}
+
+func TestClient_HelioCross(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(0, 2450858.5, ""), nil
+	}}
+
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier}
+	jd, err := NewClient(d).HelioCross(swego.Mars, 0, 2451545, fl, true)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if jd != 2450858.5 {
+		t.Errorf("jd = %f, want: 2450858.5", jd)
+	}
+
+	c := d.calls[0]
+	if a := args(int(swego.Mars), 0.0, 2451545.0, fl.Flags, int32(-1)); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_CoTransSp(t *testing.T) {
+	xpn := []float64{1, 2, 3, 4, 5, 6}
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(xpn), nil
+	}}
+
+	got, err := NewClient(d).CoTransSp([]float64{10, 20}, -23.44)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !reflect.DeepEqual(got, xpn) {
+		t.Errorf("xpn = %v, want: %v", got, xpn)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_cotrans_sp" {
+		t.Errorf("func = %q, want: \"swe_cotrans_sp\"", name)
+	}
+
+	if a := args([]float64{10, 20, 0, 0, 0, 0}, -23.44); !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_RiseTrans(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(-2, 0.0, ""), nil
+	}}
+
+	fl := &swego.RiseTransFlags{Flags: swego.FlagEphMoshier, AtPress: 1013.25, AtTemp: 15}
+	fl.SetHorHgt(5)
+	loc := swego.GeoLoc{Long: 15, Lat: 80}
+	_, err := NewClient(d).RiseTrans(2451544.5, swego.Body{Planet: swego.Sun}, loc, swego.CalcRise, fl)
+
+	want := &swego.CircumpolarError{Body: swego.Body{Planet: swego.Sun}, Event: swego.CalcRise}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("err = %v, want: %v", err, want)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_rise_trans_true_hor" {
+		t.Errorf("func = %q, want: \"swe_rise_trans_true_hor\"", name)
+	}
+
+	a := args(2451544.5, int(swego.Sun), "", fl.Flags, int32(swego.CalcRise), []float64{15, 80, 0}, 1013.25, 15.0, 5.0)
+	if !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+}
+
+func TestClient_AzAlt(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args([]float64{1, 2, 3}), nil
+	}}
+
+	fl := &swego.AzAltFlags{AtPress: 1013.25, AtTemp: 15}
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.3667, Alt: 400}
+	az, trueAlt, appAlt, err := NewClient(d).AzAlt(2451545, loc, swego.Equ2Hor, 101.287, -16.716, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if az != 1 || trueAlt != 2 || appAlt != 3 {
+		t.Errorf("AzAlt = %f %f %f, want: 1 2 3", az, trueAlt, appAlt)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_azalt" {
+		t.Errorf("func = %q, want: \"swe_azalt\"", name)
+	}
+
+	a := args(2451545.0, int32(swego.Equ2Hor), []float64{8.55, 47.3667, 400}, 1013.25, 15.0, []float64{101.287, -16.716, 1})
+	if !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+
+	want := []string{"swe_set_lapse_rate", "swe_set_delta_t_userdef"}
+	if got := ctxFuncs(d, c); !reflect.DeepEqual(got, want) {
+		t.Errorf("ctx = %q, want: %q", got, want)
+	}
+
+	if a := args(swego.DefaultLapseRate); !bytes.Equal(c.Ctx[0].Args, a) {
+		t.Errorf("swe_set_lapse_rate args =\n\t[% x]\nwant:\n\t[% x]", c.Ctx[0].Args, a)
+	}
+
+	d.reply = func(name string, c *Call) (msgp.Raw, error) {
+		return args([]float64{1, 2}), nil
+	}
+
+	_, _, _, err = NewClient(d).AzAlt(2451545, loc, swego.Equ2Hor, 101.287, -16.716, fl)
+	if _, ok := err.(*ResultError); !ok {
+		t.Errorf("err = %#v, want: %T value", err, (*ResultError)(nil))
+	}
+}
+
+func TestClient_VisLimitMag(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(int32(swego.Scotopic), []float64{1, 2, 3, 4, 5, 6, 7, 8}, ""), nil
+	}}
+
+	fl := &swego.HeliacalFlags{
+		Flags:      swego.FlagEphMoshier,
+		Atmosphere: swego.Atmosphere{Pressure: 1013.25, Temperature: 15, Humidity: 40, Extinction: 40},
+		Observer:   swego.Observer{Age: 36, SnellenRatio: 1, Binocular: true},
+	}
+
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.3667, Alt: 400}
+	v, err := NewClient(d).VisLimitMag(2451544.73, loc, swego.Body{Planet: swego.Venus}, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if v.Mode != swego.Scotopic || v.LimitingMag != 1 || v.ObjMagnitude != 8 {
+		t.Errorf("v = %+v, want: scotopic, limiting magnitude 1, object magnitude 8", v)
+	}
+
+	c := d.calls[0]
+	if name := d.funcs[c.Func]; name != "swe_vis_limit_mag" {
+		t.Errorf("func = %q, want: \"swe_vis_limit_mag\"", name)
+	}
+
+	a := args(2451544.73, []float64{8.55, 47.3667, 400}, []float64{1013.25, 15, 40, 40},
+		[]float64{36, 1, 1, 0, 0, 0}, "Venus", fl.Flags)
+	if !bytes.Equal(c.Args, a) {
+		t.Errorf("args =\n\t[% x]\nwant:\n\t[% x]", c.Args, a)
+	}
+
+	d.reply = func(name string, c *Call) (msgp.Raw, error) {
+		return args(-2, make([]float64, 8), "object is below local horizon"), nil
+	}
+
+	_, err = NewClient(d).VisLimitMag(2451545.2, loc, swego.Body{Planet: swego.Venus}, fl)
+	if err != swego.ErrBelowHorizon {
+		t.Errorf("err = %v, want: %v", err, swego.ErrBelowHorizon)
+	}
+}
+
+func TestClient_SplitDeg(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(int32(9), int32(51), int32(33), 0.25, int32(9)), nil
+	}}
+
+	ideg, imin, isec, dsecfr, isgn := NewClient(d).SplitDeg(279.859216, swego.SplitDegZodiacal)
+	if ideg != 9 || imin != 51 || isec != 33 || dsecfr != 0.25 || isgn != 9 {
+		t.Errorf("SplitDeg = %d %d %d %f %d, want: 9 51 33 0.25 9", ideg, imin, isec, dsecfr, isgn)
+	}
+
+	d.reply = func(name string, c *Call) (msgp.Raw, error) {
+		return nil, errors.New("dispatch error")
+	}
+
+	ideg, imin, isec, dsecfr, isgn = NewClient(d).SplitDeg(math.NaN(), 0)
+	if ideg != 0 || imin != 0 || isec != 0 || dsecfr != 0 || isgn != 0 {
+		t.Errorf("SplitDeg = %d %d %d %f %d, want zero values", ideg, imin, isec, dsecfr, isgn)
+	}
+}
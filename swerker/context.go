@@ -0,0 +1,377 @@
+package swerker
+
+import (
+	"context"
+
+	"github.com/howesteve/swego"
+)
+
+// ContextClient implements swego.ContextInterface like Client implements
+// swego.Interface. A call is abandoned when the context is done if the
+// dispatcher implements ContextDispatcher, otherwise the context is only
+// checked before the call is dispatched.
+type ContextClient struct {
+	d Dispatcher
+}
+
+var _ swego.ContextInterface = (*ContextClient)(nil) // assert interface
+
+// NewContextClient returns a ContextClient that dispatches calls via
+// dispatcher d.
+func NewContextClient(d Dispatcher) *ContextClient {
+	return &ContextClient{d: d}
+}
+
+func (c *ContextClient) client(ctx context.Context) *Client {
+	return &Client{d: c.d, ctx: ctx}
+}
+
+// Version implements swego.ContextInterface.
+func (c *ContextClient) Version(ctx context.Context) (string, error) {
+	return c.client(ctx).Version()
+}
+
+// PlanetName implements swego.ContextInterface.
+func (c *ContextClient) PlanetName(ctx context.Context, pl swego.Planet) (string, error) {
+	return c.client(ctx).PlanetName(pl)
+}
+
+// Calc implements swego.ContextInterface.
+func (c *ContextClient) Calc(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) (xx []float64, cfl int, err error) {
+	return c.client(ctx).Calc(et, pl, fl)
+}
+
+// CalcUT implements swego.ContextInterface.
+func (c *ContextClient) CalcUT(ctx context.Context, ut float64, pl swego.Planet, fl *swego.CalcFlags) (xx []float64, cfl int, err error) {
+	return c.client(ctx).CalcUT(ut, pl, fl)
+}
+
+// CalcBatch implements swego.ContextInterface.
+func (c *ContextClient) CalcBatch(ctx context.Context, requests []swego.CalcRequest) []swego.CalcResult {
+	return c.client(ctx).CalcBatch(requests)
+}
+
+// CalcPctr implements swego.ContextInterface.
+func (c *ContextClient) CalcPctr(ctx context.Context, et float64, pl, center swego.Planet, fl *swego.CalcFlags) (xx []float64, cfl int, err error) {
+	return c.client(ctx).CalcPctr(et, pl, center, fl)
+}
+
+// FixStar implements swego.ContextInterface.
+func (c *ContextClient) FixStar(ctx context.Context, star string, et float64, fl *swego.CalcFlags) (name string, xx []float64, cfl int, err error) {
+	return c.client(ctx).FixStar(star, et, fl)
+}
+
+// FixStarUT implements swego.ContextInterface.
+func (c *ContextClient) FixStarUT(ctx context.Context, star string, ut float64, fl *swego.CalcFlags) (name string, xx []float64, cfl int, err error) {
+	return c.client(ctx).FixStarUT(star, ut, fl)
+}
+
+// FixStarMag implements swego.ContextInterface.
+func (c *ContextClient) FixStarMag(ctx context.Context, star string) (name string, mag float64, err error) {
+	return c.client(ctx).FixStarMag(star)
+}
+
+// FixStar2 implements swego.ContextInterface.
+func (c *ContextClient) FixStar2(ctx context.Context, star string, et float64, fl *swego.CalcFlags) (name string, xx []float64, cfl int, err error) {
+	return c.client(ctx).FixStar2(star, et, fl)
+}
+
+// FixStar2UT implements swego.ContextInterface.
+func (c *ContextClient) FixStar2UT(ctx context.Context, star string, ut float64, fl *swego.CalcFlags) (name string, xx []float64, cfl int, err error) {
+	return c.client(ctx).FixStar2UT(star, ut, fl)
+}
+
+// FixStar2Mag implements swego.ContextInterface.
+func (c *ContextClient) FixStar2Mag(ctx context.Context, star string) (name string, mag float64, err error) {
+	return c.client(ctx).FixStar2Mag(star)
+}
+
+// NodAps implements swego.ContextInterface.
+func (c *ContextClient) NodAps(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags, m swego.NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error) {
+	return c.client(ctx).NodAps(et, pl, fl, m)
+}
+
+// NodApsUT implements swego.ContextInterface.
+func (c *ContextClient) NodApsUT(ctx context.Context, ut float64, pl swego.Planet, fl *swego.CalcFlags, m swego.NodApsMethod) (nasc, ndsc, peri, aphe []float64, err error) {
+	return c.client(ctx).NodApsUT(ut, pl, fl, m)
+}
+
+// GetOrbitalElements implements swego.ContextInterface.
+func (c *ContextClient) GetOrbitalElements(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) (swego.OrbitalElements, error) {
+	return c.client(ctx).GetOrbitalElements(et, pl, fl)
+}
+
+// OrbitMaxMinTrueDistance implements swego.ContextInterface.
+func (c *ContextClient) OrbitMaxMinTrueDistance(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) (dmax, dmin, dtrue float64, err error) {
+	return c.client(ctx).OrbitMaxMinTrueDistance(et, pl, fl)
+}
+
+// SolCross implements swego.ContextInterface.
+func (c *ContextClient) SolCross(ctx context.Context, x2cross, et float64, fl *swego.CalcFlags) (float64, error) {
+	return c.client(ctx).SolCross(x2cross, et, fl)
+}
+
+// SolCrossUT implements swego.ContextInterface.
+func (c *ContextClient) SolCrossUT(ctx context.Context, x2cross, ut float64, fl *swego.CalcFlags) (float64, error) {
+	return c.client(ctx).SolCrossUT(x2cross, ut, fl)
+}
+
+// MoonCross implements swego.ContextInterface.
+func (c *ContextClient) MoonCross(ctx context.Context, x2cross, et float64, fl *swego.CalcFlags) (float64, error) {
+	return c.client(ctx).MoonCross(x2cross, et, fl)
+}
+
+// MoonCrossUT implements swego.ContextInterface.
+func (c *ContextClient) MoonCrossUT(ctx context.Context, x2cross, ut float64, fl *swego.CalcFlags) (float64, error) {
+	return c.client(ctx).MoonCrossUT(x2cross, ut, fl)
+}
+
+// MoonCrossNode implements swego.ContextInterface.
+func (c *ContextClient) MoonCrossNode(ctx context.Context, et float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	return c.client(ctx).MoonCrossNode(et, fl)
+}
+
+// MoonCrossNodeUT implements swego.ContextInterface.
+func (c *ContextClient) MoonCrossNodeUT(ctx context.Context, ut float64, fl *swego.CalcFlags) (t, lng, lat float64, err error) {
+	return c.client(ctx).MoonCrossNodeUT(ut, fl)
+}
+
+// HelioCross implements swego.ContextInterface.
+func (c *ContextClient) HelioCross(ctx context.Context, pl swego.Planet, x2cross, et float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	return c.client(ctx).HelioCross(pl, x2cross, et, fl, backward)
+}
+
+// HelioCrossUT implements swego.ContextInterface.
+func (c *ContextClient) HelioCrossUT(ctx context.Context, pl swego.Planet, x2cross, ut float64, fl *swego.CalcFlags, backward bool) (float64, error) {
+	return c.client(ctx).HelioCrossUT(pl, x2cross, ut, fl, backward)
+}
+
+// GetAyanamsaEx implements swego.ContextInterface.
+func (c *ContextClient) GetAyanamsaEx(ctx context.Context, et float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	return c.client(ctx).GetAyanamsaEx(et, fl)
+}
+
+// GetAyanamsaExUT implements swego.ContextInterface.
+func (c *ContextClient) GetAyanamsaExUT(ctx context.Context, ut float64, fl *swego.AyanamsaExFlags) (float64, error) {
+	return c.client(ctx).GetAyanamsaExUT(ut, fl)
+}
+
+// GetAyanamsaName implements swego.ContextInterface.
+func (c *ContextClient) GetAyanamsaName(ctx context.Context, ayan swego.Ayanamsa) (string, error) {
+	return c.client(ctx).GetAyanamsaName(ayan)
+}
+
+// JulDay implements swego.ContextInterface.
+func (c *ContextClient) JulDay(ctx context.Context, y, m, d int, h float64, ct swego.CalType) (float64, error) {
+	return c.client(ctx).JulDay(y, m, d, h, ct)
+}
+
+// RevJul implements swego.ContextInterface.
+func (c *ContextClient) RevJul(ctx context.Context, jd float64, ct swego.CalType) (y, m, d int, h float64, err error) {
+	return c.client(ctx).RevJul(jd, ct)
+}
+
+// UTCToJD implements swego.ContextInterface.
+func (c *ContextClient) UTCToJD(ctx context.Context, y, m, d, h, i int, s float64, fl *swego.DateConvertFlags) (et, ut float64, err error) {
+	return c.client(ctx).UTCToJD(y, m, d, h, i, s, fl)
+}
+
+// JdETToUTC implements swego.ContextInterface.
+func (c *ContextClient) JdETToUTC(ctx context.Context, et float64, fl *swego.DateConvertFlags) (y, m, d, h, i int, s float64, err error) {
+	return c.client(ctx).JdETToUTC(et, fl)
+}
+
+// JdUT1ToUTC implements swego.ContextInterface.
+func (c *ContextClient) JdUT1ToUTC(ctx context.Context, ut1 float64, fl *swego.DateConvertFlags) (y, m, d, h, i int, s float64, err error) {
+	return c.client(ctx).JdUT1ToUTC(ut1, fl)
+}
+
+// UTCTimeZone implements swego.ContextInterface.
+func (c *ContextClient) UTCTimeZone(ctx context.Context, y, m, d, h, i int, s, tz float64) (int, int, int, int, int, float64, error) {
+	return c.client(ctx).UTCTimeZone(y, m, d, h, i, s, tz)
+}
+
+// HousesEx implements swego.ContextInterface.
+func (c *ContextClient) HousesEx(ctx context.Context, ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) ([]float64, []float64, error) {
+	return c.client(ctx).HousesEx(ut, fl, geolat, geolon, hsys)
+}
+
+// HousesARMC implements swego.ContextInterface.
+func (c *ContextClient) HousesARMC(ctx context.Context, armc, geolat, eps float64, hsys swego.HSys) ([]float64, []float64, error) {
+	return c.client(ctx).HousesARMC(armc, geolat, eps, hsys)
+}
+
+// HousesEx2 implements swego.ContextInterface.
+func (c *ContextClient) HousesEx2(ctx context.Context, ut float64, fl *swego.HousesExFlags, geolat, geolon float64, hsys swego.HSys) (swego.Houses, error) {
+	return c.client(ctx).HousesEx2(ut, fl, geolat, geolon, hsys)
+}
+
+// HousesARMCEx2 implements swego.ContextInterface.
+func (c *ContextClient) HousesARMCEx2(ctx context.Context, armc, geolat, eps float64, hsys swego.HSys) (swego.Houses, error) {
+	return c.client(ctx).HousesARMCEx2(armc, geolat, eps, hsys)
+}
+
+// HousePos implements swego.ContextInterface.
+func (c *ContextClient) HousePos(ctx context.Context, armc, geolat, eps float64, hsys swego.HSys, pllng, pllat float64) (float64, error) {
+	return c.client(ctx).HousePos(armc, geolat, eps, hsys, pllng, pllat)
+}
+
+// GauquelinSector implements swego.ContextInterface.
+func (c *ContextClient) GauquelinSector(ctx context.Context, ut float64, body swego.Body, loc swego.GeoLoc, method swego.GauquelinMethod, atpress, attemp float64, fl *swego.CalcFlags) (float64, error) {
+	return c.client(ctx).GauquelinSector(ut, body, loc, method, atpress, attemp, fl)
+}
+
+// HouseName implements swego.ContextInterface.
+func (c *ContextClient) HouseName(ctx context.Context, hsys swego.HSys) (string, error) {
+	return c.client(ctx).HouseName(hsys)
+}
+
+// SolEclipseWhenGlob implements swego.ContextInterface.
+func (c *ContextClient) SolEclipseWhenGlob(ctx context.Context, ut float64, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (swego.SolarEclipse, error) {
+	return c.client(ctx).SolEclipseWhenGlob(ut, fl, typ, backward)
+}
+
+// SolEclipseWhenLoc implements swego.ContextInterface.
+func (c *ContextClient) SolEclipseWhenLoc(ctx context.Context, ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (swego.SolarEclipse, error) {
+	return c.client(ctx).SolEclipseWhenLoc(ut, fl, loc, backward)
+}
+
+// SolEclipseWhere implements swego.ContextInterface.
+func (c *ContextClient) SolEclipseWhere(ctx context.Context, ut float64, fl *swego.EclipseFlags) (swego.SolarEclipse, error) {
+	return c.client(ctx).SolEclipseWhere(ut, fl)
+}
+
+// SolEclipseHow implements swego.ContextInterface.
+func (c *ContextClient) SolEclipseHow(ctx context.Context, ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc) (swego.SolarEclipse, error) {
+	return c.client(ctx).SolEclipseHow(ut, fl, loc)
+}
+
+// LunOccultWhenGlob implements swego.ContextInterface.
+func (c *ContextClient) LunOccultWhenGlob(ctx context.Context, ut float64, body swego.Body, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (swego.Occultation, error) {
+	return c.client(ctx).LunOccultWhenGlob(ut, body, fl, typ, backward)
+}
+
+// LunOccultWhenLoc implements swego.ContextInterface.
+func (c *ContextClient) LunOccultWhenLoc(ctx context.Context, ut float64, body swego.Body, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (swego.Occultation, error) {
+	return c.client(ctx).LunOccultWhenLoc(ut, body, fl, loc, backward)
+}
+
+// LunOccultWhere implements swego.ContextInterface.
+func (c *ContextClient) LunOccultWhere(ctx context.Context, ut float64, body swego.Body, fl *swego.EclipseFlags) (swego.Occultation, error) {
+	return c.client(ctx).LunOccultWhere(ut, body, fl)
+}
+
+// LunEclipseWhen implements swego.ContextInterface.
+func (c *ContextClient) LunEclipseWhen(ctx context.Context, ut float64, fl *swego.EclipseFlags, typ swego.EclipseType, backward bool) (swego.LunarEclipse, error) {
+	return c.client(ctx).LunEclipseWhen(ut, fl, typ, backward)
+}
+
+// LunEclipseWhenLoc implements swego.ContextInterface.
+func (c *ContextClient) LunEclipseWhenLoc(ctx context.Context, ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc, backward bool) (swego.LunarEclipse, error) {
+	return c.client(ctx).LunEclipseWhenLoc(ut, fl, loc, backward)
+}
+
+// LunEclipseHow implements swego.ContextInterface.
+func (c *ContextClient) LunEclipseHow(ctx context.Context, ut float64, fl *swego.EclipseFlags, loc swego.GeoLoc) (swego.LunarEclipse, error) {
+	return c.client(ctx).LunEclipseHow(ut, fl, loc)
+}
+
+// Pheno implements swego.ContextInterface.
+func (c *ContextClient) Pheno(ctx context.Context, et float64, pl swego.Planet, fl *swego.CalcFlags) (swego.Phenomena, error) {
+	return c.client(ctx).Pheno(et, pl, fl)
+}
+
+// PhenoUT implements swego.ContextInterface.
+func (c *ContextClient) PhenoUT(ctx context.Context, ut float64, pl swego.Planet, fl *swego.CalcFlags) (swego.Phenomena, error) {
+	return c.client(ctx).PhenoUT(ut, pl, fl)
+}
+
+// RiseTrans implements swego.ContextInterface.
+func (c *ContextClient) RiseTrans(ctx context.Context, ut float64, body swego.Body, loc swego.GeoLoc, event swego.RiseTransEvent, fl *swego.RiseTransFlags) (float64, error) {
+	return c.client(ctx).RiseTrans(ut, body, loc, event, fl)
+}
+
+// AzAlt implements swego.ContextInterface.
+func (c *ContextClient) AzAlt(ctx context.Context, ut float64, loc swego.GeoLoc, mode swego.AzAltMode, lng, lat float64, fl *swego.AzAltFlags) (az, trueAlt, appAlt float64, err error) {
+	return c.client(ctx).AzAlt(ut, loc, mode, lng, lat, fl)
+}
+
+// AzAltRev implements swego.ContextInterface.
+func (c *ContextClient) AzAltRev(ctx context.Context, ut float64, loc swego.GeoLoc, mode swego.AzAltMode, az, alt float64, fl *swego.AzAltFlags) (lng, lat float64, err error) {
+	return c.client(ctx).AzAltRev(ut, loc, mode, az, alt, fl)
+}
+
+// Refrac implements swego.ContextInterface.
+func (c *ContextClient) Refrac(ctx context.Context, alt, atpress, attemp float64, mode swego.RefracMode) (float64, error) {
+	return c.client(ctx).Refrac(alt, atpress, attemp, mode)
+}
+
+// RefracExtended implements swego.ContextInterface.
+func (c *ContextClient) RefracExtended(ctx context.Context, alt, geoalt, atpress, attemp, lapseRate float64, mode swego.RefracMode) (float64, swego.Refraction, error) {
+	return c.client(ctx).RefracExtended(alt, geoalt, atpress, attemp, lapseRate, mode)
+}
+
+// HeliacalUT implements swego.ContextInterface.
+func (c *ContextClient) HeliacalUT(ctx context.Context, ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) (swego.HeliacalTimes, error) {
+	return c.client(ctx).HeliacalUT(ut, loc, body, event, fl)
+}
+
+// HeliacalPhenoUT implements swego.ContextInterface.
+func (c *ContextClient) HeliacalPhenoUT(ctx context.Context, ut float64, loc swego.GeoLoc, body swego.Body, event swego.HeliacalEvent, fl *swego.HeliacalFlags) (swego.HeliacalPheno, error) {
+	return c.client(ctx).HeliacalPhenoUT(ut, loc, body, event, fl)
+}
+
+// VisLimitMag implements swego.ContextInterface.
+func (c *ContextClient) VisLimitMag(ctx context.Context, ut float64, loc swego.GeoLoc, body swego.Body, fl *swego.HeliacalFlags) (swego.VisLimit, error) {
+	return c.client(ctx).VisLimitMag(ut, loc, body, fl)
+}
+
+// HeliacalAngle implements swego.ContextInterface.
+func (c *ContextClient) HeliacalAngle(ctx context.Context, ut float64, loc swego.GeoLoc, mag, objAz, sunAz, moonAz, moonAlt float64, fl *swego.HeliacalFlags) (angle, arcVis, sunAlt float64, err error) {
+	return c.client(ctx).HeliacalAngle(ut, loc, mag, objAz, sunAz, moonAz, moonAlt, fl)
+}
+
+// TopoArcusVisionis implements swego.ContextInterface.
+func (c *ContextClient) TopoArcusVisionis(ctx context.Context, ut float64, loc swego.GeoLoc, mag, objAz, objAlt, sunAz, moonAz, moonAlt float64, fl *swego.HeliacalFlags) (float64, error) {
+	return c.client(ctx).TopoArcusVisionis(ut, loc, mag, objAz, objAlt, sunAz, moonAz, moonAlt, fl)
+}
+
+// DeltaTEx implements swego.ContextInterface.
+func (c *ContextClient) DeltaTEx(ctx context.Context, jd float64, eph swego.Ephemeris) (float64, error) {
+	return c.client(ctx).DeltaTEx(jd, eph)
+}
+
+// TimeEqu implements swego.ContextInterface.
+func (c *ContextClient) TimeEqu(ctx context.Context, jd float64, fl *swego.TimeEquFlags) (float64, error) {
+	return c.client(ctx).TimeEqu(jd, fl)
+}
+
+// LMTToLAT implements swego.ContextInterface.
+func (c *ContextClient) LMTToLAT(ctx context.Context, jdLMT, geolon float64, fl *swego.TimeEquFlags) (float64, error) {
+	return c.client(ctx).LMTToLAT(jdLMT, geolon, fl)
+}
+
+// LATToLMT implements swego.ContextInterface.
+func (c *ContextClient) LATToLMT(ctx context.Context, jdLAT, geolon float64, fl *swego.TimeEquFlags) (float64, error) {
+	return c.client(ctx).LATToLMT(jdLAT, geolon, fl)
+}
+
+// SidTime0 implements swego.ContextInterface.
+func (c *ContextClient) SidTime0(ctx context.Context, ut, eps, nut float64, fl *swego.SidTimeFlags) (float64, error) {
+	return c.client(ctx).SidTime0(ut, eps, nut, fl)
+}
+
+// SidTime implements swego.ContextInterface.
+func (c *ContextClient) SidTime(ctx context.Context, ut float64, fl *swego.SidTimeFlags) (float64, error) {
+	return c.client(ctx).SidTime(ut, fl)
+}
+
+// CoTrans implements swego.ContextInterface.
+func (c *ContextClient) CoTrans(ctx context.Context, xpo []float64, eps float64) ([]float64, error) {
+	return c.client(ctx).CoTrans(xpo, eps)
+}
+
+// CoTransSp implements swego.ContextInterface.
+func (c *ContextClient) CoTransSp(ctx context.Context, xpo []float64, eps float64) ([]float64, error) {
+	return c.client(ctx).CoTransSp(xpo, eps)
+}
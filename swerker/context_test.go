@@ -0,0 +1,66 @@
+package swerker
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/howesteve/swego"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+type testCtxDispatcher struct {
+	testDispatcher
+	ctxs []context.Context
+}
+
+func (d *testCtxDispatcher) DispatchContext(ctx context.Context, c *Call) (msgp.Raw, error) {
+	d.ctxs = append(d.ctxs, ctx)
+	return d.Dispatch(c)
+}
+
+type ctxKey struct{}
+
+func TestContextClient(t *testing.T) {
+	xx := []float64{1, 2, 3, 4, 5, 6}
+	d := &testCtxDispatcher{testDispatcher: testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(int32(swego.FlagSpeed), xx, ""), nil
+	}}}
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, 1)
+	got, _, err := NewContextClient(d).Calc(ctx, 2451545, swego.Mars, nil)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !reflect.DeepEqual(got, xx) {
+		t.Errorf("xx = %v, want: %v", got, xx)
+	}
+
+	if len(d.ctxs) != 1 || d.ctxs[0] != ctx {
+		t.Errorf("DispatchContext ctx = %v, want: [%v]", d.ctxs, ctx)
+	}
+}
+
+func TestContextClient_canceled(t *testing.T) {
+	d := &testDispatcher{funcs: testFuncs, reply: func(name string, c *Call) (msgp.Raw, error) {
+		return args(2451545.0), nil
+	}}
+
+	c := NewContextClient(d)
+	if _, err := c.JulDay(context.Background(), 2000, 1, 1, 12, swego.Gregorian); err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.JulDay(ctx, 2000, 1, 1, 12, swego.Gregorian); err != context.Canceled {
+		t.Errorf("err = %v, want: %v", err, context.Canceled)
+	}
+
+	if len(d.calls) != 1 {
+		t.Errorf("len(calls) = %d, want: 1", len(d.calls))
+	}
+}
@@ -76,7 +76,7 @@ func readData(r byteReader) ([]byte, error) {
 	}
 
 	buf := make([]byte, int(size))
-	n, err := r.Read(buf)
+	n, err := io.ReadFull(r, buf)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/philhofer/fwd"
 )
@@ -30,6 +31,7 @@ func TestReader(t *testing.T) {
 	readers := []io.Reader{
 		testReader(),
 		&basicReader{testReader()},
+		&basicReader{iotest.OneByteReader(testReader())},
 	}
 
 	for _, r := range readers {
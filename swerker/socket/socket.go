@@ -0,0 +1,486 @@
+// Package socket implements a dispatcher that connects to one or more
+// swerker-socket worker hosts via TCP or Unix domain sockets.
+package socket
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/howesteve/swego/swerker"
+	"github.com/howesteve/swego/swerker/internal/lichdata"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// Addr is the network address of a swerker-socket worker host.
+type Addr struct {
+	Network string // "tcp" or "unix"
+	Address string
+}
+
+func (a Addr) String() string { return a.Network + ":" + a.Address }
+
+// Dispatcher keeps a pool of connections to a set of swerker-socket worker
+// hosts. Each connection is served by a separate worker process, the library
+// state set by context calls is bound to a connection.
+type Dispatcher struct {
+	addrs   []Addr
+	max     int
+	data    string
+	dial    DialFunc
+	idle    chan *conn
+	sem     chan struct{} // limits the number of open connections
+	next    uint32        // round robin host index
+	funcs   []string
+	funcMap map[string]uint8
+	mu      sync.Mutex // protects closed
+	closed  bool
+}
+
+// DialFunc connects to the address on the named network.
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// An Option configures an optional Dispatcher parameter.
+type Option func(*Dispatcher)
+
+// MaxConns configures the maximum number of open connections to all hosts.
+// If num is 0, the number of logical processors usable by the current process
+// times the number of hosts is used.
+func MaxConns(num int) Option {
+	return func(d *Dispatcher) {
+		d.max = num
+	}
+}
+
+// Dialer configures a Dispatcher to connect to the hosts using fn, e.g. to
+// set up TLS. By default a net.Dialer is used.
+func Dialer(fn DialFunc) Option {
+	return func(d *Dispatcher) {
+		d.dial = fn
+	}
+}
+
+// DataPath configures one or more ephemeris data paths on the worker hosts.
+// These are passed to each new connection by calling swe_set_ephe_path. The
+// paths are combined to a list of separated paths.
+func DataPath(paths ...string) Option {
+	return func(d *Dispatcher) {
+		d.data = combineDataPaths(paths)
+	}
+}
+
+const sep = string(filepath.Separator)
+const lsep = string(filepath.ListSeparator)
+
+func combineDataPaths(paths []string) string {
+	var s []string
+
+	for _, path := range paths {
+		if !strings.HasSuffix(path, sep) {
+			path += sep
+		}
+
+		s = append(s, path)
+	}
+
+	return strings.Join(s, lsep)
+}
+
+// ErrNoHosts is returned by New if no host addresses are passed.
+var ErrNoHosts = errors.New("socket: no hosts")
+
+// ErrClosed is returned if a call is dispatched by a closed Dispatcher.
+var ErrClosed = errors.New("socket: dispatcher is closed")
+
+// FuncsMismatchError is returned if a host exposes other RPC functions than
+// the first host, e.g. because it runs another version of swerker-socket.
+type FuncsMismatchError struct {
+	Addr Addr
+}
+
+func (e *FuncsMismatchError) Error() string {
+	return fmt.Sprintf("socket: RPC functions of %s differ from other hosts", e.Addr)
+}
+
+// New returns a Dispatcher that interfaces via swerker-socket with the Swiss
+// Ephemeris on the hosts at addrs. A connection to each host is made to
+// verify that all hosts expose the same RPC functions.
+func New(addrs []Addr, opts ...Option) (_ *Dispatcher, err error) {
+	if len(addrs) == 0 {
+		return nil, ErrNoHosts
+	}
+
+	d := &Dispatcher{addrs: addrs}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	if d.max == 0 {
+		d.max = runtime.NumCPU() * len(addrs)
+	}
+
+	if d.max < len(addrs) {
+		d.max = len(addrs)
+	}
+
+	if d.dial == nil {
+		d.dial = new(net.Dialer).DialContext
+	}
+
+	d.idle = make(chan *conn, d.max)
+	d.sem = make(chan struct{}, d.max)
+
+	defer func() {
+		if err != nil {
+			d.Close()
+		}
+	}()
+
+	for _, addr := range addrs {
+		cn, err := d.connect(context.Background(), addr)
+		if err != nil {
+			return nil, err
+		}
+
+		d.idle <- cn
+	}
+
+	return d, nil
+}
+
+// Close closes the idle connections, connections in use are closed as soon
+// as their call returns.
+func (d *Dispatcher) Close() error {
+	d.mu.Lock()
+	d.closed = true
+	d.mu.Unlock()
+
+	for {
+		select {
+		case cn := <-d.idle:
+			cn.exit()
+		default:
+			return nil
+		}
+	}
+}
+
+// Addrs returns the addresses of the hosts used by dispatcher d.
+func (d *Dispatcher) Addrs() []Addr { return d.addrs }
+
+// DataPath returns the list of ephemeris data paths send to the workers.
+func (d *Dispatcher) DataPath() string { return d.data }
+
+// IndexForName implements swerker.Dispatcher interface.
+func (d *Dispatcher) IndexForName(name string) (uint8, bool) {
+	idx, ok := d.funcMap[name]
+	return idx, ok
+}
+
+// UnimplementedError is returned if the requested function is not implemented
+// by the worker.
+type UnimplementedError struct {
+	Func uint8
+}
+
+func (e *UnimplementedError) Error() string {
+	return fmt.Sprintf("socket: unimplemented function %d", e.Func)
+}
+
+// Dispatch implements swerker.Dispatcher interface.
+func (d *Dispatcher) Dispatch(c *swerker.Call) (msgp.Raw, error) {
+	return d.DispatchContext(context.Background(), c)
+}
+
+// DispatchContext implements swerker.ContextDispatcher interface. If ctx is
+// done while the call is executed, the connection is closed. The worker
+// process of the connection exits after the call is completed.
+func (d *Dispatcher) DispatchContext(ctx context.Context, c *swerker.Call) (msgp.Raw, error) {
+	if int(c.Func) >= len(d.funcs) {
+		return nil, &UnimplementedError{c.Func}
+	}
+
+	select {
+	case d.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	defer func() { <-d.sem }()
+
+	cn, err := d.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, broken, err := cn.call(ctx, c)
+	if broken {
+		cn.Close()
+	} else {
+		d.put(cn)
+	}
+
+	return data, err
+}
+
+func (d *Dispatcher) isClosed() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.closed
+}
+
+// get returns an idle connection or connects to the next host. The hosts are
+// tried in turn until a connection is made.
+func (d *Dispatcher) get(ctx context.Context) (*conn, error) {
+	if d.isClosed() {
+		return nil, ErrClosed
+	}
+
+	select {
+	case cn := <-d.idle:
+		return cn, nil
+	default:
+	}
+
+	i := int(atomic.AddUint32(&d.next, 1))
+	var err error
+	for j := range d.addrs {
+		var cn *conn
+		if cn, err = d.connect(ctx, d.addrs[(i+j)%len(d.addrs)]); err == nil {
+			return cn, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, err
+}
+
+func (d *Dispatcher) put(cn *conn) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		cn.exit()
+		return
+	}
+
+	d.idle <- cn
+}
+
+// connect makes a connection to the host at addr and initializes it.
+func (d *Dispatcher) connect(ctx context.Context, addr Addr) (*conn, error) {
+	nc, err := d.dial(ctx, addr.Network, addr.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	cn := &conn{
+		Conn: nc,
+		r:    bufio.NewReader(nc),
+		w:    lichdata.NewWriter(nc),
+	}
+
+	stop := abortOnDone(ctx, cn)
+	funcs, err := cn.readFuncs()
+	if stop() {
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		cn.Close()
+		return nil, err
+	}
+
+	if d.funcs == nil {
+		d.funcs = funcs
+		d.funcMap = make(map[string]uint8, len(funcs))
+		for idx, name := range funcs {
+			d.funcMap[name] = uint8(idx)
+		}
+	} else if !equalFuncs(d.funcs, funcs) {
+		cn.Close()
+		return nil, &FuncsMismatchError{addr}
+	}
+
+	if d.data != "" {
+		if idx, ok := d.IndexForName("swe_set_ephe_path"); ok {
+			var args []byte
+			args = msgp.AppendArrayHeader(args, 1)
+			args = msgp.AppendString(args, d.data)
+			if _, broken, err := cn.call(ctx, &swerker.Call{Func: idx, Args: args}); broken {
+				cn.Close()
+				return nil, err
+			}
+		}
+	}
+
+	return cn, nil
+}
+
+func equalFuncs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// conn is a connection to a swerker-socket worker process.
+type conn struct {
+	net.Conn
+	r *bufio.Reader
+	w *lichdata.Writer
+}
+
+// NoFuncsError is returned when no initial funcs are returned by the host.
+type NoFuncsError struct {
+	// Err is the underlying error why no funcs are available.
+	Err error
+}
+
+func (e *NoFuncsError) Error() string {
+	return "socket: no initial funcs"
+}
+
+func (e *NoFuncsError) Unwrap() error { return e.Err }
+
+func (cn *conn) readFuncs() ([]string, error) {
+	data, err := lichdata.ReadFrom(cn.r)
+	if err != nil {
+		return nil, &NoFuncsError{err}
+	}
+
+	size, data, err := msgp.ReadArrayHeaderBytes(data)
+	if err != nil {
+		return nil, &NoFuncsError{err}
+	}
+
+	funcs := make([]string, size)
+	for i := range funcs {
+		if funcs[i], data, err = msgp.ReadStringBytes(data); err != nil {
+			return nil, &NoFuncsError{err}
+		}
+	}
+
+	return funcs, nil
+}
+
+// exit asks the worker process to exit and closes the connection.
+func (cn *conn) exit() {
+	cn.SetWriteDeadline(time.Now().Add(time.Second))
+	cn.Write([]byte{'\n'})
+	cn.Close()
+}
+
+// Error represents an error returned by a worker.
+type Error struct {
+	Msg   string
+	Debug string
+}
+
+func (e *Error) Error() string {
+	if e.Debug == "" {
+		return e.Msg
+	}
+
+	return fmt.Sprintf("%s [%s]", e.Msg, e.Debug)
+}
+
+// call executes function call c over connection cn. Value broken is true if
+// the connection can not be used for further calls.
+func (cn *conn) call(ctx context.Context, c *swerker.Call) (data msgp.Raw, broken bool, err error) {
+	data, err = c.MarshalMsg(nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	stop := abortOnDone(ctx, cn)
+	if _, err = cn.w.Write(data); err == nil {
+		data, err = lichdata.ReadFrom(cn.r)
+	}
+
+	if stop() {
+		return nil, true, ctx.Err()
+	}
+
+	if err != nil {
+		return nil, true, err
+	}
+
+	if msgp.NextType(data) == msgp.MapType {
+		return nil, false, decodeError(data)
+	}
+
+	return data, false, nil
+}
+
+// abortOnDone aborts pending I/O on cn if ctx is done before the returned
+// function is called. The returned function reports whether I/O was aborted.
+func abortOnDone(ctx context.Context, cn *conn) func() bool {
+	if ctx.Done() == nil {
+		return func() bool { return false }
+	}
+
+	done := make(chan struct{})
+	aborted := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			cn.SetDeadline(time.Unix(1, 0))
+			aborted <- true
+		case <-done:
+			aborted <- false
+		}
+	}()
+
+	return func() bool {
+		close(done)
+		return <-aborted
+	}
+}
+
+func decodeError(data []byte) error {
+	size, data, err := msgp.ReadMapHeaderBytes(data)
+	if err != nil {
+		return err
+	}
+
+	e := new(Error)
+	for i := uint32(0); i < size; i++ {
+		var k, v string
+		if k, data, err = msgp.ReadStringBytes(data); err != nil {
+			return err
+		}
+
+		if v, data, err = msgp.ReadStringBytes(data); err != nil {
+			return err
+		}
+
+		switch k {
+		case "err":
+			e.Msg = v
+		case "dbg":
+			e.Debug = v
+		}
+	}
+
+	return e
+}
@@ -0,0 +1,295 @@
+package socket
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/howesteve/swego/swerker"
+	"github.com/howesteve/swego/swerker/internal/lichdata"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+var testFuncs = []string{"rpc_funcs", "swe_version", "swe_set_ephe_path"}
+
+// testHost serves the swerker-socket protocol on a Unix domain socket.
+type testHost struct {
+	addr     Addr
+	funcs    []string
+	reply    func(c *swerker.Call) msgp.Raw
+	accepted int32
+	ln       net.Listener
+	wg       sync.WaitGroup
+}
+
+func newTestHost(t *testing.T, funcs []string, reply func(c *swerker.Call) msgp.Raw) *testHost {
+	path := filepath.Join(t.TempDir(), "swerker.sock")
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	h := &testHost{addr: Addr{"unix", path}, funcs: funcs, reply: reply, ln: ln}
+	h.wg.Add(1)
+	go h.serve()
+
+	t.Cleanup(func() {
+		ln.Close()
+		h.wg.Wait()
+	})
+
+	return h
+}
+
+func (h *testHost) serve() {
+	defer h.wg.Done()
+
+	for {
+		nc, err := h.ln.Accept()
+		if err != nil {
+			return
+		}
+
+		atomic.AddInt32(&h.accepted, 1)
+		h.wg.Add(1)
+		go h.serveConn(nc)
+	}
+}
+
+func (h *testHost) serveConn(nc net.Conn) {
+	defer h.wg.Done()
+	defer nc.Close()
+
+	r := bufio.NewReader(nc)
+	w := lichdata.NewWriter(nc)
+
+	funcs := msgp.AppendArrayHeader(nil, uint32(len(h.funcs)))
+	for _, fn := range h.funcs {
+		funcs = msgp.AppendString(funcs, fn)
+	}
+
+	if _, err := w.Write(funcs); err != nil {
+		return
+	}
+
+	for {
+		if c, err := r.Peek(1); err != nil || c[0] == '\n' {
+			return
+		}
+
+		data, err := lichdata.ReadFrom(r)
+		if err != nil {
+			return
+		}
+
+		c := new(swerker.Call)
+		if _, err := c.UnmarshalMsg(data); err != nil {
+			return
+		}
+
+		if _, err := w.Write(h.reply(c)); err != nil {
+			return
+		}
+	}
+}
+
+var versionReply = msgp.Raw("\x91\xa42.10")
+
+func TestDispatcher(t *testing.T) {
+	h := newTestHost(t, testFuncs, func(c *swerker.Call) msgp.Raw { return versionReply })
+
+	d, err := New([]Addr{h.addr})
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	defer d.Close()
+
+	idx, ok := d.IndexForName("swe_version")
+	if !ok || idx != 1 {
+		t.Fatalf("IndexForName(\"swe_version\") = %d, %t, want: 1, true", idx, ok)
+	}
+
+	for i := 0; i < 3; i++ {
+		data, err := d.Dispatch(&swerker.Call{Func: idx})
+		if err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+
+		if !bytes.Equal(data, versionReply) {
+			t.Errorf("data = [% x], want: [% x]", data, versionReply)
+		}
+	}
+
+	if n := atomic.LoadInt32(&h.accepted); n != 1 {
+		t.Errorf("accepted connections = %d, want: 1", n)
+	}
+
+	if _, err := d.Dispatch(&swerker.Call{Func: 3}); err == nil {
+		t.Error("err = nil, want: *UnimplementedError")
+	}
+}
+
+func TestDispatcher_error(t *testing.T) {
+	h := newTestHost(t, testFuncs, func(c *swerker.Call) msgp.Raw {
+		return msgp.Raw("\x82\xa3err\xbbinvalid number of arguments\xa3dbg\xa5argc1")
+	})
+
+	d, err := New([]Addr{h.addr})
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	defer d.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err := d.Dispatch(&swerker.Call{Func: 1})
+		want := &Error{"invalid number of arguments", "argc1"}
+		if e, ok := err.(*Error); !ok || *e != *want {
+			t.Errorf("err = %v, want: %v", err, want)
+		}
+	}
+
+	// the connection remains usable after an error reply
+	if n := atomic.LoadInt32(&h.accepted); n != 1 {
+		t.Errorf("accepted connections = %d, want: 1", n)
+	}
+}
+
+func TestDispatcher_DataPath(t *testing.T) {
+	var paths []string
+	h := newTestHost(t, testFuncs, func(c *swerker.Call) msgp.Raw {
+		if c.Func == 2 {
+			_, args, _ := msgp.ReadArrayHeaderBytes(c.Args)
+			path, _, _ := msgp.ReadStringBytes(args)
+			paths = append(paths, path)
+		}
+
+		return msgp.Raw{0x90}
+	})
+
+	d, err := New([]Addr{h.addr}, DataPath("/path/to/files"))
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	defer d.Close()
+
+	if want := combineDataPaths([]string{"/path/to/files"}); len(paths) != 1 || paths[0] != want {
+		t.Errorf("swe_set_ephe_path args = %q, want: [%q]", paths, want)
+	}
+}
+
+func TestDispatchContext(t *testing.T) {
+	release := make(chan struct{})
+	h := newTestHost(t, testFuncs, func(c *swerker.Call) msgp.Raw {
+		if c.Args == nil {
+			<-release
+		}
+
+		return versionReply
+	})
+
+	defer close(release)
+
+	d, err := New([]Addr{h.addr}, MaxConns(1))
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	defer d.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := d.DispatchContext(ctx, &swerker.Call{Func: 1}); err != context.DeadlineExceeded {
+		t.Errorf("err = %v, want: %v", err, context.DeadlineExceeded)
+	}
+
+	// the aborted connection is replaced by a new one
+	data, err := d.Dispatch(&swerker.Call{Func: 1, Args: msgp.Raw{0x90}})
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if !bytes.Equal(data, versionReply) {
+		t.Errorf("data = [% x], want: [% x]", data, versionReply)
+	}
+
+	if n := atomic.LoadInt32(&h.accepted); n != 2 {
+		t.Errorf("accepted connections = %d, want: 2", n)
+	}
+}
+
+func TestDispatcher_hosts(t *testing.T) {
+	reply := func(c *swerker.Call) msgp.Raw { return versionReply }
+	h1 := newTestHost(t, testFuncs, reply)
+	h2 := newTestHost(t, testFuncs, reply)
+
+	d, err := New([]Addr{h1.addr, h2.addr}, MaxConns(4))
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := d.Dispatch(&swerker.Call{Func: 1}); err != nil {
+				t.Errorf("err = %v, want: nil", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+	d.Close()
+
+	if _, err := d.Dispatch(&swerker.Call{Func: 1}); err != ErrClosed {
+		t.Errorf("err = %v, want: %v", err, ErrClosed)
+	}
+
+	n1, n2 := atomic.LoadInt32(&h1.accepted), atomic.LoadInt32(&h2.accepted)
+	if n1 == 0 || n2 == 0 || n1+n2 > 4 {
+		t.Errorf("accepted connections = %d, %d, want: at most 4 on both hosts", n1, n2)
+	}
+}
+
+func TestNew_funcsMismatch(t *testing.T) {
+	reply := func(c *swerker.Call) msgp.Raw { return versionReply }
+	h1 := newTestHost(t, testFuncs, reply)
+	h2 := newTestHost(t, testFuncs[:2], reply)
+
+	_, err := New([]Addr{h1.addr, h2.addr})
+	if e, ok := err.(*FuncsMismatchError); !ok || e.Addr != h2.addr {
+		t.Errorf("err = %v, want: %v", err, &FuncsMismatchError{h2.addr})
+	}
+}
+
+func TestNew_errors(t *testing.T) {
+	if _, err := New(nil); err != ErrNoHosts {
+		t.Errorf("err = %v, want: %v", err, ErrNoHosts)
+	}
+
+	addr := Addr{"unix", filepath.Join(t.TempDir(), "missing.sock")}
+	if _, err := New([]Addr{addr}); err == nil {
+		t.Error("err = nil, want dial error")
+	}
+
+	dialErr := errors.New("dial error")
+	dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		return nil, dialErr
+	}
+
+	if _, err := New([]Addr{addr}, Dialer(dial)); err != dialErr {
+		t.Errorf("err = %v, want: %v", err, dialErr)
+	}
+}
@@ -6,8 +6,9 @@ import (
 	"os"
 	"os/exec"
 	"testing"
+	"time"
 
-	"github.com/howesteve/swego/swerker/stdio/internal/lichdata"
+	"github.com/howesteve/swego/swerker/internal/lichdata"
 
 	"github.com/tinylib/msgp/msgp"
 )
@@ -176,3 +177,14 @@ func TestUnexpectedExit_SubProcess(t *testing.T) {
 	readInput()
 	os.Exit(1)
 }
+
+func TestKill_SubProcess(t *testing.T) {
+	if os.Getenv("GO_TEST_SUBPROCESS") != "1" {
+		t.SkipNow()
+	}
+
+	writeInitalFuncs()
+	readInput()
+	time.Sleep(time.Minute)
+	os.Exit(0)
+}
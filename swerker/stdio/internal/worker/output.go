@@ -4,10 +4,11 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 
-	"github.com/howesteve/swego/swerker/stdio/internal/lichdata"
+	"github.com/howesteve/swego/swerker/internal/lichdata"
 
 	"github.com/tinylib/msgp/msgp"
 )
@@ -54,20 +55,34 @@ func freeReader(r *bytes.Reader) {
 	readerPool.Put(r)
 }
 
+// stdoutWriter reads the Lich data elements written by the subprocess. A data
+// element may span multiple writes, incomplete data is buffered.
 type stdoutWriter struct {
 	write func(msgp.Raw)
+	buf   []byte
 }
 
 func (w *stdoutWriter) Write(data []byte) (int, error) {
-	r := newReader(data)
-	msg, err := lichdata.ReadFrom(r)
-	freeReader(r)
+	w.buf = append(w.buf, data...)
 
-	if err != nil {
-		return 0, err
+	for len(w.buf) != 0 {
+		r := newReader(w.buf)
+		msg, err := lichdata.ReadFrom(r)
+		n := len(w.buf) - r.Len()
+		freeReader(r)
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break // wait for the remaining data
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		w.buf = append(w.buf[:0], w.buf[n:]...)
+		w.write(msg)
 	}
 
-	w.write(msg)
 	return len(data), nil
 }
 
@@ -4,6 +4,8 @@ import (
 	"io"
 	"reflect"
 	"testing"
+
+	"github.com/tinylib/msgp/msgp"
 )
 
 func TestStderrWriter(t *testing.T) {
@@ -91,3 +93,51 @@ func TestStderrWriter(t *testing.T) {
 		})
 	}
 }
+
+func TestStdoutWriter(t *testing.T) {
+	cases := []struct {
+		name string
+		in   func(io.Writer)
+		want []string
+	}{
+		{
+			"Basic",
+			func(w io.Writer) {
+				io.WriteString(w, "3<abc>")
+			},
+			[]string{"abc"},
+		},
+		{
+			"Split",
+			func(w io.Writer) {
+				io.WriteString(w, "1")
+				io.WriteString(w, "0<abcde")
+				io.WriteString(w, "fghij")
+				io.WriteString(w, ">")
+			},
+			[]string{"abcdefghij"},
+		},
+		{
+			"Multiple",
+			func(w io.Writer) {
+				io.WriteString(w, "3<abc>2<d")
+				io.WriteString(w, "e>1<f>")
+			},
+			[]string{"abc", "de", "f"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got []string
+			w := &stdoutWriter{write: func(out msgp.Raw) {
+				got = append(got, string(out))
+			}}
+
+			c.in(w)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %q, want: %q", got, c.want)
+			}
+		})
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"os/exec"
 
 	"github.com/howesteve/swego/swerker"
-	"github.com/howesteve/swego/swerker/stdio/internal/lichdata"
+	"github.com/howesteve/swego/swerker/internal/lichdata"
 
 	"github.com/tinylib/msgp/msgp"
 )
@@ -15,6 +15,7 @@ import (
 type Worker interface {
 	Call(c *swerker.Call) (data msgp.Raw, crashed bool, err error)
 	Exit() error
+	Kill() error
 }
 
 type worker struct {
@@ -92,6 +93,16 @@ func (w *worker) Exit() error {
 	return w.waitErr
 }
 
+// Kill terminates the subprocess immediately. A running Call returns with
+// crashed set to true.
+func (w *worker) Kill() error {
+	if w.exited() {
+		return nil
+	}
+
+	return w.cmd.Process.Kill()
+}
+
 func (w *worker) exited() bool {
 	select {
 	default:
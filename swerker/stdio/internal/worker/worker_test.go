@@ -4,9 +4,10 @@ import (
 	"flag"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/howesteve/swego/swerker"
-	"github.com/howesteve/swego/swerker/stdio/internal/lichdata"
+	"github.com/howesteve/swego/swerker/internal/lichdata"
 
 	"github.com/tinylib/msgp/msgp"
 )
@@ -315,3 +316,39 @@ func TestUnexpectedExit(t *testing.T) {
 		t.Errorf("err.(type) = %T, want: %T", err, (*UnexpectedExitError)(nil))
 	}
 }
+
+func TestKill(t *testing.T) {
+	mockOnly(t)
+	defer swizzle("Kill")()
+
+	w, _, err := New(*workerPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer w.Exit()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		if err := w.Kill(); err != nil {
+			t.Errorf("Kill() err = %v, want: nil", err)
+		}
+	}()
+
+	resp, crashed, err := w.Call(&swerker.Call{Func: 0}) // rpc_funcs
+	if !crashed {
+		t.Error("worker is not crashed")
+	}
+
+	if resp != nil {
+		t.Errorf("resp = [% x], want: nil", resp)
+	}
+
+	if _, ok := err.(*UnexpectedExitError); !ok {
+		t.Errorf("err.(type) = %T, want: %T", err, (*UnexpectedExitError)(nil))
+	}
+
+	if err := w.Kill(); err != nil {
+		t.Errorf("Kill() err = %v, want: nil", err)
+	}
+}
@@ -0,0 +1,149 @@
+package stdio
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Stats holds the state and the counters of a Dispatcher.
+type Stats struct {
+	Workers   int                  // configured number of worker processes
+	Idle      int                  // workers waiting for a call
+	Pending   int                  // calls waiting for an idle worker
+	PerWorker []WorkerStats        // indexed by worker slot
+	Funcs     map[string]FuncStats // by function name, only called functions
+}
+
+// WorkerStats holds the counters of a worker slot. A crashed worker is
+// replaced by a new process in the same slot.
+type WorkerStats struct {
+	Calls         uint64 // calls executed by the worker
+	Crashes       uint64 // unexpected exits, reported via OnExitError
+	Kills         uint64 // workers killed as the context of the call is done
+	Restarts      uint64 // workers started after a crash or kill
+	RestartErrors uint64 // failed restarts, reported via OnNewError
+}
+
+// FuncStats holds the counters of the calls of a function.
+type FuncStats struct {
+	Calls    uint64
+	Errors   uint64        // calls that returned an error
+	Duration time.Duration // summed duration of the calls
+}
+
+type workerCounters struct {
+	calls, crashes, kills, restarts, restartErrors uint64
+}
+
+type funcCounters struct {
+	calls, errors, nanos uint64
+}
+
+func (c *funcCounters) add(d time.Duration, err error) {
+	atomic.AddUint64(&c.calls, 1)
+	atomic.AddUint64(&c.nanos, uint64(d))
+	if err != nil {
+		atomic.AddUint64(&c.errors, 1)
+	}
+}
+
+// Stats returns a snapshot of the state and the counters of dispatcher d.
+func (d *Dispatcher) Stats() Stats {
+	d.mu.Lock()
+	s := Stats{Workers: d.procs, Idle: len(d.idle), Pending: len(d.pending)}
+	d.mu.Unlock()
+
+	s.PerWorker = make([]WorkerStats, len(d.workerStats))
+	for i := range d.workerStats {
+		c := &d.workerStats[i]
+		s.PerWorker[i] = WorkerStats{
+			Calls:         atomic.LoadUint64(&c.calls),
+			Crashes:       atomic.LoadUint64(&c.crashes),
+			Kills:         atomic.LoadUint64(&c.kills),
+			Restarts:      atomic.LoadUint64(&c.restarts),
+			RestartErrors: atomic.LoadUint64(&c.restartErrors),
+		}
+	}
+
+	s.Funcs = make(map[string]FuncStats)
+	for i := range d.funcStats {
+		c := &d.funcStats[i]
+		calls := atomic.LoadUint64(&c.calls)
+		if calls == 0 {
+			continue
+		}
+
+		s.Funcs[d.names[i]] = FuncStats{
+			Calls:    calls,
+			Errors:   atomic.LoadUint64(&c.errors),
+			Duration: time.Duration(atomic.LoadUint64(&c.nanos)),
+		}
+	}
+
+	return s
+}
+
+// WriteTo writes s to w in the Prometheus text exposition format. The metric
+// names have the prefix swerker_stdio_.
+func (s Stats) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+
+	gauge := func(name, help string, v int) {
+		header(&b, name, help, "gauge")
+		fmt.Fprintf(&b, "swerker_stdio_%s %d\n", name, v)
+	}
+
+	gauge("workers", "Configured number of worker processes.", s.Workers)
+	gauge("idle_workers", "Number of workers waiting for a call.", s.Idle)
+	gauge("pending_calls", "Number of calls waiting for an idle worker.", s.Pending)
+
+	perWorker := func(name, help string, v func(WorkerStats) uint64) {
+		header(&b, name, help, "counter")
+		for i, ws := range s.PerWorker {
+			fmt.Fprintf(&b, "swerker_stdio_%s{worker=\"%d\"} %d\n", name, i, v(ws))
+		}
+	}
+
+	perWorker("worker_calls_total", "Calls executed by a worker slot.",
+		func(ws WorkerStats) uint64 { return ws.Calls })
+	perWorker("worker_crashes_total", "Unexpected exits of a worker slot.",
+		func(ws WorkerStats) uint64 { return ws.Crashes })
+	perWorker("worker_kills_total", "Workers killed as the context of the call is done.",
+		func(ws WorkerStats) uint64 { return ws.Kills })
+	perWorker("worker_restarts_total", "Workers restarted in a worker slot.",
+		func(ws WorkerStats) uint64 { return ws.Restarts })
+	perWorker("worker_restart_errors_total", "Failed restarts of a worker slot.",
+		func(ws WorkerStats) uint64 { return ws.RestartErrors })
+
+	names := make([]string, 0, len(s.Funcs))
+	for name := range s.Funcs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	header(&b, "call_errors_total", "Calls of a function that returned an error.", "counter")
+	for _, name := range names {
+		fmt.Fprintf(&b, "swerker_stdio_call_errors_total{func=%s} %d\n", strconv.Quote(name), s.Funcs[name].Errors)
+	}
+
+	header(&b, "call_duration_seconds", "Duration of the calls of a function.", "summary")
+	for _, name := range names {
+		fs := s.Funcs[name]
+		label := strconv.Quote(name)
+		fmt.Fprintf(&b, "swerker_stdio_call_duration_seconds_sum{func=%s} %s\n", label,
+			strconv.FormatFloat(fs.Duration.Seconds(), 'g', -1, 64))
+		fmt.Fprintf(&b, "swerker_stdio_call_duration_seconds_count{func=%s} %d\n", label, fs.Calls)
+	}
+
+	return b.WriteTo(w)
+}
+
+func header(b *bytes.Buffer, name, help, typ string) {
+	fmt.Fprintf(b, "# HELP swerker_stdio_%s %s\n# TYPE swerker_stdio_%s %s\n", name, help, name, typ)
+}
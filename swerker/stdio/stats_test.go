@@ -0,0 +1,172 @@
+package stdio
+
+import (
+	"bytes"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/howesteve/swego/swerker"
+	"github.com/howesteve/swego/swerker/stdio/internal/worker"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+type testHook struct {
+	mu     sync.Mutex
+	starts []string
+	ends   []string
+	errs   []error
+}
+
+func (h *testHook) OnCallStart(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.starts = append(h.starts, name)
+}
+
+func (h *testHook) OnCallEnd(name string, d time.Duration, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.ends = append(h.ends, name)
+	h.errs = append(h.errs, err)
+}
+
+func TestStats(t *testing.T) {
+	funcs := worker.Funcs{"rpc_funcs", "test_func", "test_crash"}
+	crashErr := &worker.Error{Msg: "test_crash called", Panic: true}
+
+	defer func() { newWorker = worker.New }()
+	newWorker = newTestWorker(funcs, func(c *swerker.Call) (msgp.Raw, bool, error) {
+		if c.Func == 2 {
+			return nil, true, crashErr
+		}
+
+		return msgp.Raw{0x90}, false, nil
+	}, func() error {
+		return nil
+	})
+
+	hook := new(testHook)
+	d, err := New(workerPath, NumWorkers(1), CallHook(hook))
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	for _, fn := range []uint8{1, 1, 2, 1} {
+		d.Dispatch(&swerker.Call{Func: fn})
+	}
+
+	var s Stats
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if s = d.Stats(); s.PerWorker[0].Restarts > 0 {
+			break
+		}
+	}
+
+	if s.Workers != 1 || s.Idle != 1 || s.Pending != 0 {
+		t.Errorf("Workers, Idle, Pending = %d, %d, %d, want: 1, 1, 0", s.Workers, s.Idle, s.Pending)
+	}
+
+	wantWorkers := []WorkerStats{{Calls: 4, Crashes: 1, Restarts: 1}}
+	if !reflect.DeepEqual(s.PerWorker, wantWorkers) {
+		t.Errorf("PerWorker = %+v, want: %+v", s.PerWorker, wantWorkers)
+	}
+
+	if fs := s.Funcs["test_func"]; fs.Calls != 3 || fs.Errors != 0 || fs.Duration <= 0 {
+		t.Errorf("Funcs[test_func] = %+v, want: 3 calls, 0 errors", fs)
+	}
+
+	if fs := s.Funcs["test_crash"]; fs.Calls != 1 || fs.Errors != 1 {
+		t.Errorf("Funcs[test_crash] = %+v, want: 1 call, 1 error", fs)
+	}
+
+	if _, ok := s.Funcs["rpc_funcs"]; ok {
+		t.Error("Funcs[rpc_funcs] is set, want: only called functions")
+	}
+
+	names := []string{"test_func", "test_func", "test_crash", "test_func"}
+	if !reflect.DeepEqual(hook.starts, names) || !reflect.DeepEqual(hook.ends, names) {
+		t.Errorf("hook calls = %v, %v, want: %v", hook.starts, hook.ends, names)
+	}
+
+	if want := []error{nil, nil, crashErr, nil}; !reflect.DeepEqual(hook.errs, want) {
+		t.Errorf("hook errs = %v, want: %v", hook.errs, want)
+	}
+
+	if err := d.Close(); err != nil {
+		t.Errorf("err = %v, want: nil", err)
+	}
+}
+
+func TestStats_WriteTo(t *testing.T) {
+	s := Stats{
+		Workers: 2,
+		Idle:    1,
+		Pending: 3,
+		PerWorker: []WorkerStats{
+			{Calls: 10, Crashes: 1, Restarts: 1},
+			{Calls: 5, Kills: 2, Restarts: 1, RestartErrors: 1},
+		},
+		Funcs: map[string]FuncStats{
+			"swe_version": {Calls: 1, Duration: 250 * time.Microsecond},
+			"swe_calc_ut": {Calls: 14, Errors: 1, Duration: 1500 * time.Millisecond},
+		},
+	}
+
+	const want = `# HELP swerker_stdio_workers Configured number of worker processes.
+# TYPE swerker_stdio_workers gauge
+swerker_stdio_workers 2
+# HELP swerker_stdio_idle_workers Number of workers waiting for a call.
+# TYPE swerker_stdio_idle_workers gauge
+swerker_stdio_idle_workers 1
+# HELP swerker_stdio_pending_calls Number of calls waiting for an idle worker.
+# TYPE swerker_stdio_pending_calls gauge
+swerker_stdio_pending_calls 3
+# HELP swerker_stdio_worker_calls_total Calls executed by a worker slot.
+# TYPE swerker_stdio_worker_calls_total counter
+swerker_stdio_worker_calls_total{worker="0"} 10
+swerker_stdio_worker_calls_total{worker="1"} 5
+# HELP swerker_stdio_worker_crashes_total Unexpected exits of a worker slot.
+# TYPE swerker_stdio_worker_crashes_total counter
+swerker_stdio_worker_crashes_total{worker="0"} 1
+swerker_stdio_worker_crashes_total{worker="1"} 0
+# HELP swerker_stdio_worker_kills_total Workers killed as the context of the call is done.
+# TYPE swerker_stdio_worker_kills_total counter
+swerker_stdio_worker_kills_total{worker="0"} 0
+swerker_stdio_worker_kills_total{worker="1"} 2
+# HELP swerker_stdio_worker_restarts_total Workers restarted in a worker slot.
+# TYPE swerker_stdio_worker_restarts_total counter
+swerker_stdio_worker_restarts_total{worker="0"} 1
+swerker_stdio_worker_restarts_total{worker="1"} 1
+# HELP swerker_stdio_worker_restart_errors_total Failed restarts of a worker slot.
+# TYPE swerker_stdio_worker_restart_errors_total counter
+swerker_stdio_worker_restart_errors_total{worker="0"} 0
+swerker_stdio_worker_restart_errors_total{worker="1"} 1
+# HELP swerker_stdio_call_errors_total Calls of a function that returned an error.
+# TYPE swerker_stdio_call_errors_total counter
+swerker_stdio_call_errors_total{func="swe_calc_ut"} 1
+swerker_stdio_call_errors_total{func="swe_version"} 0
+# HELP swerker_stdio_call_duration_seconds Duration of the calls of a function.
+# TYPE swerker_stdio_call_duration_seconds summary
+swerker_stdio_call_duration_seconds_sum{func="swe_calc_ut"} 1.5
+swerker_stdio_call_duration_seconds_count{func="swe_calc_ut"} 14
+swerker_stdio_call_duration_seconds_sum{func="swe_version"} 0.00025
+swerker_stdio_call_duration_seconds_count{func="swe_version"} 1
+`
+
+	var b bytes.Buffer
+	n, err := s.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if got := b.String(); got != want {
+		t.Errorf("WriteTo =\n%s\nwant:\n%s", got, want)
+	}
+
+	if n != int64(b.Len()) {
+		t.Errorf("n = %d, want: %d", n, b.Len())
+	}
+}
@@ -3,12 +3,15 @@
 package stdio
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/howesteve/swego/swerker"
 	"github.com/howesteve/swego/swerker/stdio/internal/worker"
@@ -17,23 +20,101 @@ import (
 )
 
 // Dispatcher runs a set of swerker-stdio worker processes.
+//
+// The library state set by the context calls of a call is tracked per worker.
+// A call is preferably passed to an idle worker whose state already matches
+// and the context calls that do not change the state of the worker are not
+// sent.
 type Dispatcher struct {
 	procs     int
 	path      string
 	data      string
-	workers   []worker.Worker
+	workers   []*proc
 	workersMu sync.RWMutex // protects workers
-	queue     chan task
-	crashed   chan worker.Worker
+	mu        sync.Mutex   // protects idle, pending, closing and proc.state
+	idle      []*proc
+	pending   []task
+	closing   bool
+	crashed   chan *proc
 	workDone  chan struct{}
 	closed    chan struct{}
 	onNewErr  func(error)
 	onExitErr func(error)
+	hook      Hook
 	funcs     worker.FuncsMap
+	names     worker.Funcs
 	lastIdx   uint8
+	stateCost map[uint8]int  // cost of state functions by index
+	keeps     map[uint8]bool // indexes of functions that keep the state
+
+	workerStats []workerCounters // indexed by worker slot
+	funcStats   []funcCounters   // indexed by function
+}
+
+// proc is a worker process and its library state.
+type proc struct {
+	w     worker.Worker
+	slot  int       // index in Dispatcher.workers
+	tasks chan task // closed when the dispatcher is closed
+	state ctxState
+}
+
+// ctxState maps the index of a state function to the arguments of its last
+// call.
+type ctxState map[uint8]string
+
+func (s ctxState) clone() ctxState {
+	c := make(ctxState, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+
+	return c
+}
+
+// stateFuncs maps the names of the functions that set the library state that
+// is tracked per worker to the relative cost of calling them. Setting a file
+// path closes the open ephemeris files, a JPL file is opened immediately.
+var stateFuncs = map[string]int{
+	"swe_set_ephe_path":       5,
+	"swe_set_jpl_file":        10,
+	"swe_set_topo":            1,
+	"swe_set_sid_mode":        1,
+	"swe_set_delta_t_userdef": 1,
+}
+
+// keepFuncs are the functions known to leave the tracked library state
+// unchanged. After a call of any other function, except the state functions,
+// the library state of a worker is unknown. For example, the eclipse, rise and
+// set, Gauquelin sector and heliacal functions call swe_set_topo internally.
+var keepFuncs = []string{
+	"rpc_funcs", "swe_version", "swe_get_planet_name",
+	"swe_calc", "swe_calc_ut", "swe_calc_pctr",
+	"swe_fixstar", "swe_fixstar_ut", "swe_fixstar_mag",
+	"swe_fixstar2", "swe_fixstar2_ut", "swe_fixstar2_mag",
+	"swe_get_ayanamsa_ex", "swe_get_ayanamsa_ex_ut", "swe_get_ayanamsa",
+	"swe_get_ayanamsa_ut", "swe_get_ayanamsa_name",
+	"swe_date_conversion", "swe_julday", "swe_revjul", "swe_utc_to_jd",
+	"swe_jdet_to_utc", "swe_jdut1_to_utc", "swe_utc_time_zone",
+	"swe_houses", "swe_houses_ex", "swe_houses_armc", "swe_houses_ex2",
+	"swe_houses_armc_ex2", "swe_house_pos", "swe_house_name",
+	"swe_pheno", "swe_pheno_ut", "swe_refrac", "swe_refrac_extended",
+	"swe_set_lapse_rate", "swe_azalt", "swe_azalt_rev",
+	"swe_nod_aps", "swe_nod_aps_ut", "swe_get_orbital_elements",
+	"swe_orbit_max_min_true_distance",
+	"swe_solcross", "swe_solcross_ut", "swe_mooncross", "swe_mooncross_ut",
+	"swe_mooncross_node", "swe_mooncross_node_ut",
+	"swe_helio_cross", "swe_helio_cross_ut",
+	"swe_deltat", "swe_deltat_ex", "swe_time_equ", "swe_lmt_to_lat",
+	"swe_lat_to_lmt", "swe_sidtime0", "swe_sidtime", "swe_set_interpolate_nut",
+	"swe_cotrans", "swe_cotrans_sp", "swe_get_tid_acc", "swe_set_tid_acc",
+	"swe_degnorm", "swe_radnorm", "swe_rad_midp", "swe_deg_midp",
+	"swe_split_deg", "swe_difdegn", "swe_difdeg2n", "swe_difrad2n", "swe_d2l",
+	"swe_day_of_week",
 }
 
 type task struct {
+	ctx    context.Context
 	call   *swerker.Call
 	result chan result // TODO: benchmark impact of pooling
 }
@@ -71,8 +152,28 @@ func OnExitError(fn func(err error)) Option {
 	}
 }
 
+// A Hook observes the calls of a Dispatcher. The methods are called
+// concurrently by the callers of Dispatch and DispatchContext.
+type Hook interface {
+	// OnCallStart is called when a call of function name is dispatched.
+	OnCallStart(name string)
+
+	// OnCallEnd is called when a call of function name returns error err.
+	// Duration d includes the time the call waited for an idle worker.
+	OnCallEnd(name string, d time.Duration, err error)
+}
+
+// CallHook configures a Dispatcher to report each call to h.
+func CallHook(h Hook) Option {
+	return func(d *Dispatcher) {
+		d.hook = h
+	}
+}
+
 var newWorker = worker.New // for testing
 
+var errClosed = errors.New("stdio: dispatcher is closed")
+
 // New returns a Dispatcher that interfaces via swerker-stdio with the
 // Swiss Ephemeris. As it takes the file system path to the binary and the
 // number of instances of the program as arguments. By default the number of
@@ -80,8 +181,7 @@ var newWorker = worker.New // for testing
 func New(path string, opts ...Option) (d *Dispatcher, err error) {
 	d = &Dispatcher{
 		path:     path,
-		queue:    make(chan task),
-		crashed:  make(chan worker.Worker),
+		crashed:  make(chan *proc),
 		workDone: make(chan struct{}),
 		closed:   make(chan struct{}),
 	}
@@ -100,69 +200,289 @@ func New(path string, opts ...Option) (d *Dispatcher, err error) {
 		}
 	}()
 
-	d.workers = make([]worker.Worker, d.procs)
+	d.workers = make([]*proc, d.procs)
+	d.workerStats = make([]workerCounters, d.procs)
 	for i := 0; i < d.procs; i++ {
-		w, funcs, err := d.newWorker()
+		p, err := d.newWorker(i)
 		if err != nil {
 			return nil, err
 		}
 
-		if i == 0 {
-			d.funcs = funcs.FuncsMap()
-			d.lastIdx = funcs.LastIdx()
-		}
-
-		d.workers[i] = w
+		d.workers[i] = p
 	}
 
 	go d.restartWorkers()
 	return d, nil
 }
 
-func (d *Dispatcher) newWorker() (worker.Worker, worker.Funcs, error) {
+// newWorker starts a worker process for worker slot slot and marks it as
+// idle. The function table of the first worker is used for all workers.
+func (d *Dispatcher) newWorker(slot int) (*proc, error) {
 	w, funcs, err := newWorker(d.path)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	if d.funcs == nil {
+		d.setFuncs(funcs)
 	}
 
+	p := &proc{w: w, slot: slot, tasks: make(chan task, 1), state: make(ctxState)}
 	if d.data != "" {
 		if idx, ok := d.IndexForName("swe_set_ephe_path"); ok {
 			var args []byte
 			args = msgp.AppendArrayHeader(args, 1)
 			args = msgp.AppendString(args, d.data)
-			w.Call(&swerker.Call{Func: idx, Args: args})
+			if _, crashed, err := w.Call(&swerker.Call{Func: idx, Args: args}); !crashed && err == nil {
+				p.state[idx] = string(args)
+			}
+		}
+	}
+
+	d.release(p, p.state)
+	go d.runWorker(p)
+	return p, nil
+}
+
+func (d *Dispatcher) setFuncs(funcs worker.Funcs) {
+	d.funcs = funcs.FuncsMap()
+	d.names = funcs
+	d.lastIdx = funcs.LastIdx()
+	d.funcStats = make([]funcCounters, len(funcs))
+
+	d.stateCost = make(map[uint8]int)
+	for name, cost := range stateFuncs {
+		if idx, ok := d.funcs[name]; ok {
+			d.stateCost[idx] = cost
 		}
 	}
 
-	go d.runWorker(w)
-	return w, funcs, nil
+	d.keeps = make(map[uint8]bool)
+	for _, name := range keepFuncs {
+		if idx, ok := d.funcs[name]; ok {
+			d.keeps[idx] = true
+		}
+	}
 }
 
-func (d *Dispatcher) runWorker(w worker.Worker) {
-	for t := range d.queue {
+func (d *Dispatcher) runWorker(p *proc) {
+	for t := range p.tasks {
+		if err := t.ctx.Err(); err != nil {
+			d.release(p, p.state)
+			t.result <- result{nil, err}
+			continue
+		}
+
+		call, state := d.dropRedundant(p.state, t.call)
+
 		d.workersMu.RLock()
 
-		data, crashed, err := w.Call(t.call)
-		t.result <- result{data, err}
+		stop := killOnDone(t.ctx, p.w)
+		data, crashed, err := p.w.Call(call)
+		killed := stop()
+		if killed {
+			data, crashed, err = nil, true, t.ctx.Err()
+		}
+
+		stats := &d.workerStats[p.slot]
+		atomic.AddUint64(&stats.calls, 1)
 		if crashed {
-			err := w.Exit()
-			if err != nil && d.onExitErr != nil {
+			if killed {
+				atomic.AddUint64(&stats.kills, 1)
+			} else {
+				atomic.AddUint64(&stats.crashes, 1)
+			}
+
+			t.result <- result{data, err}
+
+			err := p.w.Exit()
+			if err != nil && !killed && d.onExitErr != nil {
 				d.onExitErr(err)
 			}
 
-			d.crashed <- w
+			d.crashed <- p
 			d.workersMu.RUnlock()
 			return
 		}
 
 		d.workersMu.RUnlock()
+
+		if err != nil {
+			state = make(ctxState)
+		}
+
+		// p is released before the result is sent, so that a subsequent call
+		// of the same caller finds it idle.
+		d.release(p, state)
+		t.result <- result{data, err}
 	}
 
 	if idx, ok := d.IndexForName("swe_close"); ok {
-		w.Call(&swerker.Call{Func: idx})
+		p.w.Call(&swerker.Call{Func: idx})
 	}
 
-	w.Exit()
+	p.w.Exit()
+}
+
+// release sets the library state of worker p to state and passes the oldest
+// pending task to p. If no task is pending, p is marked as idle.
+func (d *Dispatcher) release(p *proc, state ctxState) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	p.state = state
+	if len(d.pending) > 0 {
+		t := d.pending[0]
+		d.pending[0] = task{}
+		d.pending = d.pending[1:]
+		p.tasks <- t
+		return
+	}
+
+	if d.closing {
+		close(p.tasks)
+		return
+	}
+
+	d.idle = append(d.idle, p)
+}
+
+// schedule passes task t to the idle worker whose library state matches the
+// context calls of t best. If all workers are busy, t is queued.
+func (d *Dispatcher) schedule(t task) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closing {
+		return errClosed
+	}
+
+	if len(d.idle) == 0 {
+		d.pending = append(d.pending, t)
+		return nil
+	}
+
+	best, max := 0, -1
+	for i, p := range d.idle {
+		if n := d.score(p.state, t.call); n > max {
+			best, max = i, n
+		}
+	}
+
+	p := d.idle[best]
+	d.idle = append(d.idle[:best], d.idle[best+1:]...)
+	p.tasks <- t
+	return nil
+}
+
+// unschedule removes task t from the pending tasks if it is not yet passed to
+// a worker.
+func (d *Dispatcher) unschedule(t task) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i := range d.pending {
+		if d.pending[i].result == t.result {
+			d.pending = append(d.pending[:i], d.pending[i+1:]...)
+			return
+		}
+	}
+}
+
+// score returns the summed cost of the context calls of c that match library
+// state s.
+func (d *Dispatcher) score(s ctxState, c *swerker.Call) (n int) {
+	for _, cc := range c.Ctx {
+		if d.resets(cc.Func) {
+			break
+		}
+
+		if args, ok := s[cc.Func]; ok && args == string(cc.Args) {
+			n += d.stateCost[cc.Func]
+		}
+	}
+
+	return n
+}
+
+// resets reports whether a call of function fn leaves the library state
+// unknown.
+func (d *Dispatcher) resets(fn uint8) bool {
+	_, state := d.stateCost[fn]
+	return !state && !d.keeps[fn]
+}
+
+// update applies a call of function fn with arguments args to library state s
+// and reports whether the call leaves s unchanged.
+func (d *Dispatcher) update(s ctxState, fn uint8, args msgp.Raw) bool {
+	if d.resets(fn) {
+		for k := range s {
+			delete(s, k)
+		}
+
+		return false
+	}
+
+	if _, ok := d.stateCost[fn]; !ok {
+		return false
+	}
+
+	if v, ok := s[fn]; ok && v == string(args) {
+		return true
+	}
+
+	s[fn] = string(args)
+	return false
+}
+
+// dropRedundant returns call c without the context calls that leave library
+// state s unchanged and the library state after the call. Call c and state s
+// are not modified.
+func (d *Dispatcher) dropRedundant(s ctxState, c *swerker.Call) (*swerker.Call, ctxState) {
+	s = s.clone()
+
+	var ctx []*swerker.CtxCall
+	dropped := false
+	for _, cc := range c.Ctx {
+		if d.update(s, cc.Func, cc.Args) {
+			dropped = true
+			continue
+		}
+
+		ctx = append(ctx, cc)
+	}
+
+	d.update(s, c.Func, c.Args)
+	if !dropped {
+		return c, s
+	}
+
+	return &swerker.Call{Ctx: ctx, Func: c.Func, Args: c.Args}, s
+}
+
+// killOnDone kills worker w if ctx is done before the returned function is
+// called. The returned function reports whether w was killed.
+func killOnDone(ctx context.Context, w worker.Worker) func() bool {
+	if ctx.Done() == nil {
+		return func() bool { return false }
+	}
+
+	done := make(chan struct{})
+	killed := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			w.Kill()
+			killed <- true
+		case <-done:
+			killed <- false
+		}
+	}()
+
+	return func() bool {
+		close(done)
+		return <-killed
+	}
 }
 
 func (d *Dispatcher) restartWorkers() {
@@ -171,13 +491,17 @@ func (d *Dispatcher) restartWorkers() {
 		case cw := <-d.crashed:
 			d.workersMu.Lock()
 
-			for i, w := range d.workers {
-				if w == cw {
-					w, _, err := d.newWorker()
-					if err != nil && d.onNewErr != nil {
-						d.onNewErr(err)
+			for i, p := range d.workers {
+				if p == cw {
+					p, err := d.newWorker(i)
+					if err != nil {
+						atomic.AddUint64(&d.workerStats[i].restartErrors, 1)
+						if d.onNewErr != nil {
+							d.onNewErr(err)
+						}
 					} else {
-						d.workers[i] = w
+						atomic.AddUint64(&d.workerStats[i].restarts, 1)
+						d.workers[i] = p
 					}
 
 					break
@@ -194,7 +518,15 @@ func (d *Dispatcher) restartWorkers() {
 
 // Close terminates and cleans the worker processes.
 func (d *Dispatcher) Close() error {
-	close(d.queue)
+	d.mu.Lock()
+	d.closing = true
+	for _, p := range d.idle {
+		close(p.tasks)
+	}
+
+	d.idle = nil
+	d.mu.Unlock()
+
 	close(d.workDone)
 	<-d.closed
 	return nil
@@ -264,14 +596,50 @@ func (e *UnimplementedError) Error() string {
 
 // Dispatch implements swerker.Dispatcher interface.
 func (d *Dispatcher) Dispatch(c *swerker.Call) (msgp.Raw, error) {
+	return d.DispatchContext(context.Background(), c)
+}
+
+// DispatchContext implements swerker.ContextDispatcher interface. If ctx is
+// done while the call is executed, the worker is killed and restarted.
+func (d *Dispatcher) DispatchContext(ctx context.Context, c *swerker.Call) (msgp.Raw, error) {
 	if c.Func > d.lastIdx {
 		return nil, &UnimplementedError{c.Func}
 	}
 
-	t := task{c, make(chan result)}
-	d.queue <- t
-	r := <-t.result
-	return r.data, r.err
+	name := d.names[c.Func]
+	if d.hook != nil {
+		d.hook.OnCallStart(name)
+	}
+
+	start := time.Now()
+	data, err := d.dispatch(ctx, c)
+	dur := time.Since(start)
+
+	d.funcStats[c.Func].add(dur, err)
+	if d.hook != nil {
+		d.hook.OnCallEnd(name, dur, err)
+	}
+
+	return data, err
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, c *swerker.Call) (msgp.Raw, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	t := task{ctx, c, make(chan result, 1)}
+	if err := d.schedule(t); err != nil {
+		return nil, err
+	}
+
+	select {
+	case r := <-t.result:
+		return r.data, r.err
+	case <-ctx.Done():
+		d.unschedule(t)
+		return nil, ctx.Err()
+	}
 }
 
 // Version returns the Swiss Ephemeris version linked by the swerker-stdio
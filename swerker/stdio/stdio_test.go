@@ -2,10 +2,12 @@ package stdio
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"os/exec"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/howesteve/swego/swerker"
 	"github.com/howesteve/swego/swerker/stdio/internal/worker"
@@ -17,6 +19,7 @@ type testWorker struct {
 	path string
 	call callFunc
 	exit exitFunc
+	kill exitFunc
 }
 
 type newFunc func(string) (worker.Worker, worker.Funcs, error)
@@ -24,13 +27,20 @@ type callFunc func(*swerker.Call) (msgp.Raw, bool, error)
 type exitFunc func() error
 
 func (w *testWorker) Exit() error { return w.exit() }
+func (w *testWorker) Kill() error {
+	if w.kill != nil {
+		return w.kill()
+	}
+
+	return nil
+}
 func (w *testWorker) Call(c *swerker.Call) (msgp.Raw, bool, error) {
 	return w.call(c)
 }
 
 func newTestWorker(funcs worker.Funcs, call callFunc, exit exitFunc) newFunc {
 	return func(path string) (worker.Worker, worker.Funcs, error) {
-		return &testWorker{path, call, exit}, funcs, nil
+		return &testWorker{path, call, exit, nil}, funcs, nil
 	}
 }
 
@@ -261,6 +271,214 @@ func TestCrash(t *testing.T) {
 	}
 }
 
+func TestDispatchContext(t *testing.T) {
+	funcs := worker.Funcs{"rpc_funcs", "test_slow", "test_func"}
+	started := make(chan *testWorker, 2)
+
+	defer func() { newWorker = worker.New }()
+	newWorker = func(path string) (worker.Worker, worker.Funcs, error) {
+		killed := make(chan struct{})
+		w := &testWorker{path: path}
+		w.call = func(c *swerker.Call) (msgp.Raw, bool, error) {
+			if c.Func == 1 {
+				<-killed
+				return nil, true, &worker.UnexpectedExitError{}
+			}
+
+			return msgp.Raw{0x90}, false, nil
+		}
+		w.exit = func() error { return nil }
+		w.kill = func() error {
+			close(killed)
+			return nil
+		}
+
+		started <- w
+		return w, funcs, nil
+	}
+
+	d, err := New(workerPath, NumWorkers(1), OnExitError(func(err error) {
+		t.Errorf("exit err = %v, want: no call", err)
+	}))
+
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	<-started
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		data, err := d.DispatchContext(ctx, &swerker.Call{Func: 2})
+		if data != nil {
+			t.Errorf("data = [% x], want: nil", data)
+		}
+
+		if err != context.Canceled {
+			t.Errorf("err = %v, want: %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		data, err := d.DispatchContext(ctx, &swerker.Call{Func: 1})
+		if data != nil {
+			t.Errorf("data = [% x], want: nil", data)
+		}
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("err = %v, want: %v", err, context.DeadlineExceeded)
+		}
+
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal("killed worker is not restarted")
+		}
+	})
+
+	t.Run("Call", func(t *testing.T) {
+		data, err := d.DispatchContext(context.Background(), &swerker.Call{Func: 2})
+		if err != nil {
+			t.Errorf("err = %v, want: nil", err)
+		}
+
+		if !bytes.Equal(data, msgp.Raw{0x90}) {
+			t.Errorf("data = [% x], want: [90]", data)
+		}
+	})
+
+	if err := d.Close(); err != nil {
+		t.Errorf("err = %v, want: nil", err)
+	}
+}
+
+// ctxFuncs returns the functions of the context calls of c.
+func ctxFuncs(c *swerker.Call) (s []uint8) {
+	for _, cc := range c.Ctx {
+		s = append(s, cc.Func)
+	}
+
+	return s
+}
+
+func TestDispatch_redundantCtx(t *testing.T) {
+	funcs := worker.Funcs{"rpc_funcs", "swe_set_ephe_path", "swe_set_topo", "swe_set_jpl_file", "swe_close", "swe_calc", "swe_sol_eclipse_how"}
+	const (
+		ephePath   = 1
+		topo       = 2
+		jplFile    = 3
+		closeFn    = 4
+		testFunc   = 5
+		eclipseHow = 6 // sets the topo internally
+	)
+
+	var calls [][]uint8
+	defer func() { newWorker = worker.New }()
+	newWorker = newTestWorker(funcs, func(c *swerker.Call) (msgp.Raw, bool, error) {
+		if c.Func == testFunc {
+			calls = append(calls, ctxFuncs(c))
+		}
+
+		return msgp.Raw{0x90}, false, nil
+	}, func() error {
+		return nil
+	})
+
+	d, err := New(workerPath, NumWorkers(1), DataPath("/path/to/files"))
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	ephe := &swerker.CtxCall{Func: ephePath, Args: msgp.AppendString(msgp.AppendArrayHeader(nil, 1), d.DataPath())}
+	topoA := &swerker.CtxCall{Func: topo, Args: msgp.Raw{0x91, 0x01}}
+	topoB := &swerker.CtxCall{Func: topo, Args: msgp.Raw{0x91, 0x02}}
+	jpl := &swerker.CtxCall{Func: jplFile, Args: msgp.Raw{0x91, 0xa1, 'x'}}
+
+	for _, c := range []*swerker.Call{
+		{Ctx: []*swerker.CtxCall{ephe, topoA, jpl}, Func: testFunc},
+		{Ctx: []*swerker.CtxCall{ephe, topoA, jpl}, Func: testFunc},
+		{Ctx: []*swerker.CtxCall{topoB, jpl}, Func: testFunc},
+		{Func: closeFn},
+		{Ctx: []*swerker.CtxCall{topoB, jpl}, Func: testFunc},
+		{Func: eclipseHow},
+		{Ctx: []*swerker.CtxCall{topoB, jpl}, Func: testFunc},
+	} {
+		if _, err := d.Dispatch(c); err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+	}
+
+	want := [][]uint8{{topo, jplFile}, nil, {topo}, {topo, jplFile}, {topo, jplFile}}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("ctx funcs = %v, want: %v", calls, want)
+	}
+
+	if err := d.Close(); err != nil {
+		t.Errorf("err = %v, want: nil", err)
+	}
+}
+
+func TestDispatch_affinity(t *testing.T) {
+	funcs := worker.Funcs{"rpc_funcs", "swe_set_jpl_file", "swe_calc"}
+	const (
+		jplFile  = 1
+		testFunc = 2
+	)
+
+	type call struct {
+		w   *testWorker
+		ctx []uint8
+	}
+
+	var calls []call
+	defer func() { newWorker = worker.New }()
+	newWorker = func(path string) (worker.Worker, worker.Funcs, error) {
+		w := &testWorker{path: path, exit: func() error { return nil }}
+		w.call = func(c *swerker.Call) (msgp.Raw, bool, error) {
+			calls = append(calls, call{w, ctxFuncs(c)})
+			return msgp.Raw{0x90}, false, nil
+		}
+
+		return w, funcs, nil
+	}
+
+	d, err := New(workerPath, NumWorkers(2))
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	jplA := &swerker.CtxCall{Func: jplFile, Args: msgp.Raw{0x91, 0xa1, 'a'}}
+	jplB := &swerker.CtxCall{Func: jplFile, Args: msgp.Raw{0x91, 0xa1, 'b'}}
+	for _, cc := range []*swerker.CtxCall{jplA, jplB, jplA, jplB} {
+		if _, err := d.Dispatch(&swerker.Call{Ctx: []*swerker.CtxCall{cc}, Func: testFunc}); err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+	}
+
+	if calls[0].w == calls[1].w {
+		t.Error("calls with different JPL files are sent to the same worker")
+	}
+
+	for i := 2; i < len(calls); i++ {
+		if calls[i].w != calls[i-2].w {
+			t.Errorf("call %d is not sent to the worker of call %d", i, i-2)
+		}
+
+		if calls[i].ctx != nil {
+			t.Errorf("call %d ctx funcs = %v, want: nil", i, calls[i].ctx)
+		}
+	}
+
+	if err := d.Close(); err != nil {
+		t.Errorf("err = %v, want: nil", err)
+	}
+}
+
 func TestVersion(t *testing.T) {
 	funcs := worker.Funcs{"rpc_funcs", "swe_version"}
 	const version = "2.00"
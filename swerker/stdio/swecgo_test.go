@@ -0,0 +1,300 @@
+//go:build (linux && cgo) || (darwin && cgo)
+// +build linux,cgo darwin,cgo
+
+package stdio
+
+import (
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/howesteve/swego"
+	"github.com/howesteve/swego/swecgo"
+	"github.com/howesteve/swego/swerker"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// The tests in this file compare the results of a swerker-stdio worker with
+// the results of swecgo. They are skipped if environment variable
+// SWERKER_STDIO is not set to the path of the worker binary.
+
+func newTestDispatcher(t *testing.T) *Dispatcher {
+	path := os.Getenv("SWERKER_STDIO")
+	if path == "" {
+		t.Skip("SWERKER_STDIO is not set")
+	}
+
+	d, err := New(path, NumWorkers(1))
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	t.Cleanup(func() { d.Close() })
+	return d
+}
+
+const tidalAutomatic = 999999 // SE_TIDAL_AUTOMATIC
+
+func values(v ...interface{}) []interface{} { return v }
+
+func TestWorker_swecgo(t *testing.T) {
+	d := newTestDispatcher(t)
+
+	const jd = 2451545
+	loc := swego.GeoLoc{Long: 5.116667, Lat: 52.083333}
+	calcFl := &swego.CalcFlags{Flags: swego.FlagEphMoshier | swego.FlagSpeed}
+	calcFl.SetDeltaT(60.0 / 86400)
+	azAltFl := &swego.AzAltFlags{AtPress: 1013.25, AtTemp: 15}
+	azAltFl.SetLapseRate(0.008)
+
+	cases := []struct {
+		name string
+		fn   func(swe swego.Interface) []interface{}
+	}{
+		{"Version", func(swe swego.Interface) []interface{} { return values(swe.Version()) }},
+		{"PlanetName", func(swe swego.Interface) []interface{} { return values(swe.PlanetName(swego.Mars)) }},
+		{"Calc", func(swe swego.Interface) []interface{} { return values(swe.Calc(jd, swego.Mars, calcFl)) }},
+		{"CalcUT", func(swe swego.Interface) []interface{} { return values(swe.CalcUT(jd, swego.Moon, calcFl)) }},
+		{"JulDay", func(swe swego.Interface) []interface{} { return values(swe.JulDay(2000, 1, 1, 12, swego.Gregorian)) }},
+		{"RevJul", func(swe swego.Interface) []interface{} { return values(swe.RevJul(jd, swego.Gregorian)) }},
+		{"UTCToJD", func(swe swego.Interface) []interface{} {
+			return values(swe.UTCToJD(2000, 1, 1, 12, 0, 0, &swego.DateConvertFlags{Calendar: swego.Gregorian}))
+		}},
+		{"HousesEx", func(swe swego.Interface) []interface{} {
+			return values(swe.HousesEx(jd, nil, loc.Lat, loc.Long, swego.Placidus))
+		}},
+		{"HouseName", func(swe swego.Interface) []interface{} { return values(swe.HouseName(swego.Placidus)) }},
+		{"AzAlt", func(swe swego.Interface) []interface{} {
+			return values(swe.AzAlt(jd, loc, swego.Ecl2Hor, 280, 0, azAltFl))
+		}},
+		{"Refrac", func(swe swego.Interface) []interface{} { return values(swe.Refrac(1, 1013.25, 15, swego.TrueToApp)) }},
+		{"DeltaTEx", func(swe swego.Interface) []interface{} { return values(swe.DeltaTEx(jd, swego.Moshier)) }},
+		{"TimeEqu", func(swe swego.Interface) []interface{} { return values(swe.TimeEqu(jd, nil)) }},
+		{"SidTime", func(swe swego.Interface) []interface{} { return values(swe.SidTime(jd, nil)) }},
+		{"CoTrans", func(swe swego.Interface) []interface{} { return values(swe.CoTrans([]float64{280, 0, 1}, 23.44)) }},
+		{"SplitDeg", func(swe swego.Interface) []interface{} { return values(swe.SplitDeg(280.123456, 0)) }},
+	}
+
+	swe := swecgo.Open()
+	client := swerker.NewClient(d)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			want := c.fn(swe)
+			if got := c.fn(client); !reflect.DeepEqual(got, want) {
+				t.Errorf("swerker = %v, want: %v", got, want)
+			}
+		})
+	}
+}
+
+// call dispatches function name with arguments args and context calls ctx to
+// d and returns the numbers in the result flattened into a single slice.
+func call(t *testing.T, d *Dispatcher, ctx []*swerker.CtxCall, name string, args ...interface{}) []float64 {
+	t.Helper()
+
+	idx, ok := d.IndexForName(name)
+	if !ok {
+		t.Fatalf("%s not implemented", name)
+	}
+
+	data, err := d.Dispatch(&swerker.Call{Ctx: ctx, Func: idx, Args: encodeArgs(args)})
+	if err != nil {
+		t.Fatalf("%s err = %v, want: nil", name, err)
+	}
+
+	v, _, err := msgp.ReadIntfBytes(data)
+	if err != nil {
+		t.Fatalf("%s err = %v, want: nil", name, err)
+	}
+
+	return flatten(nil, v)
+}
+
+func ctxCall(t *testing.T, d *Dispatcher, name string, args ...interface{}) *swerker.CtxCall {
+	t.Helper()
+
+	idx, ok := d.IndexForName(name)
+	if !ok {
+		t.Fatalf("%s not implemented", name)
+	}
+
+	return &swerker.CtxCall{Func: idx, Args: encodeArgs(args)}
+}
+
+func encodeArgs(args []interface{}) msgp.Raw {
+	b := msgp.AppendArrayHeader(nil, uint32(len(args)))
+	for _, a := range args {
+		b, _ = msgp.AppendIntf(b, a)
+	}
+
+	return b
+}
+
+func flatten(dst []float64, v interface{}) []float64 {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, v := range v {
+			dst = flatten(dst, v)
+		}
+	case float64:
+		dst = append(dst, v)
+	case int64:
+		dst = append(dst, float64(v))
+	case uint64:
+		dst = append(dst, float64(v))
+	}
+
+	return dst
+}
+
+func TestWorker_handlers(t *testing.T) {
+	d := newTestDispatcher(t)
+	swe := swecgo.Open()
+
+	const jd = 2451545
+	jdGreg, _ := swe.JulDay(2000, 1, 1, 12, swego.Gregorian)
+	cusps, ascmc, _ := swe.HousesEx(jd, nil, 52.083333, 5.116667, swego.Placidus)
+	dow := (int(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).Weekday()) + 6) % 7 // Monday is 0
+
+	cases := []struct {
+		name string
+		ctx  []*swerker.CtxCall
+		args []interface{}
+		want []float64
+	}{
+		{"swe_date_conversion", nil, values(2000, 1, 1, 12.0, int(swego.Gregorian)), []float64{0, jdGreg}},
+		{"swe_houses", nil, values(float64(jd), 52.083333, 5.116667, int(swego.Placidus)), append(append([]float64{0}, cusps...), ascmc...)},
+		{"swe_get_tid_acc", []*swerker.CtxCall{ctxCall(t, d, "swe_set_tid_acc", -25.8)}, nil, []float64{-25.8}},
+		{"swe_degnorm", nil, values(-10.0), []float64{350}},
+		{"swe_radnorm", nil, values(-math.Pi / 2), []float64{3 * math.Pi / 2}},
+		{"swe_deg_midp", nil, values(10.0, 350.0), []float64{0}},
+		{"swe_rad_midp", nil, values(1.0, 0.5), []float64{0.75}},
+		{"swe_difdegn", nil, values(10.0, 350.0), []float64{20}},
+		{"swe_difdeg2n", nil, values(350.0, 10.0), []float64{-20}},
+		{"swe_difrad2n", nil, values(0.5, 1.0), []float64{-0.5}},
+		{"swe_d2l", nil, values(-1.5), []float64{-2}},
+		{"swe_day_of_week", nil, values(float64(jd)), []float64{float64(dow)}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := call(t, d, c.ctx, c.name, c.args...)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("%s = %v, want: %v", c.name, got, c.want)
+			}
+		})
+	}
+
+	// reset the tidal acceleration of the worker
+	call(t, d, []*swerker.CtxCall{ctxCall(t, d, "swe_set_tid_acc", float64(tidalAutomatic))}, "swe_get_tid_acc")
+}
+
+func TestWorker_deltaT(t *testing.T) {
+	d := newTestDispatcher(t)
+	swe := swecgo.Open()
+
+	const jd = 2451545
+	want, _ := swe.DeltaTEx(jd, swego.Moshier)
+
+	// swe_deltat uses the ephemeris of the last calculation
+	calc := call(t, d, nil, "swe_calc", float64(jd), int(swego.Sun), int(swego.FlagEphMoshier))
+	if calc[0] < 0 {
+		t.Fatalf("swe_calc = %v, want no error", calc)
+	}
+
+	if got := call(t, d, nil, "swe_deltat", float64(jd)); !reflect.DeepEqual(got, []float64{want}) {
+		t.Errorf("swe_deltat = %v, want: [%v]", got, want)
+	}
+}
+
+func TestWorker_interpolateNut(t *testing.T) {
+	d := newTestDispatcher(t)
+	swe := swecgo.Open()
+
+	const jd = 2451545
+	fl := int(swego.FlagEphMoshier | swego.FlagSpeed)
+	xx, cfl, _ := swe.Calc(jd, swego.Moon, &swego.CalcFlags{Flags: int32(fl)})
+
+	ctx := []*swerker.CtxCall{ctxCall(t, d, "swe_set_interpolate_nut", 1)}
+	got := call(t, d, ctx, "swe_calc", float64(jd), int(swego.Moon), fl)
+	if len(got) != 7 || int(got[0]) != cfl {
+		t.Fatalf("swe_calc = %v, want: [%d %v]", got, cfl, xx)
+	}
+
+	for i, x := range xx {
+		if math.Abs(got[i+1]-x) > 1e-6 {
+			t.Errorf("swe_calc xx[%d] = %f, want: %f", i, got[i+1], x)
+		}
+	}
+
+	call(t, d, []*swerker.CtxCall{ctxCall(t, d, "swe_set_interpolate_nut", 0)}, "swe_get_tid_acc")
+}
+
+func TestWorker_topoState(t *testing.T) {
+	d := newTestDispatcher(t)
+	c := swerker.NewClient(d)
+
+	const jd = 2451545
+	fl := &swego.CalcFlags{Flags: swego.FlagEphMoshier | swego.FlagSpeed | swego.FlagTopo, TopoLoc: &swego.GeoLoc{}}
+	want, _, err := c.CalcUT(jd, swego.Moon, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	// swe_sol_eclipse_how sets the topo of the worker to its location
+	c.SolEclipseHow(jd, &swego.EclipseFlags{Flags: swego.FlagEphMoshier}, swego.GeoLoc{Long: 150, Lat: -40})
+
+	got, _, err := c.CalcUT(jd, swego.Moon, fl)
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("CalcUT = %v, %v, want: %v, nil", got, err, want)
+	}
+}
+
+func TestWorker_lapseRate(t *testing.T) {
+	d := newTestDispatcher(t)
+	c := swerker.NewClient(d)
+
+	loc := swego.GeoLoc{Long: 8.55, Lat: 47.37, Alt: 3000}
+	sun := swego.Body{Planet: swego.Sun}
+	fl := &swego.RiseTransFlags{Flags: swego.FlagEphMoshier, AtPress: 1013.25, AtTemp: 15}
+	fl.SetHorHgt(-1)
+
+	want, err := c.RiseTrans(2451544.5, sun, loc, swego.CalcRise, fl)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	// the lapse rate of AzAlt changes the dip of the horizon at the altitude of loc
+	azAltFl := &swego.AzAltFlags{AtPress: 1013.25, AtTemp: 15}
+	azAltFl.SetLapseRate(0.1)
+	c.AzAlt(2451545, loc, swego.Ecl2Hor, 280.37, 0, azAltFl)
+
+	if got, err := c.RiseTrans(2451544.5, sun, loc, swego.CalcRise, fl); got != want || err != nil {
+		t.Errorf("RiseTrans = %f, %v, want: %f, nil", got, err, want)
+	}
+}
+
+func TestWorker_ctxEphePath(t *testing.T) {
+	d := newTestDispatcher(t)
+
+	idx, _ := d.IndexForName("swe_calc")
+	ctx := []*swerker.CtxCall{ctxCall(t, d, "swe_close"), ctxCall(t, d, "swe_set_ephe_path", "/nonexist")}
+	data, err := d.Dispatch(&swerker.Call{Ctx: ctx, Func: idx, Args: encodeArgs(values(2451545.0, int(swego.Sun), 0))})
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	v, _, err := msgp.ReadIntfBytes(data)
+	res, _ := v.([]interface{})
+	if err != nil || len(res) != 3 {
+		t.Fatalf("swe_calc = %v, %v, want 3 values", v, err)
+	}
+
+	if msg, _ := res[2].(string); !strings.Contains(msg, "'/nonexist/'") {
+		t.Errorf("swe_calc warning = %q, want ephemeris path '/nonexist/'", msg)
+	}
+}
@@ -1,7 +1,11 @@
 // Package swerker provides an interface for interfacing with worker processes.
 package swerker
 
-import "github.com/tinylib/msgp/msgp"
+import (
+	"context"
+
+	"github.com/tinylib/msgp/msgp"
+)
 
 // Dispatcher dispatches calls to a backend worker.
 type Dispatcher interface {
@@ -17,6 +21,16 @@ type Dispatcher interface {
 	Dispatch(*Call) (msgp.Raw, error)
 }
 
+// ContextDispatcher is a Dispatcher that can abandon a call when a context is
+// done.
+type ContextDispatcher interface {
+	Dispatcher
+
+	// DispatchContext dispatches a call to a backend. It returns the context
+	// error if the context is done before the result is received.
+	DispatchContext(context.Context, *Call) (msgp.Raw, error)
+}
+
 //go:generate msgp
 
 //msgp:tuple Call
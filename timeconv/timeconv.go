@@ -0,0 +1,113 @@
+// Package timeconv converts between time.Time values and the Julian Dates
+// used by swego.Interface. Julian Dates in Universal Time and Ephemeris Time
+// have distinct types so they cannot be confused at compile time.
+package timeconv
+
+import (
+	"math"
+	"time"
+
+	"github.com/howesteve/swego"
+)
+
+// JulianDayUT represents a Julian Date in Universal Time (UT1).
+type JulianDayUT float64
+
+// JulianDayET represents a Julian Date in Ephemeris Time (TT).
+type JulianDayET float64
+
+// Converter converts between time.Time values and Julian Dates using the
+// date functions of a swego.Interface. Leap seconds are accounted for by the
+// Swiss Ephemeris, including those added to seleapsec.txt in the ephemeris
+// path. Dates are always in the Gregorian calendar.
+type Converter struct {
+	swe swego.Interface
+	fl  swego.DateConvertFlags
+}
+
+// New returns a Converter that uses swe for the conversions.
+func New(swe swego.Interface) *Converter {
+	return &Converter{swe: swe, fl: swego.DateConvertFlags{Calendar: swego.Gregorian}}
+}
+
+// SetDeltaT sets f as delta T used in the conversions.
+func (c *Converter) SetDeltaT(f float64) { c.fl.SetDeltaT(f) }
+
+// ResetDeltaT resets delta T to the value computed by the Swiss Ephemeris.
+func (c *Converter) ResetDeltaT() { c.fl.DeltaT = nil }
+
+// JulianDay returns the Julian Dates in Ephemeris and Universal Time of t.
+// The location of t is converted to UTC with swe_utc_time_zone.
+func (c *Converter) JulianDay(t time.Time) (JulianDayET, JulianDayUT, error) {
+	y, m, d := t.Date()
+	h, i, sec := t.Clock()
+	s := float64(sec) + float64(t.Nanosecond())/1e9
+
+	mi := int(m)
+	if _, off := t.Zone(); off != 0 {
+		var err error
+		y, mi, d, h, i, s, err = c.swe.UTCTimeZone(y, mi, d, h, i, s, float64(off)/3600)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	et, ut, err := c.swe.UTCToJD(y, mi, d, h, i, s, &c.fl)
+	return JulianDayET(et), JulianDayUT(ut), err
+}
+
+// UT returns the Julian Date in Universal Time of t.
+func (c *Converter) UT(t time.Time) (JulianDayUT, error) {
+	_, ut, err := c.JulianDay(t)
+	return ut, err
+}
+
+// ET returns the Julian Date in Ephemeris Time of t.
+func (c *Converter) ET(t time.Time) (JulianDayET, error) {
+	et, _, err := c.JulianDay(t)
+	return et, err
+}
+
+// TimeUT returns Julian Date ut as time in location loc, nil means UTC.
+func (c *Converter) TimeUT(ut JulianDayUT, loc *time.Location) (time.Time, error) {
+	y, m, d, h, i, s, err := c.swe.JdUT1ToUTC(float64(ut), &c.fl)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return date(y, m, d, h, i, s, loc), nil
+}
+
+// TimeET returns Julian Date et as time in location loc, nil means UTC.
+func (c *Converter) TimeET(et JulianDayET, loc *time.Location) (time.Time, error) {
+	y, m, d, h, i, s, err := c.swe.JdETToUTC(float64(et), &c.fl)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return date(y, m, d, h, i, s, loc), nil
+}
+
+// date returns the given UTC date in location loc. A leap second is returned
+// as the first second of the next minute, time.Time cannot represent it.
+func date(y, m, d, h, i int, s float64, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	sec := math.Floor(s)
+	nsec := math.Round((s - sec) * 1e9)
+	return time.Date(y, time.Month(m), d, h, i, int(sec), int(nsec), time.UTC).In(loc)
+}
+
+// Calc computes the position and optionally the speed of planet pl at Julian
+// Date et, see swego.Interface.Calc.
+func (c *Converter) Calc(et JulianDayET, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	return c.swe.Calc(float64(et), pl, fl)
+}
+
+// CalcUT computes the position and optionally the speed of planet pl at
+// Julian Date ut, see swego.Interface.CalcUT.
+func (c *Converter) CalcUT(ut JulianDayUT, pl swego.Planet, fl *swego.CalcFlags) ([]float64, int, error) {
+	return c.swe.CalcUT(float64(ut), pl, fl)
+}
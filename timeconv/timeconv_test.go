@@ -0,0 +1,124 @@
+//go:build (linux && cgo) || (darwin && cgo)
+// +build linux,cgo darwin,cgo
+
+package timeconv
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/howesteve/swego/swecgo"
+)
+
+var conv = New(swecgo.Open())
+
+func inDelta(lhs, rhs, delta float64) bool {
+	return math.Abs(lhs-rhs) < delta
+}
+
+func TestConverter_JulianDay(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+	nst := time.FixedZone("NST", -(3*3600 + 30*60))
+	times := []time.Time{
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2000, 1, 1, 1, 0, 0, 0, cet),
+		time.Date(1999, 12, 31, 20, 30, 0, 0, nst),
+	}
+
+	for _, tm := range times {
+		et, ut, err := conv.JulianDay(tm)
+		if err != nil {
+			t.Fatalf("err = %v, want: nil", err)
+		}
+
+		if !inDelta(float64(et), 2451544.500743, 1e-6) || !inDelta(float64(ut), 2451544.500004, 1e-6) {
+			t.Errorf("JulianDay(%s) = %f, %f, want: 2451544.500743, 2451544.500004", tm, et, ut)
+		}
+	}
+}
+
+func TestConverter_JulianDay_leapSecond(t *testing.T) {
+	before := time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC)
+	after := before.Add(time.Second)
+
+	et1, err := conv.ET(before)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	et2, err := conv.ET(after)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if d := float64(et2-et1) * 86400; !inDelta(d, 2, 1e-3) {
+		t.Errorf("ET(%s) - ET(%s) = %fs, want: 2s", after, before, d)
+	}
+
+	// 23:59:60.5 is returned as the first second of the next minute.
+	got, err := conv.TimeET(et1+JulianDayET(1.5/86400), nil)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	want := after.Add(500 * time.Millisecond)
+	if d := got.Sub(want); d < -time.Millisecond || d > time.Millisecond {
+		t.Errorf("TimeET() = %s, want: %s", got, want)
+	}
+}
+
+func TestConverter_Time(t *testing.T) {
+	loc := time.FixedZone("IST", 5*3600+30*60)
+	want := time.Date(2021, 3, 20, 15, 7, 28, 500e6, loc)
+
+	et, ut, err := conv.JulianDay(want)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	gotET, err := conv.TimeET(et, loc)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	gotUT, err := conv.TimeUT(ut, loc)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	for _, got := range []time.Time{gotET, gotUT} {
+		if d := got.Sub(want); d < -time.Millisecond || d > time.Millisecond {
+			t.Errorf("Time() = %s, want: %s", got, want)
+		}
+
+		if got.Location() != loc {
+			t.Errorf("Location() = %s, want: %s", got.Location(), loc)
+		}
+	}
+}
+
+func TestConverter_SetDeltaT(t *testing.T) {
+	c := New(swecgo.Open())
+	c.SetDeltaT(1.0 / 86400)
+
+	tm := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	et, ut, err := c.JulianDay(tm)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if d := float64(et-JulianDayET(ut)) * 86400; !inDelta(d, 1, 1e-3) {
+		t.Errorf("et - ut = %fs, want: 1s", d)
+	}
+
+	c.ResetDeltaT()
+	et, ut, err = c.JulianDay(tm)
+	if err != nil {
+		t.Fatalf("err = %v, want: nil", err)
+	}
+
+	if d := float64(et-JulianDayET(ut)) * 86400; !inDelta(d, 63.83, 0.01) {
+		t.Errorf("et - ut = %fs, want: 63.83s", d)
+	}
+}